@@ -18,21 +18,22 @@ const REQUESTING_SERVICE = "Agent 1";
 // ** Pull these from secure secret storage in a real application **
 const ORGANIZATION_ID = "acme-corp";
 const ORGANIZATION_KEY = "super-secret-key";
-const CLIENT_ID = "client-123";
+// The API key identifies this client to the service
+const API_KEY = process.env.MISTOKENLY_API_KEY;
 
 // Call the tokenisation service to tokenise PII data
 const tokenize = async (data, dataType) => {
     const response = await fetch(`${SERVICE_URL}/v1/tokenize`, {
         method: "POST",
         headers: {
-            "Content-Type": "application/json"
+            "Content-Type": "application/json",
+            "Authorization": `Bearer ${API_KEY}`,
+            "X-Org-Key": ORGANIZATION_KEY
         },
         body: JSON.stringify({
             data,
             dataType,
-            clientId: CLIENT_ID,
-            organizationId: ORGANIZATION_ID,
-            organizationKey: ORGANIZATION_KEY
+            organizationId: ORGANIZATION_ID
         })
     })
 
@@ -50,14 +51,14 @@ const detokenize = async (referenceHash) => {
     const response = await fetch(`${SERVICE_URL}/v1/detokenize`, {
         method: "POST",
         headers: {
-            "Content-Type": "application/json"
+            "Content-Type": "application/json",
+            "Authorization": `Bearer ${API_KEY}`,
+            "X-Org-Key": ORGANIZATION_KEY
         },
         body: JSON.stringify({
             referenceHash,
             purpose: "Agent tool calling example",
-            requestingService: REQUESTING_SERVICE,
-            organizationId: ORGANIZATION_ID,
-            organizationKey: ORGANIZATION_KEY
+            organizationId: ORGANIZATION_ID
         })
     });
 
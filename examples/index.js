@@ -2,14 +2,14 @@ const tokenize = async (data) => {
     const response = await fetch("http://localhost/v1/tokenize", {
         method: "POST",
         headers: {
-            "Content-Type": "application/json"
+            "Content-Type": "application/json",
+            "Authorization": `Bearer ${process.env.MISTOKENLY_API_KEY}`,
+            "X-Org-Key": "super-secret-key"
         },
         body: JSON.stringify({
             data,
             dataType: "email",
-            clientId: "client-123",
-            organizationId: "acme-corp",
-            organizationKey: "super-secret-key"
+            organizationId: "acme-corp"
         })
     })
 
@@ -27,15 +27,15 @@ const detokenize = async (referenceHash) => {
     const response = await fetch("http://localhost/v1/detokenize", {
         method: "POST",
         headers: {
-            "Content-Type": "application/json"
+            "Content-Type": "application/json",
+            "Authorization": `Bearer ${process.env.MISTOKENLY_API_KEY}`,
+            "X-Org-Key": "super-secret-key"
         },
         body: JSON.stringify({
             referenceHash,
             purpose: "customer-service",
-            requestingService: "crm",
             requestingUser: "user@example.com",
-            organizationId: "acme-corp",
-            organizationKey: "super-secret-key"
+            organizationId: "acme-corp"
         })
     });
 
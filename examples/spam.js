@@ -5,14 +5,14 @@ const tokenize = async (data) => {
     const response = await fetch("http://localhost/v1/tokenize", {
         method: "POST",
         headers: {
-            "Content-Type": "application/json"
+            "Content-Type": "application/json",
+            "Authorization": `Bearer ${process.env.MISTOKENLY_API_KEY}`,
+            "X-Org-Key": "super-secret-key"
         },
         body: JSON.stringify({
             data,
             dataType: "email",
-            clientId: "client-123",
-            organizationId: "acme-corp",
-            organizationKey: "super-secret-key"
+            organizationId: "acme-corp"
         }),
         agent
     })
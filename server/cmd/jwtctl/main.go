@@ -0,0 +1,253 @@
+// Command jwtctl creates a local JWKS and signs test tokens with it, so the API
+// gateway's OIDC token validation can be exercised without an identity provider.
+//
+//	jwtctl keygen -kid key-1 -jwks jwks.json -key key-1.pem
+//	jwtctl sign -key key-1.pem -kid key-1 -iss https://idp.local -aud mistokenly -azp crm -sub alice -org acme-corp -roles detokenize
+//
+// Point JWT_JWKS_FILE at the JWKS. Running keygen again with a new key ID adds the
+// key to the existing JWKS, which simulates a key rotation by the provider.
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+const usage = `Usage:
+  jwtctl keygen -kid ID -jwks FILE -key FILE [-bits N]
+      Generate an RSA signing key, write its private key to -key and add its public
+      key to the JWKS in -jwks (created if missing).
+  jwtctl sign -key FILE -kid ID -sub SUBJECT [-iss ISSUER] [-aud AUDIENCE] [-ttl DURATION]
+              [-azp CLIENT] [-org ORG,...] [-roles ROLE,...] [-claim NAME=VALUE ...]
+      Print an RS256 token signed with the key.
+`
+
+// jwk is the public part of an RSA signing key in a JWKS
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "keygen":
+		err = runKeygen(os.Args[2:])
+	case "sign":
+		err = runSign(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runKeygen generates a signing key and publishes it in the JWKS
+func runKeygen(args []string) error {
+	flags := flag.NewFlagSet("keygen", flag.ExitOnError)
+	kid := flags.String("kid", "", "key ID")
+	jwksFile := flags.String("jwks", "jwks.json", "JWKS file to add the public key to")
+	keyFile := flags.String("key", "", "file to write the private key to (PEM)")
+	bits := flags.Int("bits", 2048, "RSA key size")
+	flags.Parse(args)
+
+	if *kid == "" || *keyFile == "" {
+		return errors.New("-kid and -key are required")
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if data, err := os.ReadFile(*jwksFile); err == nil {
+		if err := json.Unmarshal(data, &set); err != nil {
+			return fmt.Errorf("invalid JWKS in %s: %w", *jwksFile, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	for _, k := range set.Keys {
+		if k.Kid == *kid {
+			return fmt.Errorf("%s already has a key %q", *jwksFile, *kid)
+		}
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, *bits)
+	if err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to encode key: %w", err)
+	}
+	if err := os.WriteFile(*keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+
+	set.Keys = append(set.Keys, jwk{
+		Kty: "RSA",
+		Kid: *kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	})
+	data, err := json.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*jwksFile, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write JWKS: %w", err)
+	}
+
+	fmt.Printf("Wrote private key %s and added key %q to %s (%d keys)\n", *keyFile, *kid, *jwksFile, len(set.Keys))
+	return nil
+}
+
+// runSign prints a signed token
+func runSign(args []string) error {
+	flags := flag.NewFlagSet("sign", flag.ExitOnError)
+	keyFile := flags.String("key", "", "private key file (PEM)")
+	kid := flags.String("kid", "", "key ID")
+	sub := flags.String("sub", "", "subject")
+	iss := flags.String("iss", "", "issuer")
+	aud := flags.String("aud", "", "audience")
+	azp := flags.String("azp", "", "authorized party (the OAuth client)")
+	org := flags.String("org", "", "comma-separated organizations for the org claim")
+	roles := flags.String("roles", "", "comma-separated roles for the roles claim")
+	ttl := flags.Duration("ttl", time.Hour, "token lifetime")
+	var extra claimFlags
+	flags.Var(&extra, "claim", "additional string claim as NAME=VALUE (repeatable)")
+	flags.Parse(args)
+
+	if *keyFile == "" || *sub == "" {
+		return errors.New("-key and -sub are required")
+	}
+
+	key, err := readPrivateKey(*keyFile)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"sub": *sub,
+		"iat": now.Unix(),
+		"exp": now.Add(*ttl).Unix(),
+	}
+	for name, value := range map[string]string{"iss": *iss, "aud": *aud, "azp": *azp} {
+		if value != "" {
+			claims[name] = value
+		}
+	}
+	if *org != "" {
+		claims["org"] = strings.Split(*org, ",")
+	}
+	if *roles != "" {
+		claims["roles"] = strings.Split(*roles, ",")
+	}
+	for name, value := range extra {
+		claims[name] = value
+	}
+
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	if *kid != "" {
+		header["kid"] = *kid
+	}
+
+	token, err := sign(key, header, claims)
+	if err != nil {
+		return err
+	}
+	fmt.Println(token)
+	return nil
+}
+
+// sign encodes and signs a compact RS256 JWT
+func sign(key *rsa.PrivateKey, header map[string]string, claims map[string]interface{}) (string, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// readPrivateKey reads a PKCS#8 or PKCS#1 RSA private key
+func readPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not PEM-encoded", path)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an RSA key", path)
+	}
+	return key, nil
+}
+
+// claimFlags collects repeated -claim NAME=VALUE flags
+type claimFlags map[string]string
+
+func (c *claimFlags) String() string {
+	return fmt.Sprint(map[string]string(*c))
+}
+
+func (c *claimFlags) Set(value string) error {
+	name, val, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return errors.New("claims must be NAME=VALUE")
+	}
+	if *c == nil {
+		*c = make(claimFlags)
+	}
+	(*c)[name] = val
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+	grpcclient "github.com/PlainFunction/mistokenly/internal/common/grpc"
+	"github.com/PlainFunction/mistokenly/internal/services"
+	pb "github.com/PlainFunction/mistokenly/proto/kms"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	log.Println("🚀 Starting KMS Service...")
+
+	// Load configuration
+	cfg := config.Load()
+	log.Printf("📋 Configuration loaded: Environment=%s", cfg.Environment)
+
+	// Create KMS service instance; this is the only service that loads the KEK
+	kmsService, err := services.NewKMSService(cfg)
+	if err != nil {
+		log.Fatalf("❌ Failed to create KMS service: %v", err)
+	}
+	log.Println("✅ KMS service instance created")
+
+	// Initialize audit service gRPC client (optional - for key operation audit events)
+	auditAddr := fmt.Sprintf("%s:%s", cfg.AuditServiceHost, cfg.AuditServicePort)
+	auditClient, err := grpcclient.NewAuditServiceGRPCClient(auditAddr)
+	if err != nil {
+		log.Printf("⚠️  Audit service connection failed: %v (key operation auditing disabled)", err)
+	} else {
+		kmsService.SetAuditClient(auditClient)
+		log.Printf("✅ Audit service connected at %s", auditAddr)
+	}
+
+	// Create gRPC server
+	grpcServer := grpc.NewServer()
+
+	// Register KMS service
+	pb.RegisterKMSServiceServer(grpcServer, kmsService)
+	log.Println("✅ KMS service registered with gRPC server")
+
+	// Register health check service; a sealed KMS cannot wrap or unwrap keys, so it
+	// reports NOT_SERVING until custodians unseal it
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	kmsService.SetSealListener(func(sealed bool) {
+		servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+		if sealed {
+			servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus("", servingStatus)
+		healthServer.SetServingStatus("kms-service", servingStatus)
+	})
+	log.Println("✅ Health check service registered")
+
+	// Register reflection service for debugging
+	reflection.Register(grpcServer)
+	log.Println("✅ gRPC reflection registered")
+
+	// Start gRPC server
+	grpcPort := cfg.KMSServicePort
+	lis, err := net.Listen("tcp", "0.0.0.0:"+grpcPort)
+	if err != nil {
+		log.Fatalf("❌ Failed to listen on port %s: %v", grpcPort, err)
+	}
+
+	log.Printf("🎧 KMS Service listening on 0.0.0.0:%s", grpcPort)
+	log.Println("📡 Ready to accept gRPC requests")
+
+	// Handle graceful shutdown
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	// Start gRPC server in a goroutine
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("❌ Failed to serve gRPC: %v", err)
+		}
+	}()
+
+	// Wait for shutdown signal
+	<-stop
+	log.Println("🛑 Shutdown signal received, gracefully stopping...")
+
+	// Stop gRPC server
+	grpcServer.GracefulStop()
+
+	// Close KMS service
+	if err := kmsService.Close(); err != nil {
+		log.Printf("⚠️  Error closing KMS service: %v", err)
+	}
+
+	if auditClient != nil {
+		auditClient.Close()
+	}
+
+	log.Println("✅ KMS Service stopped")
+}
@@ -0,0 +1,193 @@
+// Command kmsctl runs the KEK key ceremony and lets custodians unseal the KMS service.
+//
+//	kmsctl ceremony -shares 5 -threshold 3 [-existing]
+//	ADMIN_API_KEY=... kmsctl unseal -addr kms:9083 -custodian alice [-reset]
+//	kmsctl status -addr kms:9083
+//
+// Shares and KEKs are read from standard input so they do not end up in shell history.
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
+	"github.com/PlainFunction/mistokenly/internal/common/shamir"
+	pb "github.com/PlainFunction/mistokenly/proto/kms"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `Usage:
+  kmsctl ceremony -shares N -threshold M [-existing]
+      Generate a KEK (or split an existing one read from stdin) into N shares, any M of
+      which unseal it. Prints the KEK fingerprint and the shares; the KEK is not printed.
+  kmsctl unseal -addr HOST:PORT -custodian NAME [-reset]
+      Submit a share read from stdin, or discard the shares submitted so far. The KMS
+      service's admin key is read from ADMIN_API_KEY.
+  kmsctl status -addr HOST:PORT
+      Show whether the KEK is sealed and the unseal progress.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "ceremony":
+		err = runCeremony(os.Args[2:])
+	case "unseal":
+		err = runUnseal(os.Args[2:])
+	case "status":
+		err = runStatus(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runCeremony generates or reads a KEK and splits it into shares
+func runCeremony(args []string) error {
+	flags := flag.NewFlagSet("ceremony", flag.ExitOnError)
+	shares := flags.Int("shares", 5, "number of shares to create, one per custodian")
+	threshold := flags.Int("threshold", 3, "shares needed to unseal")
+	existing := flags.Bool("existing", false, "split the base64 KEK read from stdin instead of generating one")
+	flags.Parse(args)
+
+	var secret []byte
+	if *existing {
+		fmt.Fprintln(os.Stderr, "Enter the base64-encoded KEK:")
+		line, err := readLine()
+		if err != nil {
+			return err
+		}
+		secret, err = base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return fmt.Errorf("invalid base64 KEK: %w", err)
+		}
+		if len(secret) != 32 {
+			return fmt.Errorf("KEK must be 32 bytes, got %d", len(secret))
+		}
+	} else {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("failed to generate KEK: %w", err)
+		}
+	}
+	defer clear(secret)
+
+	parts, err := shamir.Split(secret, *shares, *threshold)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("KEK_FINGERPRINT=%s\n", kek.Fingerprint(secret))
+	fmt.Printf("KEK_UNSEAL_THRESHOLD=%d\n\n", *threshold)
+	fmt.Println("Give each custodian exactly one share. They are not stored anywhere else.")
+	for i, part := range parts {
+		fmt.Printf("Share %d: %s\n", i+1, base64.StdEncoding.EncodeToString(part))
+	}
+
+	return nil
+}
+
+// runUnseal submits one share to the KMS service
+func runUnseal(args []string) error {
+	flags := flag.NewFlagSet("unseal", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:9083", "KMS service address")
+	custodian := flags.String("custodian", "", "name of the custodian submitting the share")
+	reset := flags.Bool("reset", false, "discard the shares submitted so far")
+	flags.Parse(args)
+
+	if *custodian == "" {
+		return fmt.Errorf("-custodian is required")
+	}
+	adminKey := os.Getenv("ADMIN_API_KEY")
+	if adminKey == "" {
+		return fmt.Errorf("ADMIN_API_KEY is required")
+	}
+
+	req := &pb.UnsealRequest{Custodian: *custodian, Reset_: *reset}
+	if !*reset {
+		fmt.Fprintln(os.Stderr, "Enter your share:")
+		line, err := readLine()
+		if err != nil {
+			return err
+		}
+		req.Share, err = base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return fmt.Errorf("invalid base64 share: %w", err)
+		}
+	}
+
+	return withClient(*addr, func(ctx context.Context, client pb.KMSServiceClient) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, kek.AdminKeyMetadata, adminKey)
+		resp, err := client.Unseal(ctx, req)
+		if err != nil {
+			return err
+		}
+		printSealStatus(resp.SealStatus)
+		return nil
+	})
+}
+
+// runStatus prints the seal status of the KMS service
+func runStatus(args []string) error {
+	flags := flag.NewFlagSet("status", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:9083", "KMS service address")
+	flags.Parse(args)
+
+	return withClient(*addr, func(ctx context.Context, client pb.KMSServiceClient) error {
+		resp, err := client.GetSealStatus(ctx, &pb.GetSealStatusRequest{})
+		if err != nil {
+			return err
+		}
+		printSealStatus(resp.SealStatus)
+		return nil
+	})
+}
+
+func withClient(addr string, fn func(ctx context.Context, client pb.KMSServiceClient) error) error {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to KMS service: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return fn(ctx, pb.NewKMSServiceClient(conn))
+}
+
+func printSealStatus(sealStatus *pb.SealStatus) {
+	if sealStatus.GetSealed() {
+		fmt.Printf("🔒 Sealed: %d of %d shares submitted\n", sealStatus.GetProgress(), sealStatus.GetThreshold())
+		return
+	}
+	fmt.Println("🔓 Unsealed")
+}
+
+// readLine reads one trimmed line from stdin
+func readLine() (string, error) {
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read stdin: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/PlainFunction/mistokenly/internal/common/config"
 	"github.com/PlainFunction/mistokenly/internal/common/db"
+	grpcclient "github.com/PlainFunction/mistokenly/internal/common/grpc"
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
 	"github.com/PlainFunction/mistokenly/internal/services"
 	pb "github.com/PlainFunction/mistokenly/proto/persistence"
 	_ "github.com/lib/pq"
@@ -44,11 +46,34 @@ func main() {
 	}
 	log.Println("✅ Persistence service instance created")
 
+	// Connect to the key service when it holds the KEK
+	if cfg.KEKProvider == kek.ProviderRemote {
+		kmsAddr := fmt.Sprintf("%s:%s", cfg.KMSServiceHost, cfg.KMSServicePort)
+		kmsClient, err := grpcclient.NewKMSServiceGRPCClient(kmsAddr, "persistence-service")
+		if err != nil {
+			log.Fatalf("❌ Failed to connect to KMS service: %v", err)
+		}
+		persistenceService.SetKEKProvider(kmsClient)
+		log.Printf("✅ KMS service connected at %s", kmsAddr)
+	}
+
+	// Initialize audit service gRPC client (optional - for lockout audit events)
+	auditAddr := fmt.Sprintf("%s:%s", cfg.AuditServiceHost, cfg.AuditServicePort)
+	auditClient, err := grpcclient.NewAuditServiceGRPCClient(auditAddr)
+	if err != nil {
+		log.Printf("⚠️  Audit service connection failed: %v (lockout auditing disabled)", err)
+	} else {
+		persistenceService.SetAuditClient(auditClient)
+		log.Printf("✅ Audit service connected at %s", auditAddr)
+	}
+
 	// Start PGMQ workers to process queue messages (3 concurrent workers)
 	persistenceService.StartWorkers(3)
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcclient.OrgKeyInterceptor),
+	)
 
 	// Register persistence service
 	pb.RegisterPersistenceServiceServer(grpcServer, persistenceService)
@@ -17,6 +17,7 @@ import (
 	"github.com/PlainFunction/mistokenly/internal/common/config"
 	"github.com/PlainFunction/mistokenly/internal/common/db"
 	grpcserver "github.com/PlainFunction/mistokenly/internal/common/grpc"
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
 	"github.com/PlainFunction/mistokenly/internal/services"
 	pb "github.com/PlainFunction/mistokenly/proto/pii"
 	_ "github.com/lib/pq"
@@ -41,6 +42,17 @@ func main() {
 	}
 	log.Println("✅ PII service instance created")
 
+	// Connect to the key service when it holds the KEK
+	if cfg.KEKProvider == kek.ProviderRemote {
+		kmsAddr := fmt.Sprintf("%s:%s", cfg.KMSServiceHost, cfg.KMSServicePort)
+		kmsClient, err := grpcserver.NewKMSServiceGRPCClient(kmsAddr, "pii-service")
+		if err != nil {
+			log.Fatalf("❌ Failed to connect to KMS service: %v", err)
+		}
+		piiService.SetKEKProvider(kmsClient)
+		log.Printf("✅ KMS service connected at %s", kmsAddr)
+	}
+
 	// Initialize persistence service gRPC client (optional - for cache miss queries)
 	persistenceAddr := fmt.Sprintf("%s:%s", cfg.PersistServiceHost, cfg.PersistServicePort)
 	persistenceClient, err := grpcserver.NewPersistenceServiceGRPCClient(persistenceAddr)
@@ -59,6 +71,8 @@ func main() {
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(10*1024*1024), // 10MB max message size
 		grpc.MaxSendMsgSize(10*1024*1024),
+		grpc.UnaryInterceptor(grpcserver.OrgKeyInterceptor),
+		grpc.StreamInterceptor(grpcserver.StreamOrgKeyInterceptor),
 	)
 
 	// Register PII service
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateAPIKey issues a new API key for a client (admin only). The key is only
+// returned in this response; the gateway stores its hash.
+func (h *Handler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/api-keys"
+
+	var jsonReq struct {
+		ClientID    string `json:"clientId"`
+		Description string `json:"description"`
+		ExpiresAt   string `json:"expiresAt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+	if jsonReq.ClientID == "" {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_API_KEY_REQUEST", "clientId is required")
+		return
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if jsonReq.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, jsonReq.ExpiresAt)
+		if err != nil {
+			h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_API_KEY_REQUEST", "expiresAt must be an RFC 3339 timestamp")
+			return
+		}
+		if !t.After(time.Now()) {
+			h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_API_KEY_REQUEST", "expiresAt must be in the future")
+			return
+		}
+		expiresAt = timestamppb.New(t)
+	}
+
+	key, keyID, err := generateAPIKey()
+	if err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusInternalServerError, "internal_server_error", "API_KEY_REQUEST_FAILED", err.Error())
+		return
+	}
+
+	resp, err := h.persistence.CreateAPIKey(r.Context(), &pbPersistence.CreateAPIKeyRequest{
+		KeyId:       keyID,
+		ClientId:    jsonReq.ClientID,
+		Description: jsonReq.Description,
+		KeyHash:     hashAPIKey(key),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		h.writeAPIKeyError(w, start, "POST", endpoint, err)
+		return
+	}
+	resp.Key = key
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusCreated, resp)
+	h.auditAPIKey(r, "create", resp.ApiKey)
+}
+
+// ListAPIKeys returns the API keys of all clients, or of one client (admin only)
+func (h *Handler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/api-keys"
+
+	req := &pbPersistence.ListAPIKeysRequest{
+		ClientId: r.URL.Query().Get("clientId"),
+	}
+	if includeRevoked := r.URL.Query().Get("includeRevoked"); includeRevoked != "" {
+		req.IncludeRevoked, _ = strconv.ParseBool(includeRevoked)
+	}
+
+	resp, err := h.persistence.ListAPIKeys(r.Context(), req)
+	if err != nil {
+		h.writeAPIKeyError(w, start, "GET", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "GET", endpoint, http.StatusOK, resp)
+}
+
+// RevokeAPIKey permanently disables an API key (admin only)
+func (h *Handler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/api-keys/{keyId}/revoke"
+
+	keyID := mux.Vars(r)["keyId"]
+	resp, err := h.persistence.RevokeAPIKey(r.Context(), &pbPersistence.RevokeAPIKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		h.writeAPIKeyError(w, start, "POST", endpoint, err)
+		return
+	}
+	h.auth.forgetAPIKey(keyID)
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+	h.auditAPIKey(r, "revoke", resp.ApiKey)
+}
+
+// auditAPIKey records an API key lifecycle event
+func (h *Handler) auditAPIKey(r *http.Request, action string, key *pbPersistence.APIKey) {
+	auditReq := &pbAudit.LogAccessRequest{
+		Operation:         "admin",
+		RequestingService: "api-gateway",
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          r.RemoteAddr,
+		Metadata: map[string]string{
+			"action":    "api_key_" + action,
+			"key_id":    key.GetKeyId(),
+			"client_id": key.GetClientId(),
+		},
+	}
+	h.auditService.LogAccess(r.Context(), auditReq)
+}
+
+// writeAPIKeyError maps a gRPC status from an API key RPC to an HTTP error response
+func (h *Handler) writeAPIKeyError(w http.ResponseWriter, start time.Time, method, endpoint string, err error) {
+	message := err.Error()
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		message = grpcErr.GRPCStatus().Message()
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		h.writeError(w, start, method, endpoint, http.StatusBadRequest, "bad_request", "INVALID_API_KEY_REQUEST", message)
+	case codes.NotFound:
+		h.writeError(w, start, method, endpoint, http.StatusNotFound, "not_found", "API_KEY_NOT_FOUND", message)
+	case codes.AlreadyExists:
+		h.writeError(w, start, method, endpoint, http.StatusConflict, "conflict", "API_KEY_EXISTS", message)
+	case codes.Unavailable:
+		h.writeError(w, start, method, endpoint, http.StatusServiceUnavailable, "service_unavailable", "SERVICE_UNAVAILABLE", message)
+	default:
+		h.writeError(w, start, method, endpoint, http.StatusInternalServerError, "internal_server_error", "API_KEY_REQUEST_FAILED", fmt.Sprintf("API key request failed: %v", err))
+	}
+}
@@ -0,0 +1,406 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyPrefix starts every API key, which has the form mtk_<key ID>_<secret>
+const apiKeyPrefix = "mtk_"
+
+// apiKeyCacheTTL bounds how long a verified API key is trusted without asking the
+// persistence service again, and so how long a revoked key keeps working on other
+// gateway replicas
+const apiKeyCacheTTL = 30 * time.Second
+
+// maxAPIKeyCacheEntries triggers a sweep of expired cache entries
+const maxAPIKeyCacheEntries = 10000
+
+// Authentication methods recorded on a principal
+const (
+	authMethodAPIKey = "api_key"
+	authMethodJWT    = "jwt"
+)
+
+// Operations a principal can be permitted, named by the roles claim of a JWT
+const (
+	operationTokenize   = "tokenize"
+	operationDetokenize = "detokenize"
+	operationLookup     = "lookup"
+	operationAudit      = "audit"
+	operationMetrics    = "metrics"
+)
+
+// principal is the authenticated caller of a request: the client ID of its API key
+// or the client claim of its JWT, with the restrictions the JWT's claims carry
+type principal struct {
+	id            string
+	method        string
+	user          string   // Bound to requestingUser, if set
+	organizations []string // Organizations the caller may use; nil means any
+	operations    []string // Operations the caller may perform; nil means all, as for API keys
+}
+
+// principalContextKey is the context key of the request's principal
+type principalContextKey struct{}
+
+// principalFromContext returns the principal set by the authentication middleware
+func principalFromContext(ctx context.Context) (principal, bool) {
+	p, ok := ctx.Value(principalContextKey{}).(principal)
+	return p, ok
+}
+
+// cachedAPIKey is a verified API key held by the authenticator
+type cachedAPIKey struct {
+	keyID    string
+	clientID string
+	expires  time.Time
+}
+
+// claimMapping names the JWT claims mapped onto a principal. Nested claims are
+// named by dotted paths such as "realm_access.roles".
+type claimMapping struct {
+	client       string
+	user         string
+	organization string
+	roles        string
+}
+
+// authenticator verifies the API keys and JWTs of client requests
+type authenticator struct {
+	enabled     bool
+	persistence types.PersistenceServiceInterface
+	jwt         *jwtVerifier
+	claims      claimMapping
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey // By key hash
+}
+
+// newAuthenticator creates an authenticator that verifies API keys with the
+// persistence service and JWTs with the given verifier
+func newAuthenticator(enabled bool, persistence types.PersistenceServiceInterface, jwt *jwtVerifier, claims claimMapping) *authenticator {
+	if !enabled {
+		log.Printf("⚠️  [API] API authentication is disabled; client routes are open to anyone who can reach the gateway")
+	}
+	return &authenticator{
+		enabled:     enabled,
+		persistence: persistence,
+		jwt:         jwt,
+		claims:      claims,
+		cache:       make(map[string]cachedAPIKey),
+	}
+}
+
+// middleware requires an API key or JWT in the Authorization header and stores the
+// authenticated principal in the request context
+func (a *authenticator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := bearerToken(r)
+		if !ok {
+			writeUnauthenticated(w, "AUTHENTICATION_REQUIRED", "An API key or JWT is required in the Authorization header")
+			return
+		}
+
+		var p principal
+		if strings.HasPrefix(token, apiKeyPrefix) {
+			clientID, err := a.verifyAPIKey(r.Context(), token)
+			if status.Code(err) == codes.Unauthenticated {
+				writeUnauthenticated(w, "INVALID_API_KEY", "Invalid API key")
+				return
+			}
+			if err != nil {
+				log.Printf("❌ [API] API key verification failed: %v", err)
+				writeAuthError(w, http.StatusServiceUnavailable, "service_unavailable", "AUTHENTICATION_UNAVAILABLE", "API keys cannot be verified right now")
+				return
+			}
+			p = principal{id: clientID, method: authMethodAPIKey}
+		} else {
+			if !a.jwt.enabled() {
+				writeUnauthenticated(w, "INVALID_TOKEN", "JWT authentication is not configured")
+				return
+			}
+			claims, err := a.jwt.verify(r.Context(), token, time.Now())
+			if err == nil {
+				p, err = a.principalFromClaims(claims)
+			}
+			if err != nil {
+				log.Printf("[API] Rejected JWT: %v", err)
+				writeUnauthenticated(w, "INVALID_TOKEN", "Invalid token")
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalContextKey{}, p)))
+	})
+}
+
+// principalFromClaims maps the claims of a verified JWT onto a principal
+func (a *authenticator) principalFromClaims(claims map[string]interface{}) (principal, error) {
+	p := principal{method: authMethodJWT}
+
+	clients, _ := claimStrings(claims, a.claims.client)
+	if len(clients) != 1 || clients[0] == "" {
+		return p, fmt.Errorf("token has no %q claim", a.claims.client)
+	}
+	p.id = clients[0]
+
+	if a.claims.user != "" {
+		if users, ok := claimStrings(claims, a.claims.user); ok && len(users) == 1 {
+			p.user = users[0]
+		}
+	}
+
+	// A token without the organization claim may use no organization
+	if a.claims.organization != "" {
+		p.organizations = []string{}
+		if organizations, ok := claimStrings(claims, a.claims.organization); ok {
+			p.organizations = organizations
+		}
+	}
+
+	// A token without the roles claim may perform no operation
+	if a.claims.roles != "" {
+		p.operations = []string{}
+		if roles, ok := claimStrings(claims, a.claims.roles); ok {
+			// A single string is a space-separated list, as in the OAuth "scope" claim
+			if len(roles) == 1 {
+				roles = strings.Fields(roles[0])
+			}
+			p.operations = roles
+		}
+	}
+
+	return p, nil
+}
+
+// claimStrings returns a string or string array claim at a dotted path. ok is false
+// when the claim is absent or has another type.
+func claimStrings(claims map[string]interface{}, path string) ([]string, bool) {
+	var value interface{} = claims
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[name]; !ok {
+			return nil, false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, str)
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// requireOperation lets a request through only when its principal may perform the operation
+func requireOperation(operation string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := principalFromContext(r.Context()); ok && p.operations != nil && !slices.Contains(p.operations, operation) {
+			writeAuthError(w, http.StatusForbidden, "forbidden", "OPERATION_NOT_PERMITTED", fmt.Sprintf("The token does not permit %s", operation))
+			return
+		}
+		next(w, r)
+	}
+}
+
+// verifyAPIKey returns the client ID of an API key. Invalid keys fail with an
+// Unauthenticated status.
+func (a *authenticator) verifyAPIKey(ctx context.Context, key string) (string, error) {
+	keyID, ok := parseAPIKey(key)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "malformed API key")
+	}
+	keyHash := hashAPIKey(key)
+	now := time.Now()
+
+	a.mu.Lock()
+	cached, ok := a.cache[keyHash]
+	a.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.clientID, nil
+	}
+
+	resp, err := a.persistence.VerifyAPIKey(ctx, &pbPersistence.VerifyAPIKeyRequest{
+		KeyId:   keyID,
+		KeyHash: keyHash,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Never trust a cached key past its own expiry
+	expires := now.Add(apiKeyCacheTTL)
+	if keyExpiry := resp.ApiKey.GetExpiresAt(); keyExpiry != nil && keyExpiry.AsTime().Before(expires) {
+		expires = keyExpiry.AsTime()
+	}
+
+	a.mu.Lock()
+	if len(a.cache) >= maxAPIKeyCacheEntries {
+		for hash, entry := range a.cache {
+			if !now.Before(entry.expires) {
+				delete(a.cache, hash)
+			}
+		}
+	}
+	a.cache[keyHash] = cachedAPIKey{keyID: keyID, clientID: resp.ApiKey.GetClientId(), expires: expires}
+	a.mu.Unlock()
+
+	return resp.ApiKey.GetClientId(), nil
+}
+
+// forgetAPIKey drops a key from the cache so a revocation takes effect immediately
+// on this gateway
+func (a *authenticator) forgetAPIKey(keyID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for hash, entry := range a.cache {
+		if entry.keyID == keyID {
+			delete(a.cache, hash)
+		}
+	}
+}
+
+// generateAPIKey returns a new API key and its key ID
+func generateAPIKey() (key, keyID string, err error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", fmt.Errorf("failed to generate key ID: %w", err)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate key secret: %w", err)
+	}
+
+	keyID = hex.EncodeToString(id)
+	return apiKeyPrefix + keyID + "_" + base64.RawURLEncoding.EncodeToString(secret), keyID, nil
+}
+
+// parseAPIKey returns the key ID embedded in an API key
+func parseAPIKey(key string) (keyID string, ok bool) {
+	keyID, secret, ok := strings.Cut(strings.TrimPrefix(key, apiKeyPrefix), "_")
+	if !ok || keyID == "" || secret == "" {
+		return "", false
+	}
+	return keyID, true
+}
+
+// hashAPIKey returns the hex SHA-256 digest stored for an API key. API keys carry
+// 256 bits of randomness, so a fast hash is enough.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// bearerToken returns the credential of an "Authorization: Bearer" header
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// writeUnauthenticated rejects a request without valid client credentials
+func writeUnauthenticated(w http.ResponseWriter, code, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="mistokenly"`)
+	writeAuthError(w, http.StatusUnauthorized, "unauthorized", code, message)
+}
+
+// callerFields are the request fields that identify the caller and the data it uses
+type callerFields struct {
+	client       string // clientId or requestingService
+	user         string // requestingUser
+	organization string // organizationId
+}
+
+// bindCaller checks the caller fields of a request against its principal. A field
+// the principal determines must be empty, in which case it is filled in, or match.
+// On a mismatch ok is false and field names the offending request field, which is
+// clientField for the client. Without authentication the fields are used as sent.
+func bindCaller(r *http.Request, clientField string, fields callerFields) (bound callerFields, field string, ok bool) {
+	p, authenticated := principalFromContext(r.Context())
+	if !authenticated {
+		return fields, "", true
+	}
+
+	if bound.client, ok = bindField(fields.client, []string{p.id}); !ok {
+		return fields, clientField, false
+	}
+	var users []string
+	if p.user != "" {
+		users = []string{p.user}
+	}
+	if bound.user, ok = bindField(fields.user, users); !ok {
+		return fields, "requestingUser", false
+	}
+	if bound.organization, ok = bindField(fields.organization, p.organizations); !ok {
+		return fields, "organizationId", false
+	}
+	return bound, "", true
+}
+
+// bindField returns the value of a request field given the values the principal
+// allows, nil meaning any. An empty field takes the only allowed value.
+func bindField(claimed string, allowed []string) (string, bool) {
+	switch {
+	case allowed == nil:
+		return claimed, true
+	case claimed == "":
+		if len(allowed) == 1 {
+			return allowed[0], true
+		}
+		return "", true
+	default:
+		return claimed, slices.Contains(allowed, claimed)
+	}
+}
+
+// callerMismatch returns the error code and message for a request field that does
+// not match the principal
+func callerMismatch(field string) (code, message string) {
+	if field == "organizationId" {
+		return "ORGANIZATION_NOT_PERMITTED", "The authenticated client may not use this organization"
+	}
+	return "PRINCIPAL_MISMATCH", fmt.Sprintf("%s does not match the authenticated client", field)
+}
+
+// writeCallerMismatch rejects a request whose caller fields do not match its principal
+func (h *Handler) writeCallerMismatch(w http.ResponseWriter, start time.Time, method, endpoint, field string) {
+	code, message := callerMismatch(field)
+	h.writeError(w, start, method, endpoint, http.StatusForbidden, "forbidden", code, message)
+}
@@ -0,0 +1,679 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	pbPII "github.com/PlainFunction/mistokenly/proto/pii"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeKeyStore is a persistence service that only knows API keys
+type fakeKeyStore struct {
+	types.PersistenceServiceInterface
+
+	mu       sync.Mutex
+	keys     map[string]*pbPersistence.APIKey // By key hash
+	verifies int
+}
+
+func newFakeKeyStore() *fakeKeyStore {
+	return &fakeKeyStore{keys: make(map[string]*pbPersistence.APIKey)}
+}
+
+// issue creates a key for a client, expiring at expires unless it is zero
+func (f *fakeKeyStore) issue(t *testing.T, clientID string, expires time.Time) (key, keyID string) {
+	t.Helper()
+	key, keyID, err := generateAPIKey()
+	if err != nil {
+		t.Fatalf("generateAPIKey: %v", err)
+	}
+	apiKey := &pbPersistence.APIKey{KeyId: keyID, ClientId: clientID}
+	if !expires.IsZero() {
+		apiKey.ExpiresAt = timestamppb.New(expires)
+	}
+
+	f.mu.Lock()
+	f.keys[hashAPIKey(key)] = apiKey
+	f.mu.Unlock()
+	return key, keyID
+}
+
+func (f *fakeKeyStore) VerifyAPIKey(ctx context.Context, req *pbPersistence.VerifyAPIKeyRequest) (*pbPersistence.VerifyAPIKeyResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.verifies++
+
+	key, ok := f.keys[req.KeyHash]
+	if !ok || key.KeyId != req.KeyId || key.RevokedAt != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid API key")
+	}
+	return &pbPersistence.VerifyAPIKeyResponse{ApiKey: key}, nil
+}
+
+func (f *fakeKeyStore) RevokeAPIKey(ctx context.Context, req *pbPersistence.RevokeAPIKeyRequest) (*pbPersistence.RevokeAPIKeyResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, key := range f.keys {
+		if key.KeyId == req.KeyId {
+			key.RevokedAt = timestamppb.Now()
+			return &pbPersistence.RevokeAPIKeyResponse{ApiKey: key}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "API key not found")
+}
+
+func (f *fakeKeyStore) verifyCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.verifies
+}
+
+// fakeAuditService keeps the entries it is given and records the audit log queries
+// it receives, answering them from the kept entries
+type fakeAuditService struct {
+	types.AuditServiceInterface
+	entries []*pbAudit.AuditLogEntry
+	queries []*pbAudit.GetAuditLogsRequest
+}
+
+func (f *fakeAuditService) LogAccess(ctx context.Context, req *pbAudit.LogAccessRequest) (*pbAudit.LogAccessResponse, error) {
+	f.entries = append(f.entries, &pbAudit.AuditLogEntry{
+		ReferenceHash:     req.ReferenceHash,
+		Operation:         req.Operation,
+		RequestingService: req.RequestingService,
+		RequestingUser:    req.RequestingUser,
+		Timestamp:         req.Timestamp,
+	})
+	return &pbAudit.LogAccessResponse{}, nil
+}
+
+func (f *fakeAuditService) GetAuditLogs(ctx context.Context, req *pbAudit.GetAuditLogsRequest) (*pbAudit.GetAuditLogsResponse, error) {
+	f.queries = append(f.queries, req)
+	resp := &pbAudit.GetAuditLogsResponse{Status: "success"}
+	for _, entry := range f.entries {
+		if req.RequestingService == "" || entry.RequestingService == req.RequestingService {
+			resp.Logs = append(resp.Logs, entry)
+		}
+	}
+	resp.TotalCount = int32(len(resp.Logs))
+	return resp, nil
+}
+
+// fakePIIService tokenizes every value to the same token
+type fakePIIService struct {
+	types.PIIServiceInterface
+}
+
+func (f *fakePIIService) Tokenize(ctx context.Context, req *pbPII.TokenizeRequest) (*pbPII.TokenizeResponse, error) {
+	return &pbPII.TokenizeResponse{ReferenceHash: "tok_0123456789abcdef", Status: "success"}, nil
+}
+
+// newTestHandler returns a handler with unregistered metrics and the given backends
+func newTestHandler(persistence types.PersistenceServiceInterface, audit types.AuditServiceInterface) *Handler {
+	return &Handler{
+		config:       &config.Config{},
+		auditService: audit,
+		persistence:  persistence,
+		auth:         newAuthenticator(true, persistence, &jwtVerifier{}, claimMapping{}),
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_requests_total"},
+			[]string{"method", "endpoint", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_request_duration_seconds"},
+			[]string{"method", "endpoint"}),
+		tokenizeRequests:   prometheus.NewCounter(prometheus.CounterOpts{Name: "test_tokenize_requests_total"}),
+		detokenizeRequests: prometheus.NewCounter(prometheus.CounterOpts{Name: "test_detokenize_requests_total"}),
+	}
+}
+
+// withPrincipal returns a request authenticated as p
+func withPrincipal(r *http.Request, p principal) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), principalContextKey{}, p))
+}
+
+func TestBindField(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		claimed string
+		allowed []string
+		want    string
+		ok      bool
+	}{
+		{"any value, empty", "", nil, "", true},
+		{"any value, set", "crm", nil, "crm", true},
+		{"single value fills in", "", []string{"crm"}, "crm", true},
+		{"single value matches", "crm", []string{"crm"}, "crm", true},
+		{"single value differs", "billing", []string{"crm"}, "billing", false},
+		{"several values stay empty", "", []string{"acme", "globex"}, "", true},
+		{"one of several", "globex", []string{"acme", "globex"}, "globex", true},
+		{"none of several", "initech", []string{"acme", "globex"}, "initech", false},
+		{"empty list allows nothing", "acme", []string{}, "acme", false},
+	} {
+		got, ok := bindField(c.claimed, c.allowed)
+		if got != c.want || ok != c.ok {
+			t.Errorf("%s: bindField(%q, %q) = %q, %v, want %q, %v", c.name, c.claimed, c.allowed, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestBindCaller(t *testing.T) {
+	fields := callerFields{client: "crm", user: "alice", organization: "acme"}
+	r := httptest.NewRequest(http.MethodPost, "/v1/detokenize", nil)
+
+	// Without authentication the fields are used as sent
+	bound, field, ok := bindCaller(r, "requestingService", fields)
+	if !ok || field != "" || bound != fields {
+		t.Errorf("unauthenticated bindCaller = %+v, %q, %v, want the fields unchanged", bound, field, ok)
+	}
+
+	p := principal{id: "crm", method: authMethodJWT, user: "alice", organizations: []string{"acme", "globex"}}
+	for _, c := range []struct {
+		name   string
+		fields callerFields
+		want   callerFields
+		field  string
+	}{
+		{"matching fields", fields, fields, ""},
+		{"empty fields", callerFields{}, callerFields{client: "crm", user: "alice"}, ""},
+		{"other client", callerFields{client: "billing"}, callerFields{}, "requestingService"},
+		{"other user", callerFields{user: "mallory"}, callerFields{}, "requestingUser"},
+		{"other organization", callerFields{organization: "initech"}, callerFields{}, "organizationId"},
+	} {
+		bound, field, ok := bindCaller(withPrincipal(r, p), "requestingService", c.fields)
+		if field != c.field || ok != (c.field == "") {
+			t.Errorf("%s: bindCaller field = %q, %v, want %q", c.name, field, ok, c.field)
+			continue
+		}
+		if ok && bound != c.want {
+			t.Errorf("%s: bindCaller = %+v, want %+v", c.name, bound, c.want)
+		}
+	}
+
+	// An API key principal binds the client only
+	apiKey := principal{id: "crm", method: authMethodAPIKey}
+	bound, _, ok = bindCaller(withPrincipal(r, apiKey), "clientId", callerFields{user: "anyone", organization: "any-org"})
+	if !ok || bound != (callerFields{client: "crm", user: "anyone", organization: "any-org"}) {
+		t.Errorf("API key bindCaller = %+v, %v, want the client filled in", bound, ok)
+	}
+	if _, field, ok := bindCaller(withPrincipal(r, apiKey), "clientId", callerFields{client: "billing"}); ok || field != "clientId" {
+		t.Errorf("API key bindCaller of another client = %q, %v, want clientId rejected", field, ok)
+	}
+}
+
+func TestVerifyAPIKeyCache(t *testing.T) {
+	store := newFakeKeyStore()
+	auth := newAuthenticator(true, store, &jwtVerifier{}, claimMapping{})
+	key, keyID := store.issue(t, "crm", time.Time{})
+
+	for i := 0; i < 3; i++ {
+		clientID, err := auth.verifyAPIKey(context.Background(), key)
+		if err != nil {
+			t.Fatalf("verifyAPIKey: %v", err)
+		}
+		if clientID != "crm" {
+			t.Fatalf("verifyAPIKey = %q, want crm", clientID)
+		}
+	}
+	if n := store.verifyCount(); n != 1 {
+		t.Errorf("persistence verified the key %d times, want 1 while cached", n)
+	}
+
+	// An expired cache entry is verified again
+	auth.mu.Lock()
+	entry := auth.cache[hashAPIKey(key)]
+	if !entry.expires.After(time.Now()) || entry.expires.After(time.Now().Add(apiKeyCacheTTL)) {
+		t.Errorf("cache entry expires at %v, want within %v", entry.expires, apiKeyCacheTTL)
+	}
+	entry.expires = time.Now().Add(-time.Second)
+	auth.cache[hashAPIKey(key)] = entry
+	auth.mu.Unlock()
+
+	if _, err := auth.verifyAPIKey(context.Background(), key); err != nil {
+		t.Fatalf("verifyAPIKey after the cache expired: %v", err)
+	}
+	if n := store.verifyCount(); n != 2 {
+		t.Errorf("persistence verified the key %d times, want 2 after the cache expired", n)
+	}
+
+	// Forgetting another key leaves this one cached
+	auth.forgetAPIKey("0000000000000000")
+	if _, err := auth.verifyAPIKey(context.Background(), key); err != nil {
+		t.Fatalf("verifyAPIKey: %v", err)
+	}
+	if n := store.verifyCount(); n != 2 {
+		t.Errorf("forgetting another key evicted this one")
+	}
+
+	auth.forgetAPIKey(keyID)
+	if _, err := auth.verifyAPIKey(context.Background(), key); err != nil {
+		t.Fatalf("verifyAPIKey after forgetAPIKey: %v", err)
+	}
+	if n := store.verifyCount(); n != 3 {
+		t.Errorf("persistence verified the key %d times, want 3 after forgetAPIKey", n)
+	}
+}
+
+func TestVerifyAPIKeyExpiry(t *testing.T) {
+	store := newFakeKeyStore()
+	auth := newAuthenticator(true, store, &jwtVerifier{}, claimMapping{})
+
+	// A key expiring before the cache TTL is not cached past its expiry
+	expires := time.Now().Add(5 * time.Second)
+	key, _ := store.issue(t, "crm", expires)
+	if _, err := auth.verifyAPIKey(context.Background(), key); err != nil {
+		t.Fatalf("verifyAPIKey: %v", err)
+	}
+
+	auth.mu.Lock()
+	entry := auth.cache[hashAPIKey(key)]
+	auth.mu.Unlock()
+	if entry.expires.After(expires) {
+		t.Errorf("cache entry expires at %v, after the key's expiry %v", entry.expires, expires)
+	}
+}
+
+func TestVerifyAPIKeyInvalid(t *testing.T) {
+	store := newFakeKeyStore()
+	auth := newAuthenticator(true, store, &jwtVerifier{}, claimMapping{})
+	key, _ := store.issue(t, "crm", time.Time{})
+
+	for _, invalid := range []string{"mtk_", "mtk_abc", "mtk__secret", "mtk_abc_", key + "x", "mtk_0000000000000000" + key[len("mtk_")+16:]} {
+		_, err := auth.verifyAPIKey(context.Background(), invalid)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("verifyAPIKey(%q) = %v, want Unauthenticated", invalid, err)
+		}
+	}
+
+	// Rejected keys are not cached
+	auth.mu.Lock()
+	cached := len(auth.cache)
+	auth.mu.Unlock()
+	if cached != 0 {
+		t.Errorf("%d rejected keys were cached", cached)
+	}
+}
+
+func TestRevokeAPIKeyEvictsCache(t *testing.T) {
+	store := newFakeKeyStore()
+	h := newTestHandler(store, &fakeAuditService{})
+	key, keyID := store.issue(t, "crm", time.Time{})
+
+	protected := h.auth.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, _ := principalFromContext(r.Context())
+		if p.id != "crm" || p.method != authMethodAPIKey {
+			t.Errorf("principal = %+v, want the key's client", p)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	call := func() int {
+		r := httptest.NewRequest(http.MethodPost, "/v1/tokenize", nil)
+		r.Header.Set("Authorization", "Bearer "+key)
+		w := httptest.NewRecorder()
+		protected.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := call(); code != http.StatusNoContent {
+		t.Fatalf("request with a valid key = %d, want %d", code, http.StatusNoContent)
+	}
+
+	// Revoking through the admin route takes effect at once, not after the cache TTL
+	r := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys/"+keyID+"/revoke", nil)
+	r = mux.SetURLVars(r, map[string]string{"keyId": keyID})
+	w := httptest.NewRecorder()
+	h.RevokeAPIKey(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("RevokeAPIKey = %d: %s", w.Code, w.Body)
+	}
+
+	if code := call(); code != http.StatusUnauthorized {
+		t.Errorf("request with a revoked key = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
+
+func TestGetAuditLogsBindsCaller(t *testing.T) {
+	audit := &fakeAuditService{}
+	h := newTestHandler(newFakeKeyStore(), audit)
+	p := principal{id: "crm", method: authMethodAPIKey}
+
+	for _, c := range []struct {
+		query string
+		code  int
+		want  string
+	}{
+		{"", http.StatusOK, "crm"},
+		{"?requestingService=crm", http.StatusOK, "crm"},
+		{"?requestingService=billing", http.StatusForbidden, ""},
+	} {
+		audit.queries = nil
+		r := withPrincipal(httptest.NewRequest(http.MethodGet, "/v1/audit/logs"+c.query, nil), p)
+		w := httptest.NewRecorder()
+		h.GetAuditLogs(w, r)
+
+		if w.Code != c.code {
+			t.Errorf("GetAuditLogs%s = %d, want %d", c.query, w.Code, c.code)
+			continue
+		}
+		if c.code != http.StatusOK {
+			var body map[string]string
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil || body["code"] != "PRINCIPAL_MISMATCH" {
+				t.Errorf("GetAuditLogs%s error = %v, %v, want PRINCIPAL_MISMATCH", c.query, body, err)
+			}
+			if len(audit.queries) != 0 {
+				t.Errorf("GetAuditLogs%s queried the audit service", c.query)
+			}
+			continue
+		}
+		if len(audit.queries) != 1 || audit.queries[0].RequestingService != c.want {
+			t.Errorf("GetAuditLogs%s queried %v, want requestingService %q", c.query, audit.queries, c.want)
+		}
+	}
+
+	// Without authentication the filter is used as sent
+	audit.queries = nil
+	w := httptest.NewRecorder()
+	h.GetAuditLogs(w, httptest.NewRequest(http.MethodGet, "/v1/audit/logs?requestingService=billing", nil))
+	if w.Code != http.StatusOK || len(audit.queries) != 1 || audit.queries[0].RequestingService != "billing" {
+		t.Errorf("unauthenticated GetAuditLogs = %d, %v, want the billing filter", w.Code, audit.queries)
+	}
+}
+
+func TestGetAuditLogsFindsOwnEntries(t *testing.T) {
+	audit := &fakeAuditService{}
+	h := newTestHandler(newFakeKeyStore(), audit)
+	h.piiService = &fakePIIService{}
+
+	tokenize := func(p principal) {
+		t.Helper()
+		body := `{"data": "alice@example.com", "dataType": "email", "organizationId": "acme"}`
+		r := withPrincipal(httptest.NewRequest(http.MethodPost, "/v1/tokenize", strings.NewReader(body)), p)
+		r.Header.Set("X-Org-Key", "org-key")
+		w := httptest.NewRecorder()
+		h.Tokenize(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Tokenize as %s = %d: %s", p.id, w.Code, w.Body)
+		}
+	}
+	tokenize(principal{id: "crm", method: authMethodAPIKey})
+	tokenize(principal{id: "billing", method: authMethodAPIKey})
+
+	r := withPrincipal(httptest.NewRequest(http.MethodGet, "/v1/audit/logs", nil), principal{id: "crm", method: authMethodAPIKey})
+	w := httptest.NewRecorder()
+	h.GetAuditLogs(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetAuditLogs = %d: %s", w.Code, w.Body)
+	}
+
+	var resp struct {
+		Logs []struct {
+			ReferenceHash     string `json:"reference_hash"`
+			Operation         string `json:"operation"`
+			RequestingService string `json:"requesting_service"`
+		} `json:"logs"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding GetAuditLogs: %v", err)
+	}
+	if len(resp.Logs) != 1 {
+		t.Fatalf("GetAuditLogs returned %d entries, want crm's tokenization", len(resp.Logs))
+	}
+	if entry := resp.Logs[0]; entry.Operation != "tokenize" || entry.RequestingService != "crm" || entry.ReferenceHash != "tok_0123456789abcdef" {
+		t.Errorf("GetAuditLogs entry = %+v, want crm's tokenization", entry)
+	}
+}
+
+// testClaims returns the claims of a valid token for the test issuer and audience
+func testClaims(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":   "https://idp.test",
+		"aud":   "mistokenly",
+		"azp":   "crm",
+		"sub":   "alice",
+		"exp":   now.Add(time.Hour).Unix(),
+		"org":   []string{"acme"},
+		"roles": []string{"detokenize", "lookup"},
+	}
+}
+
+// newTestJWKSVerifier returns a verifier for tokens signed with the first test key,
+// configured as a deployment would be
+func newTestJWKSVerifier(t *testing.T) *jwtVerifier {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(file, testJWKSDocument(t, testJWK("key-1", testRSAKey(t, 0))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	v, err := newJWTVerifier(&config.Config{
+		JWTJWKSFile:    file,
+		JWTJWKSRefresh: time.Hour,
+		JWTIssuer:      "https://idp.test",
+		JWTAudience:    "mistokenly",
+	})
+	if err != nil {
+		t.Fatalf("newJWTVerifier: %v", err)
+	}
+	return v
+}
+
+func TestJWKSRequiresIssuerAndAudience(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(file, testJWKSDocument(t, testJWK("key-1", testRSAKey(t, 0))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cfg := range []config.Config{
+		{JWTJWKSFile: file},
+		{JWTJWKSFile: file, JWTIssuer: "https://idp.test"},
+		{JWTJWKSFile: file, JWTAudience: "mistokenly"},
+		{JWTJWKSURL: "https://idp.test/jwks", JWTAudience: "mistokenly"},
+	} {
+		if _, err := newJWTVerifier(&cfg); err == nil {
+			t.Errorf("newJWTVerifier accepted a JWKS with issuer %q and audience %q", cfg.JWTIssuer, cfg.JWTAudience)
+		}
+	}
+
+	// A static key or secret may still be used without them
+	if _, err := newJWTVerifier(&config.Config{JWTSecret: strings.Repeat("s", minJWTSecretLength)}); err != nil {
+		t.Errorf("newJWTVerifier with an HS256 secret: %v", err)
+	}
+}
+
+func TestJWTVerifyClaims(t *testing.T) {
+	v := newTestJWKSVerifier(t)
+	key := testRSAKey(t, 0)
+	now := time.Now()
+	ctx := context.Background()
+
+	for _, c := range []struct {
+		name   string
+		modify func(claims map[string]interface{})
+		kid    string
+		valid  bool
+	}{
+		{"valid", func(map[string]interface{}) {}, "key-1", true},
+		{"audience in an array", func(c map[string]interface{}) { c["aud"] = []string{"other", "mistokenly"} }, "key-1", true},
+		{"expired within the leeway", func(c map[string]interface{}) { c["exp"] = now.Add(-jwtLeeway / 2).Unix() }, "key-1", true},
+		{"wrong issuer", func(c map[string]interface{}) { c["iss"] = "https://evil.test" }, "key-1", false},
+		{"no issuer", func(c map[string]interface{}) { delete(c, "iss") }, "key-1", false},
+		{"wrong audience", func(c map[string]interface{}) { c["aud"] = "other-service" }, "key-1", false},
+		{"wrong audiences", func(c map[string]interface{}) { c["aud"] = []string{"a", "b"} }, "key-1", false},
+		{"no audience", func(c map[string]interface{}) { delete(c, "aud") }, "key-1", false},
+		{"expired", func(c map[string]interface{}) { c["exp"] = now.Add(-2 * jwtLeeway).Unix() }, "key-1", false},
+		{"no expiry", func(c map[string]interface{}) { delete(c, "exp") }, "key-1", false},
+		{"not valid yet", func(c map[string]interface{}) { c["nbf"] = now.Add(2 * jwtLeeway).Unix() }, "key-1", false},
+		{"unknown key ID", func(map[string]interface{}) {}, "key-9", false},
+	} {
+		claims := testClaims(now)
+		c.modify(claims)
+		token := signTestJWT(t, key, c.kid, claims)
+
+		_, err := v.verify(ctx, token, now)
+		if c.valid && err != nil {
+			t.Errorf("%s: verify: %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: verify accepted the token", c.name)
+		}
+	}
+
+	// A token signed with another key under a known key ID
+	forged := signTestJWT(t, testRSAKey(t, 1), "key-1", testClaims(now))
+	if _, err := v.verify(ctx, forged, now); err == nil {
+		t.Error("verify accepted a token signed with another key")
+	}
+
+	// Unsigned and HS256 tokens are refused when only RS256 keys are configured
+	token := signTestJWT(t, key, "key-1", testClaims(now))
+	parts := strings.Split(token, ".")
+	for name, header := range map[string]string{"none": `{"alg":"none"}`, "HS256": `{"alg":"HS256","kid":"key-1"}`} {
+		altered := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + parts[1] + "." + parts[2]
+		if _, err := v.verify(ctx, altered, now); err == nil {
+			t.Errorf("verify accepted a token with alg %s", name)
+		}
+	}
+}
+
+func TestPrincipalFromClaims(t *testing.T) {
+	a := newAuthenticator(true, nil, &jwtVerifier{}, claimMapping{client: "azp", user: "sub", organization: "org", roles: "roles"})
+	now := time.Now()
+
+	p, err := a.principalFromClaims(jsonClaims(t, testClaims(now)))
+	if err != nil {
+		t.Fatalf("principalFromClaims: %v", err)
+	}
+	want := principal{id: "crm", method: authMethodJWT, user: "alice", organizations: []string{"acme"}, operations: []string{"detokenize", "lookup"}}
+	if p.id != want.id || p.method != want.method || p.user != want.user ||
+		!slices.Equal(p.organizations, want.organizations) || !slices.Equal(p.operations, want.operations) {
+		t.Errorf("principalFromClaims = %+v, want %+v", p, want)
+	}
+
+	// A space-separated roles string, as in the OAuth scope claim
+	claims := testClaims(now)
+	claims["roles"] = "tokenize audit"
+	if p, err := a.principalFromClaims(jsonClaims(t, claims)); err != nil || !slices.Equal(p.operations, []string{"tokenize", "audit"}) {
+		t.Errorf("operations of a roles string = %q, %v", p.operations, err)
+	}
+
+	// Nested claims are named by dotted paths
+	nested := newAuthenticator(true, nil, &jwtVerifier{}, claimMapping{client: "azp", roles: "realm_access.roles"})
+	claims = testClaims(now)
+	claims["realm_access"] = map[string]interface{}{"roles": []string{"lookup"}}
+	if p, err := nested.principalFromClaims(jsonClaims(t, claims)); err != nil || !slices.Equal(p.operations, []string{"lookup"}) {
+		t.Errorf("operations of a nested roles claim = %q, %v", p.operations, err)
+	}
+
+	// Without a roles claim the token may perform nothing
+	claims = testClaims(now)
+	delete(claims, "roles")
+	p, err = a.principalFromClaims(jsonClaims(t, claims))
+	if err != nil {
+		t.Fatalf("principalFromClaims without roles: %v", err)
+	}
+	if p.operations == nil || len(p.operations) != 0 {
+		t.Errorf("operations without a roles claim = %#v, want none", p.operations)
+	}
+
+	// Nor with a roles claim of the wrong type
+	claims["roles"] = 42
+	if p, err := a.principalFromClaims(jsonClaims(t, claims)); err != nil || p.operations == nil || len(p.operations) != 0 {
+		t.Errorf("operations of a numeric roles claim = %#v, %v, want none", p.operations, err)
+	}
+
+	// Without an organization claim no organization may be used
+	claims = testClaims(now)
+	delete(claims, "org")
+	p, err = a.principalFromClaims(jsonClaims(t, claims))
+	if err != nil || p.organizations == nil || len(p.organizations) != 0 {
+		t.Errorf("organizations without an org claim = %#v, %v, want none", p.organizations, err)
+	}
+	if _, field, ok := bindCaller(withPrincipal(httptest.NewRequest(http.MethodPost, "/v1/detokenize", nil), p), "requestingService", callerFields{organization: "acme"}); ok || field != "organizationId" {
+		t.Errorf("bindCaller without an org claim = %v, %q, want organizationId refused", ok, field)
+	}
+
+	// Unless organization and role checks are turned off
+	unrestricted := newAuthenticator(true, nil, &jwtVerifier{}, claimMapping{client: "azp"})
+	if p, err := unrestricted.principalFromClaims(jsonClaims(t, claims)); err != nil || p.organizations != nil || p.operations != nil {
+		t.Errorf("principal with the checks off = %+v, %v, want any organization and operation", p, err)
+	}
+
+	// The client claim is required
+	claims = testClaims(now)
+	delete(claims, "azp")
+	if _, err := a.principalFromClaims(jsonClaims(t, claims)); err == nil {
+		t.Error("principalFromClaims accepted a token without a client")
+	}
+	claims["azp"] = []string{"crm", "billing"}
+	if _, err := a.principalFromClaims(jsonClaims(t, claims)); err == nil {
+		t.Error("principalFromClaims accepted a token with two clients")
+	}
+}
+
+// jsonClaims round-trips claims through JSON, as verify returns them
+func jsonClaims(t *testing.T, claims map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	var decoded map[string]interface{}
+	if err := decodeJWTPart(encodeTestJWTPart(t, claims), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestJWTMiddleware(t *testing.T) {
+	v := newTestJWKSVerifier(t)
+	a := newAuthenticator(true, newFakeKeyStore(), v, claimMapping{client: "azp", user: "sub", organization: "org", roles: "roles"})
+	key := testRSAKey(t, 0)
+	now := time.Now()
+
+	var seen principal
+	handler := a.middleware(requireOperation(operationDetokenize, func(w http.ResponseWriter, r *http.Request) {
+		seen, _ = principalFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	call := func(claims map[string]interface{}) int {
+		r := httptest.NewRequest(http.MethodPost, "/v1/detokenize", nil)
+		r.Header.Set("Authorization", "Bearer "+signTestJWT(t, key, "key-1", claims))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := call(testClaims(now)); code != http.StatusNoContent {
+		t.Fatalf("request with a valid token = %d, want %d", code, http.StatusNoContent)
+	}
+	if seen.id != "crm" || seen.user != "alice" || seen.method != authMethodJWT {
+		t.Errorf("principal = %+v, want client crm and user alice", seen)
+	}
+
+	claims := testClaims(now)
+	claims["roles"] = []string{"tokenize"}
+	if code := call(claims); code != http.StatusForbidden {
+		t.Errorf("request without the detokenize role = %d, want %d", code, http.StatusForbidden)
+	}
+	delete(claims, "roles")
+	if code := call(claims); code != http.StatusForbidden {
+		t.Errorf("request without a roles claim = %d, want %d", code, http.StatusForbidden)
+	}
+
+	claims = testClaims(now)
+	claims["aud"] = "other-service"
+	if code := call(claims); code != http.StatusUnauthorized {
+		t.Errorf("request with a token for another audience = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TokenizeBatch tokenizes several values of one organization in a single request
+func (h *Handler) TokenizeBatch(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/tokenize/batch"
+
+	var jsonReq struct {
+		Items []struct {
+			Data             string `json:"data"`
+			DataType         string `json:"dataType"`
+			RetentionPolicy  string `json:"retentionPolicy"`
+			TokenFormat      string `json:"tokenFormat"`
+			PreserveBin      bool   `json:"preserveBin"`
+			PreserveLastFour bool   `json:"preserveLastFour"`
+		} `json:"items"`
+		RetentionPolicy string            `json:"retentionPolicy"`
+		ClientID        string            `json:"clientId"`
+		Metadata        map[string]string `json:"metadata"`
+		OrganizationID  string            `json:"organizationId"`
+		OrganizationKey string            `json:"organizationKey"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	caller, field, ok := bindCaller(r, "clientId", callerFields{client: jsonReq.ClientID, organization: jsonReq.OrganizationID})
+	if !ok {
+		h.writeCallerMismatch(w, start, "POST", endpoint, field)
+		return
+	}
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, jsonReq.OrganizationKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", endpoint)
+		return
+	}
+
+	req := &pb.TokenizeBatchRequest{
+		RetentionPolicy: jsonReq.RetentionPolicy,
+		ClientId:        caller.client,
+		Metadata:        jsonReq.Metadata,
+		OrganizationId:  caller.organization,
+		OrganizationKey: organizationKey,
+	}
+	for _, item := range jsonReq.Items {
+		req.Items = append(req.Items, &pb.TokenizeBatchItem{
+			Data:             item.Data,
+			DataType:         item.DataType,
+			RetentionPolicy:  item.RetentionPolicy,
+			TokenFormat:      item.TokenFormat,
+			PreserveBin:      item.PreserveBin,
+			PreserveLastFour: item.PreserveLastFour,
+		})
+	}
+
+	ctx := lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey)
+	resp, err := h.piiService.TokenizeBatch(ctx, req)
+	if accessErr := organizationAccessError(err); accessErr != nil {
+		setRetryAfter(w, err)
+		h.writeError(w, start, "POST", endpoint, accessErr.httpStatus, accessErr.errorType, accessErr.code, accessErr.message)
+		return
+	}
+	if err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusInternalServerError, "internal_server_error", "TOKENIZE_FAILED", fmt.Sprintf("Tokenize batch failed: %v", err))
+		return
+	}
+	if resp.Status == "error" {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "error", "TOKENIZE_ERROR", resp.ErrorMessage)
+		return
+	}
+
+	var tokens []string
+	for _, result := range resp.Results {
+		if result.Status == "success" {
+			tokens = append(tokens, result.ReferenceHash)
+		}
+	}
+	h.tokenizeRequests.Add(float64(len(tokens)))
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+
+	// One audit entry for the batch, listing the tokens it created
+	auditReq := &pbAudit.LogAccessRequest{
+		Operation:         "tokenize",
+		RequestingService: auditRequestingService(req.ClientId),
+		RequestingUser:    req.ClientId,
+		Purpose:           req.RetentionPolicy,
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          r.RemoteAddr,
+		Metadata:          batchAuditMetadata(req.Metadata, len(req.Items), tokens),
+	}
+	h.auditService.LogAccess(ctx, auditReq)
+}
+
+// DetokenizeBatch detokenizes several tokens of one organization in a single request
+func (h *Handler) DetokenizeBatch(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/detokenize/batch"
+
+	var jsonReq struct {
+		ReferenceHashes   []string `json:"referenceHashes"`
+		Purpose           string   `json:"purpose"`
+		RequestingService string   `json:"requestingService"`
+		RequestingUser    string   `json:"requestingUser"`
+		OrganizationID    string   `json:"organizationId"`
+		OrganizationKey   string   `json:"organizationKey"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	caller, field, ok := bindCaller(r, "requestingService", callerFields{client: jsonReq.RequestingService, user: jsonReq.RequestingUser, organization: jsonReq.OrganizationID})
+	if !ok {
+		h.writeCallerMismatch(w, start, "POST", endpoint, field)
+		return
+	}
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, jsonReq.OrganizationKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", endpoint)
+		return
+	}
+
+	req := &pb.DetokenizeBatchRequest{
+		ReferenceHashes:   jsonReq.ReferenceHashes,
+		Purpose:           jsonReq.Purpose,
+		RequestingService: caller.client,
+		RequestingUser:    caller.user,
+		OrganizationId:    caller.organization,
+		OrganizationKey:   organizationKey,
+	}
+
+	ctx := lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey)
+	resp, err := h.piiService.DetokenizeBatch(ctx, req)
+	if accessErr := organizationAccessError(err); accessErr != nil {
+		setRetryAfter(w, err)
+		h.writeError(w, start, "POST", endpoint, accessErr.httpStatus, accessErr.errorType, accessErr.code, accessErr.message)
+		return
+	}
+	if err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusInternalServerError, "internal_server_error", "DETOKENIZE_FAILED", fmt.Sprintf("Detokenize batch failed: %v", err))
+		return
+	}
+	if resp.Status == "error" {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "error", "DETOKENIZE_ERROR", resp.ErrorMessage)
+		return
+	}
+
+	var tokens []string
+	for i, result := range resp.Results {
+		if result.Status == "success" && i < len(req.ReferenceHashes) {
+			tokens = append(tokens, req.ReferenceHashes[i])
+		}
+	}
+	h.detokenizeRequests.Add(float64(len(tokens)))
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+
+	// One audit entry for the batch, listing the tokens it revealed
+	auditReq := &pbAudit.LogAccessRequest{
+		Operation:         "detokenize",
+		RequestingService: auditRequestingService(req.RequestingService),
+		RequestingUser:    req.RequestingUser,
+		Purpose:           req.Purpose,
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          r.RemoteAddr,
+		Metadata:          batchAuditMetadata(nil, len(req.ReferenceHashes), tokens),
+	}
+	h.auditService.LogAccess(ctx, auditReq)
+}
+
+// batchAuditMetadata adds the size of a batch and its successful tokens to the
+// caller's audit metadata
+func batchAuditMetadata(metadata map[string]string, items int, tokens []string) map[string]string {
+	out := make(map[string]string, len(metadata)+3)
+	maps.Copy(out, metadata)
+	out["batch_items"] = strconv.Itoa(items)
+	out["batch_succeeded"] = strconv.Itoa(len(tokens))
+	out["reference_hashes"] = strings.Join(tokens, ",")
+	return out
+}
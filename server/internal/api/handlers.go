@@ -3,17 +3,22 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/PlainFunction/mistokenly/internal/common/config"
 	"github.com/PlainFunction/mistokenly/internal/common/grpc"
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
 	"github.com/PlainFunction/mistokenly/internal/common/types"
 	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
 	pb "github.com/PlainFunction/mistokenly/proto/pii"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -23,6 +28,8 @@ type Handler struct {
 	piiService   grpc.PIIServiceInterface
 	registry     *grpc.ServiceRegistry
 	auditService types.AuditServiceInterface
+	persistence  types.PersistenceServiceInterface
+	auth         *authenticator
 
 	// Prometheus metrics
 	requestsTotal      *prometheus.CounterVec
@@ -46,6 +53,23 @@ func NewHandler(cfg *config.Config) *Handler {
 		panic("Failed to get Audit service client: " + err.Error())
 	}
 
+	// Get Persistence service client, which stores API keys
+	persistence, err := registry.GetPersistenceServiceClient()
+	if err != nil {
+		panic("Failed to get Persistence service client: " + err.Error())
+	}
+
+	jwt, err := newJWTVerifier(cfg)
+	if err != nil {
+		panic("Failed to configure JWT authentication: " + err.Error())
+	}
+	claims := claimMapping{
+		client:       cfg.JWTClientClaim,
+		user:         cfg.JWTUserClaim,
+		organization: cfg.JWTOrganizationClaim,
+		roles:        cfg.JWTRolesClaim,
+	}
+
 	// Initialize Prometheus metrics
 	requestsTotal := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -86,6 +110,8 @@ func NewHandler(cfg *config.Config) *Handler {
 		piiService:         piiService,
 		registry:           registry,
 		auditService:       auditService,
+		persistence:        persistence,
+		auth:               newAuthenticator(cfg.APIAuthEnabled, persistence, jwt, claims),
 		requestsTotal:      requestsTotal,
 		requestDuration:    requestDuration,
 		tokenizeRequests:   tokenizeRequests,
@@ -178,8 +204,27 @@ func (h *Handler) Tokenize(w http.ResponseWriter, r *http.Request) {
 	if organizationId, ok := jsonReq["organizationId"].(string); ok {
 		req.OrganizationId = organizationId
 	}
-	if organizationKey, ok := jsonReq["organizationKey"].(string); ok {
-		req.OrganizationKey = organizationKey
+	caller, field, ok := bindCaller(r, "clientId", callerFields{client: req.ClientId, organization: req.OrganizationId})
+	if !ok {
+		h.writeCallerMismatch(w, start, "POST", "/tokenize", field)
+		return
+	}
+	req.ClientId, req.OrganizationId = caller.client, caller.organization
+	bodyKey, _ := jsonReq["organizationKey"].(string)
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, bodyKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", "/tokenize")
+		return
+	}
+	req.OrganizationKey = organizationKey
+	if tokenFormat, ok := jsonReq["tokenFormat"].(string); ok {
+		req.TokenFormat = tokenFormat
+	}
+	if preserveBin, ok := jsonReq["preserveBin"].(bool); ok {
+		req.PreserveBin = preserveBin
+	}
+	if preserveLastFour, ok := jsonReq["preserveLastFour"].(bool); ok {
+		req.PreserveLastFour = preserveLastFour
 	}
 	if metadata, ok := jsonReq["metadata"].(map[string]interface{}); ok {
 		req.Metadata = make(map[string]string)
@@ -190,8 +235,22 @@ func (h *Handler) Tokenize(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	ctx := r.Context()
+	ctx := lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey)
 	resp, err := h.piiService.Tokenize(ctx, req)
+	if accessErr := organizationAccessError(err); accessErr != nil {
+		h.requestsTotal.WithLabelValues("POST", "/tokenize", strconv.Itoa(accessErr.httpStatus)).Inc()
+		h.requestDuration.WithLabelValues("POST", "/tokenize").Observe(time.Since(start).Seconds())
+		errorResp := map[string]interface{}{
+			"error":   accessErr.errorType,
+			"code":    accessErr.code,
+			"message": accessErr.message,
+		}
+		setRetryAfter(w, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(accessErr.httpStatus)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
 	if err != nil {
 		h.requestsTotal.WithLabelValues("POST", "/tokenize", "500").Inc()
 		h.requestDuration.WithLabelValues("POST", "/tokenize").Observe(time.Since(start).Seconds())
@@ -249,7 +308,7 @@ func (h *Handler) Tokenize(w http.ResponseWriter, r *http.Request) {
 	auditReq := &pbAudit.LogAccessRequest{
 		ReferenceHash:     resp.ReferenceHash,
 		Operation:         "tokenize",
-		RequestingService: "api-gateway",
+		RequestingService: auditRequestingService(req.ClientId),
 		RequestingUser:    req.ClientId,
 		Purpose:           req.RetentionPolicy,
 		Timestamp:         timestamppb.New(time.Now()),
@@ -296,12 +355,36 @@ func (h *Handler) Detokenize(w http.ResponseWriter, r *http.Request) {
 	if organizationId, ok := jsonReq["organizationId"].(string); ok {
 		req.OrganizationId = organizationId
 	}
-	if organizationKey, ok := jsonReq["organizationKey"].(string); ok {
-		req.OrganizationKey = organizationKey
+	caller, field, ok := bindCaller(r, "requestingService", callerFields{client: req.RequestingService, user: req.RequestingUser, organization: req.OrganizationId})
+	if !ok {
+		h.writeCallerMismatch(w, start, "POST", "/detokenize", field)
+		return
+	}
+	req.RequestingService, req.RequestingUser, req.OrganizationId = caller.client, caller.user, caller.organization
+	bodyKey, _ := jsonReq["organizationKey"].(string)
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, bodyKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", "/detokenize")
+		return
 	}
+	req.OrganizationKey = organizationKey
 
-	ctx := r.Context()
+	ctx := lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey)
 	resp, err := h.piiService.Detokenize(ctx, req)
+	if accessErr := organizationAccessError(err); accessErr != nil {
+		h.requestsTotal.WithLabelValues("POST", "/detokenize", strconv.Itoa(accessErr.httpStatus)).Inc()
+		h.requestDuration.WithLabelValues("POST", "/detokenize").Observe(time.Since(start).Seconds())
+		errorResp := map[string]interface{}{
+			"error":   accessErr.errorType,
+			"code":    accessErr.code,
+			"message": accessErr.message,
+		}
+		setRetryAfter(w, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(accessErr.httpStatus)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
 	if err != nil {
 		h.requestsTotal.WithLabelValues("POST", "/detokenize", "500").Inc()
 		h.requestDuration.WithLabelValues("POST", "/detokenize").Observe(time.Since(start).Seconds())
@@ -359,7 +442,7 @@ func (h *Handler) Detokenize(w http.ResponseWriter, r *http.Request) {
 	auditReq := &pbAudit.LogAccessRequest{
 		ReferenceHash:     req.ReferenceHash,
 		Operation:         "detokenize",
-		RequestingService: "api-gateway",
+		RequestingService: auditRequestingService(req.RequestingService),
 		RequestingUser:    req.RequestingUser,
 		Purpose:           req.Purpose,
 		Timestamp:         timestamppb.New(time.Now()),
@@ -369,10 +452,211 @@ func (h *Handler) Detokenize(w http.ResponseWriter, r *http.Request) {
 	h.auditService.LogAccess(ctx, auditReq)
 }
 
+// LookupToken finds the live tokens of a known value
+func (h *Handler) LookupToken(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	req := &pb.LookupTokenRequest{}
+	decoder := json.NewDecoder(r.Body)
+	var jsonReq map[string]interface{}
+	if err := decoder.Decode(&jsonReq); err != nil {
+		h.requestsTotal.WithLabelValues("POST", "/lookup", "400").Inc()
+		h.requestDuration.WithLabelValues("POST", "/lookup").Observe(time.Since(start).Seconds())
+		errorResp := map[string]interface{}{
+			"error":   "bad_request",
+			"code":    "INVALID_REQUEST_BODY",
+			"message": "Invalid request body",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
+
+	// Manually map JSON fields to protobuf (handles camelCase to snake_case)
+	if data, ok := jsonReq["data"].(string); ok {
+		req.Data = data
+	}
+	if dataType, ok := jsonReq["dataType"].(string); ok {
+		req.DataType = dataType
+	}
+	if purpose, ok := jsonReq["purpose"].(string); ok {
+		req.Purpose = purpose
+	}
+	if requestingService, ok := jsonReq["requestingService"].(string); ok {
+		req.RequestingService = requestingService
+	}
+	if requestingUser, ok := jsonReq["requestingUser"].(string); ok {
+		req.RequestingUser = requestingUser
+	}
+	if organizationId, ok := jsonReq["organizationId"].(string); ok {
+		req.OrganizationId = organizationId
+	}
+	caller, field, ok := bindCaller(r, "requestingService", callerFields{client: req.RequestingService, user: req.RequestingUser, organization: req.OrganizationId})
+	if !ok {
+		h.writeCallerMismatch(w, start, "POST", "/lookup", field)
+		return
+	}
+	req.RequestingService, req.RequestingUser, req.OrganizationId = caller.client, caller.user, caller.organization
+	bodyKey, _ := jsonReq["organizationKey"].(string)
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, bodyKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", "/lookup")
+		return
+	}
+	req.OrganizationKey = organizationKey
+
+	ctx := lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey)
+	resp, err := h.piiService.LookupToken(ctx, req)
+	if accessErr := organizationAccessError(err); accessErr != nil {
+		h.requestsTotal.WithLabelValues("POST", "/lookup", strconv.Itoa(accessErr.httpStatus)).Inc()
+		h.requestDuration.WithLabelValues("POST", "/lookup").Observe(time.Since(start).Seconds())
+		errorResp := map[string]interface{}{
+			"error":   accessErr.errorType,
+			"code":    accessErr.code,
+			"message": accessErr.message,
+		}
+		setRetryAfter(w, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(accessErr.httpStatus)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
+	if err != nil {
+		h.requestsTotal.WithLabelValues("POST", "/lookup", "500").Inc()
+		h.requestDuration.WithLabelValues("POST", "/lookup").Observe(time.Since(start).Seconds())
+		errorResp := map[string]interface{}{
+			"error":   "internal_server_error",
+			"code":    "LOOKUP_FAILED",
+			"message": fmt.Sprintf("Lookup failed: %v", err),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
+
+	// Check for application-level errors
+	if resp.Status == "error" {
+		h.requestsTotal.WithLabelValues("POST", "/lookup", "400").Inc()
+		h.requestDuration.WithLabelValues("POST", "/lookup").Observe(time.Since(start).Seconds())
+		errorResp := map[string]string{
+			"error":   "error",
+			"code":    "LOOKUP_ERROR",
+			"message": resp.ErrorMessage,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
+
+	// Convert protobuf to JSON
+	jsonBytes, err := protojson.Marshal(resp)
+	if err != nil {
+		h.requestsTotal.WithLabelValues("POST", "/lookup", "500").Inc()
+		h.requestDuration.WithLabelValues("POST", "/lookup").Observe(time.Since(start).Seconds())
+		errorResp := map[string]interface{}{
+			"error":   "internal_server_error",
+			"code":    "MARSHAL_FAILED",
+			"message": fmt.Sprintf("Failed to marshal response: %v", err),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(errorResp)
+		return
+	}
+
+	h.requestsTotal.WithLabelValues("POST", "/lookup", "200").Inc()
+	h.requestDuration.WithLabelValues("POST", "/lookup").Observe(time.Since(start).Seconds())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes)
+
+	// Audit log for the lookup; the looked-up value is not recorded
+	auditReq := &pbAudit.LogAccessRequest{
+		Operation:         "lookup",
+		RequestingService: auditRequestingService(req.RequestingService),
+		RequestingUser:    req.RequestingUser,
+		Purpose:           req.Purpose,
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          r.RemoteAddr,
+		Metadata: map[string]string{
+			"data_type": req.DataType,
+			"matches":   strconv.Itoa(len(resp.Tokens)),
+		},
+	}
+	h.auditService.LogAccess(ctx, auditReq)
+}
+
+// apiError describes an error response derived from a gRPC status
+type apiError struct {
+	httpStatus int
+	errorType  string
+	code       string
+	message    string
+}
+
+// organizationAccessError maps the gRPC status returned when an organization may not
+// be used (unknown, suspended or wrong key) to its HTTP error, or returns nil
+func organizationAccessError(err error) *apiError {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return &apiError{http.StatusUnauthorized, "unauthorized", "INVALID_ORGANIZATION_KEY", "Invalid organization key"}
+	case codes.NotFound:
+		return &apiError{http.StatusNotFound, "not_found", "ORGANIZATION_NOT_FOUND", "Organization not found"}
+	case codes.FailedPrecondition:
+		return &apiError{http.StatusForbidden, "forbidden", "ORGANIZATION_SUSPENDED", "Organization is suspended"}
+	case codes.ResourceExhausted:
+		return &apiError{http.StatusTooManyRequests, "too_many_requests", "ORGANIZATION_LOCKED", "Too many failed organization key attempts"}
+	default:
+		return nil
+	}
+}
+
+// setRetryAfter sets the Retry-After header when err carries a lockout duration
+func setRetryAfter(w http.ResponseWriter, err error) {
+	if retryAfter := types.RetryAfter(err); retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+}
+
+// requestOrganizationKey returns the organization key sent in the given header, or
+// else the one from the request body. ok is false when the body carries a key and
+// the gateway is configured to reject body keys.
+func (h *Handler) requestOrganizationKey(r *http.Request, header, bodyKey string) (key string, ok bool) {
+	if bodyKey != "" && h.config.RejectBodyOrganizationKey {
+		return "", false
+	}
+	if key := r.Header.Get(header); key != "" {
+		return key, true
+	}
+	return bodyKey, true
+}
+
+// writeOrganizationKeyInBody rejects a request that sent an organization key in its body
+func (h *Handler) writeOrganizationKeyInBody(w http.ResponseWriter, start time.Time, method, endpoint string) {
+	h.writeError(w, start, method, endpoint, http.StatusBadRequest, "bad_request", "ORGANIZATION_KEY_IN_BODY", organizationKeyInBodyMessage)
+}
+
+// organizationKeyInBodyMessage explains a rejected body organization key
+const organizationKeyInBodyMessage = "Organization keys must be sent in the X-Org-Key and X-New-Org-Key headers, not the request body"
+
 func (h *Handler) Metrics(w http.ResponseWriter, r *http.Request) {
 	promhttp.Handler().ServeHTTP(w, r)
 }
 
+// auditRequestingService returns the requesting service of an audit entry written by
+// the gateway: the request's bound client, so GetAuditLogs finds the entry for the
+// authenticated client that caused it, or "api-gateway" if the request named none
+func auditRequestingService(client string) string {
+	if client == "" {
+		return "api-gateway"
+	}
+	return client
+}
+
 func (h *Handler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 
@@ -395,6 +679,14 @@ func (h *Handler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
 	req.ReferenceHash = r.URL.Query().Get("referenceHash")
 	req.RequestingService = r.URL.Query().Get("requestingService")
 
+	// Authenticated clients only see their own entries
+	caller, field, ok := bindCaller(r, "requestingService", callerFields{client: req.RequestingService})
+	if !ok {
+		h.writeCallerMismatch(w, start, "GET", "/audit/logs", field)
+		return
+	}
+	req.RequestingService = caller.client
+
 	// Parse limit and offset
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
 		if limit, err := strconv.Atoi(limitStr); err == nil && limit > 0 {
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// minJWKSReloadInterval limits reloads triggered by unknown key IDs, so tokens with
+// made-up key IDs cannot make the gateway hammer the OIDC provider
+const minJWKSReloadInterval = 30 * time.Second
+
+// maxJWKSSize bounds the size of a JWKS document
+const maxJWKSSize = 1 << 20
+
+// jwk is a JSON Web Key; only RSA signing keys are used
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// jwks holds the RS256 keys of a JSON Web Key Set loaded from a URL or a file. The
+// set is reloaded once it is older than the refresh interval, or early when a token
+// names a key it does not have, so keys rotated by the provider are picked up. The
+// last good set stays in use while the source is unreachable.
+type jwks struct {
+	url     string
+	file    string
+	refresh time.Duration
+	client  *http.Client
+
+	reloadMu sync.Mutex // Serializes reloads
+
+	mu          sync.RWMutex
+	keys        map[string]*rsa.PublicKey // By key ID
+	loadedAt    time.Time                 // Last successful load
+	attemptedAt time.Time                 // Last load attempt
+}
+
+// newJWKS loads a key set from a URL or a file. A URL that cannot be reached yet
+// is retried when the first token arrives; a file must load.
+func newJWKS(url, file string, refresh time.Duration) (*jwks, error) {
+	j := &jwks{
+		url:     url,
+		file:    file,
+		refresh: refresh,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		keys:    make(map[string]*rsa.PublicKey),
+	}
+
+	if err := j.reload(context.Background()); err != nil {
+		if file != "" {
+			return nil, err
+		}
+		log.Printf("⚠️  [API] Failed to load JWKS from %s, will retry: %v", url, err)
+	}
+	return j, nil
+}
+
+// source names where the key set is loaded from
+func (j *jwks) source() string {
+	if j.file != "" {
+		return j.file
+	}
+	return j.url
+}
+
+// key returns the RSA key with the given key ID. A token without a key ID can only
+// use a set with a single key.
+func (j *jwks) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	now := time.Now()
+
+	j.mu.RLock()
+	key, found := j.lookup(kid)
+	stale := now.Sub(j.loadedAt) > j.refresh
+	j.mu.RUnlock()
+
+	if found && !stale {
+		return key, nil
+	}
+
+	if err := j.reload(ctx); err != nil && !errors.Is(err, errJWKSReloadTooSoon) {
+		log.Printf("⚠️  [API] Failed to reload JWKS from %s: %v", j.source(), err)
+	}
+
+	j.mu.RLock()
+	key, found = j.lookup(kid)
+	j.mu.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// lookup finds a key; the caller must hold j.mu
+func (j *jwks) lookup(kid string) (*rsa.PublicKey, bool) {
+	if key, ok := j.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(j.keys) == 1 {
+		for _, key := range j.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// errJWKSReloadTooSoon is returned by reload within minJWKSReloadInterval of the last attempt
+var errJWKSReloadTooSoon = errors.New("JWKS was reloaded moments ago")
+
+// reload replaces the key set with a fresh copy from its source
+func (j *jwks) reload(ctx context.Context) error {
+	j.reloadMu.Lock()
+	defer j.reloadMu.Unlock()
+
+	j.mu.RLock()
+	attemptedAt := j.attemptedAt
+	j.mu.RUnlock()
+	if time.Since(attemptedAt) < minJWKSReloadInterval {
+		return errJWKSReloadTooSoon
+	}
+
+	j.mu.Lock()
+	j.attemptedAt = time.Now()
+	j.mu.Unlock()
+
+	data, err := j.fetch(context.WithoutCancel(ctx))
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	j.keys = keys
+	j.loadedAt = time.Now()
+	j.mu.Unlock()
+
+	log.Printf("[API] Loaded %d signing keys from %s", len(keys), j.source())
+	return nil
+}
+
+// fetch reads the JWKS document from its file or URL
+func (j *jwks) fetch(ctx context.Context) ([]byte, error) {
+	if j.file != "" {
+		data, err := os.ReadFile(j.file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWKS: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS URL: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	if len(data) > maxJWKSSize {
+		return nil, fmt.Errorf("JWKS is larger than %d bytes", maxJWKSSize)
+	}
+	return data, nil
+}
+
+// parseJWKS returns the RS256 signing keys of a JWKS document by key ID. Keys of
+// other types, algorithms or uses are skipped.
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") || (k.Alg != "" && k.Alg != "RS256") {
+			continue
+		}
+		key, err := k.rsaPublicKey()
+		if err != nil {
+			log.Printf("⚠️  [API] Skipping JWKS key %q: %v", k.Kid, err)
+			continue
+		}
+		keys[k.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS has no RS256 signing keys")
+	}
+	return keys, nil
+}
+
+// rsaPublicKey decodes the modulus and exponent of an RSA JWK
+func (k jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil || len(n) == 0 {
+		return nil, errors.New("invalid modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	key := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}
+	if key.N.BitLen() < 2048 {
+		return nil, errors.New("modulus is shorter than 2048 bits")
+	}
+	if key.E < 3 || key.E%2 == 0 {
+		return nil, errors.New("invalid exponent")
+	}
+	return key, nil
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	testRSAKeysOnce sync.Once
+	testRSAKeys     []*rsa.PrivateKey
+)
+
+// testRSAKey returns one of three RSA keys shared by the tests; generating them is slow
+func testRSAKey(t *testing.T, i int) *rsa.PrivateKey {
+	t.Helper()
+	testRSAKeysOnce.Do(func() {
+		for range 3 {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			if err != nil {
+				panic(err)
+			}
+			testRSAKeys = append(testRSAKeys, key)
+		}
+	})
+	return testRSAKeys[i]
+}
+
+// testJWK returns the public JWK of a key, as a provider publishes it
+func testJWK(kid string, key *rsa.PrivateKey) jwk {
+	return jwk{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// testJWKSDocument encodes a JWKS document
+func testJWKSDocument(t *testing.T, keys ...jwk) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// signTestJWT signs a compact RS256 JWT, naming kid in its header unless it is empty
+func signTestJWT(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	signed := encodeTestJWTPart(t, header) + "." + encodeTestJWTPart(t, claims)
+
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeTestJWTPart(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// allowJWKSReload lets the next reload through the minJWKSReloadInterval limit
+func allowJWKSReload(j *jwks) {
+	j.mu.Lock()
+	j.attemptedAt = time.Now().Add(-minJWKSReloadInterval)
+	j.mu.Unlock()
+}
+
+// jwksServer serves a JWKS document that the test can replace, counting requests
+type jwksServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	document []byte
+	status   int
+	requests int
+}
+
+func newJWKSServer(t *testing.T, document []byte) *jwksServer {
+	s := &jwksServer{document: document, status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests++
+		w.WriteHeader(s.status)
+		if s.status == http.StatusOK {
+			w.Write(s.document)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) serve(status int, document []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status, s.document = status, document
+}
+
+func (s *jwksServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func TestJWKSKeyRotationFromFile(t *testing.T) {
+	key1, key2 := testRSAKey(t, 0), testRSAKey(t, 1)
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(file, testJWKSDocument(t, testJWK("key-1", key1)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := newJWKS("", file, time.Hour)
+	if err != nil {
+		t.Fatalf("newJWKS: %v", err)
+	}
+	ctx := context.Background()
+
+	got, err := set.key(ctx, "key-1")
+	if err != nil || !got.Equal(&key1.PublicKey) {
+		t.Fatalf("key(key-1) = %v, %v, want the first key", got, err)
+	}
+	// A set with a single key also serves tokens without a key ID
+	if got, err := set.key(ctx, ""); err != nil || !got.Equal(&key1.PublicKey) {
+		t.Errorf("key(\"\") = %v, %v, want the only key", got, err)
+	}
+
+	// The provider rotates: key-2 is added next to key-1
+	if err := os.WriteFile(file, testJWKSDocument(t, testJWK("key-1", key1), testJWK("key-2", key2)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Right after a load, an unknown key ID does not trigger another one
+	if _, err := set.key(ctx, "key-2"); err == nil {
+		t.Error("key(key-2) reloaded the JWKS within minJWKSReloadInterval")
+	}
+
+	allowJWKSReload(set)
+	if got, err := set.key(ctx, "key-2"); err != nil || !got.Equal(&key2.PublicKey) {
+		t.Fatalf("key(key-2) after the rotation = %v, %v, want the new key", got, err)
+	}
+	if got, err := set.key(ctx, "key-1"); err != nil || !got.Equal(&key1.PublicKey) {
+		t.Errorf("key(key-1) after the rotation = %v, %v, want the old key kept", got, err)
+	}
+	// With two keys, a token must name one
+	if _, err := set.key(ctx, ""); err == nil {
+		t.Error("key(\"\") picked a key from a set of two")
+	}
+}
+
+func TestJWKSReloadFromURL(t *testing.T) {
+	key1, key2 := testRSAKey(t, 0), testRSAKey(t, 1)
+	server := newJWKSServer(t, testJWKSDocument(t, testJWK("key-1", key1)))
+
+	set, err := newJWKS(server.URL, "", time.Hour)
+	if err != nil {
+		t.Fatalf("newJWKS: %v", err)
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := set.key(ctx, "key-1"); err != nil {
+			t.Fatalf("key(key-1): %v", err)
+		}
+	}
+	if n := server.requestCount(); n != 1 {
+		t.Errorf("JWKS was fetched %d times for a known key, want 1", n)
+	}
+
+	// Made-up key IDs cause at most one fetch per minJWKSReloadInterval
+	allowJWKSReload(set)
+	for _, kid := range []string{"forged-1", "forged-2", "forged-3"} {
+		if _, err := set.key(ctx, kid); err == nil {
+			t.Errorf("key(%s) succeeded", kid)
+		}
+	}
+	if n := server.requestCount(); n != 2 {
+		t.Errorf("JWKS was fetched %d times, want 2 with the reload rate-limited", n)
+	}
+
+	// Once the set is older than the refresh interval it is reloaded, so a retired key goes away
+	server.serve(http.StatusOK, testJWKSDocument(t, testJWK("key-2", key2)))
+	set.refresh = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	allowJWKSReload(set)
+	if _, err := set.key(ctx, "key-1"); err == nil {
+		t.Error("key(key-1) succeeded after the provider retired it")
+	}
+	if got, err := set.key(ctx, "key-2"); err != nil || !got.Equal(&key2.PublicKey) {
+		t.Errorf("key(key-2) = %v, %v, want the new key", got, err)
+	}
+
+	// While the provider is down, the last loaded keys stay in use
+	server.serve(http.StatusInternalServerError, nil)
+	time.Sleep(2 * time.Millisecond)
+	allowJWKSReload(set)
+	if _, err := set.key(ctx, "key-2"); err != nil {
+		t.Errorf("key(key-2) with the provider down: %v", err)
+	}
+}
+
+func TestJWKSUnreachableAtStartup(t *testing.T) {
+	key1 := testRSAKey(t, 0)
+	server := newJWKSServer(t, nil)
+	server.serve(http.StatusServiceUnavailable, nil)
+
+	// A URL is retried later; a file must load
+	set, err := newJWKS(server.URL, "", time.Hour)
+	if err != nil {
+		t.Fatalf("newJWKS with the provider down: %v", err)
+	}
+	if _, err := newJWKS("", filepath.Join(t.TempDir(), "missing.json"), time.Hour); err == nil {
+		t.Error("newJWKS accepted a missing file")
+	}
+
+	server.serve(http.StatusOK, testJWKSDocument(t, testJWK("key-1", key1)))
+	allowJWKSReload(set)
+	if _, err := set.key(context.Background(), "key-1"); err != nil {
+		t.Errorf("key(key-1) once the provider is up: %v", err)
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	key := testRSAKey(t, 0)
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encryption := testJWK("enc", key)
+	encryption.Use = "enc"
+	otherAlg := testJWK("ps256", key)
+	otherAlg.Alg = "PS256"
+	ec := jwk{Kty: "EC", Kid: "ec"}
+	unspecified := testJWK("plain", key)
+	unspecified.Use, unspecified.Alg = "", ""
+
+	keys, err := parseJWKS(testJWKSDocument(t, testJWK("sig", key), encryption, otherAlg, ec, testJWK("small", small), unspecified))
+	if err != nil {
+		t.Fatalf("parseJWKS: %v", err)
+	}
+	if len(keys) != 2 || keys["sig"] == nil || keys["plain"] == nil {
+		t.Errorf("parseJWKS kept %d keys, want sig and plain", len(keys))
+	}
+
+	for name, document := range map[string][]byte{
+		"no usable keys": testJWKSDocument(t, encryption, testJWK("small", small)),
+		"empty":          testJWKSDocument(t),
+		"not JSON":       []byte("<html>"),
+	} {
+		if _, err := parseJWKS(document); err == nil {
+			t.Errorf("parseJWKS accepted a JWKS with %s", name)
+		}
+	}
+}
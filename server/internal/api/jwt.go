@@ -0,0 +1,256 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+)
+
+// jwtLeeway tolerates clock skew between the token issuer and the gateway
+const jwtLeeway = time.Minute
+
+// minJWTSecretLength is the HS256 secret length below which a warning is logged
+const minJWTSecretLength = 32
+
+// jwtVerifier checks the signature and claims of HS256 and RS256 JWTs
+type jwtVerifier struct {
+	secret    []byte         // HS256 secret; HS256 tokens are refused when nil
+	publicKey *rsa.PublicKey // Static RS256 key
+	jwks      *jwks          // RS256 keys by key ID; RS256 tokens are refused when neither is set
+	issuer    string         // Required "iss", if set
+	audience  string         // Required "aud", if set
+}
+
+// jwtHeader is the JOSE header of a JWT
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// jwtClaims are the registered claims the gateway checks
+type jwtClaims struct {
+	Issuer    string      `json:"iss"`
+	Audience  jwtAudience `json:"aud"`
+	ExpiresAt *float64    `json:"exp"`
+	NotBefore *float64    `json:"nbf"`
+}
+
+// jwtAudience is the "aud" claim, which may be a single string or an array
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return errors.New("aud must be a string or an array of strings")
+	}
+	*a = multiple
+	return nil
+}
+
+// newJWTVerifier creates a verifier from the JWT settings of the configuration
+func newJWTVerifier(cfg *config.Config) (*jwtVerifier, error) {
+	v := &jwtVerifier{
+		issuer:   cfg.JWTIssuer,
+		audience: cfg.JWTAudience,
+	}
+
+	if cfg.JWTSecret != "" {
+		if len(cfg.JWTSecret) < minJWTSecretLength {
+			log.Printf("⚠️  [API] JWT_SECRET is shorter than %d bytes; HS256 tokens signed with it can be brute-forced", minJWTSecretLength)
+		}
+		v.secret = []byte(cfg.JWTSecret)
+	}
+
+	if cfg.JWTPublicKeyFile != "" {
+		key, err := loadRSAPublicKey(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.publicKey = key
+	}
+
+	if cfg.JWTJWKSURL != "" || cfg.JWTJWKSFile != "" {
+		// A provider's keys sign tokens for all of its clients, so the issuer and
+		// audience are what restrict them to this gateway
+		if cfg.JWTIssuer == "" || cfg.JWTAudience == "" {
+			return nil, errors.New("JWT_ISSUER and JWT_AUDIENCE must be set with JWT_JWKS_URL or JWT_JWKS_FILE")
+		}
+		set, err := newJWKS(cfg.JWTJWKSURL, cfg.JWTJWKSFile, cfg.JWTJWKSRefresh)
+		if err != nil {
+			return nil, err
+		}
+		v.jwks = set
+	}
+
+	return v, nil
+}
+
+// enabled reports whether any JWT algorithm is configured
+func (v *jwtVerifier) enabled() bool {
+	return v.secret != nil || v.publicKey != nil || v.jwks != nil
+}
+
+// verify checks a compact JWT and returns all of its claims
+func (v *jwtVerifier) verify(ctx context.Context, token string, now time.Time) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+
+	// The algorithm is only trusted to pick among the configured keys
+	signed := []byte(parts[0] + "." + parts[1])
+	switch header.Alg {
+	case "HS256":
+		if v.secret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return nil, errors.New("invalid token signature")
+		}
+	case "RS256":
+		key, err := v.rsaKey(ctx, header.Kid)
+		if err != nil {
+			return nil, err
+		}
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, errors.New("invalid token signature")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported token algorithm %q", header.Alg)
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+	if err := v.checkClaims(&claims, now); err != nil {
+		return nil, err
+	}
+
+	// All claims, for mapping onto requests
+	var all map[string]interface{}
+	if err := decodeJWTPart(parts[1], &all); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+	return all, nil
+}
+
+// rsaKey returns the RS256 key a token was signed with: the JWKS key it names, or
+// else the static public key
+func (v *jwtVerifier) rsaKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	if v.jwks != nil && (kid != "" || v.publicKey == nil) {
+		return v.jwks.key(ctx, kid)
+	}
+	if v.publicKey == nil {
+		return nil, errors.New("RS256 tokens are not accepted")
+	}
+	return v.publicKey, nil
+}
+
+// checkClaims validates the time, issuer and audience claims
+func (v *jwtVerifier) checkClaims(claims *jwtClaims, now time.Time) error {
+	if claims.ExpiresAt == nil {
+		return errors.New("token has no expiry")
+	}
+	if now.Add(-jwtLeeway).After(numericDate(*claims.ExpiresAt)) {
+		return errors.New("token has expired")
+	}
+	if claims.NotBefore != nil && now.Add(jwtLeeway).Before(numericDate(*claims.NotBefore)) {
+		return errors.New("token is not valid yet")
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return errors.New("token has the wrong issuer")
+	}
+	if v.audience != "" && !slices.Contains(claims.Audience, v.audience) {
+		return errors.New("token has the wrong audience")
+	}
+	return nil
+}
+
+// decodeJWTPart decodes a base64url JSON segment of a JWT. Numbers are kept as
+// json.Number so large claim values survive intact.
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// numericDate converts a JWT NumericDate (seconds since the epoch) to a time
+func numericDate(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+// loadRSAPublicKey reads a PEM "PUBLIC KEY" or "RSA PUBLIC KEY" from a file
+func loadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT public key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("JWT public key %s is not PEM-encoded", path)
+	}
+
+	var key *rsa.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT public key: %w", err)
+		}
+		rsaKey, ok := parsed.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("JWT public key %s is not an RSA key", path)
+		}
+		key = rsaKey
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT public key: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("JWT public key %s has unsupported PEM type %q", path, block.Type)
+	}
+
+	if key.N.BitLen() < 2048 {
+		return nil, fmt.Errorf("JWT public key %s is shorter than 2048 bits", path)
+	}
+	return key, nil
+}
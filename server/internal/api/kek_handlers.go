@@ -0,0 +1,36 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// RewrapTEKs starts rewrapping every stored TEK under the current KEK (admin only)
+func (h *Handler) RewrapTEKs(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/kek/rewrap"
+
+	resp, err := h.piiService.RewrapTEKs(r.Context(), &pb.RewrapTEKsRequest{})
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusAccepted, resp)
+}
+
+// GetKEKStatus reports how many stored TEKs each KEK still wraps (admin only)
+func (h *Handler) GetKEKStatus(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/kek"
+
+	resp, err := h.piiService.GetKEKStatus(r.Context(), &pb.GetKEKStatusRequest{})
+	if err != nil {
+		h.writeOrganizationError(w, start, "GET", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "GET", endpoint, http.StatusOK, resp)
+}
@@ -0,0 +1,412 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateOrganization onboards a new tenant (admin only)
+func (h *Handler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations"
+
+	var jsonReq struct {
+		OrganizationID  string `json:"organizationId"`
+		DisplayName     string `json:"displayName"`
+		OrganizationKey string `json:"organizationKey"`
+		CipherSuite     string `json:"cipherSuite"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, jsonReq.OrganizationKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", endpoint)
+		return
+	}
+
+	req := &pb.CreateOrganizationRequest{
+		OrganizationId:  jsonReq.OrganizationID,
+		DisplayName:     jsonReq.DisplayName,
+		OrganizationKey: organizationKey,
+		CipherSuite:     jsonReq.CipherSuite,
+	}
+
+	resp, err := h.piiService.CreateOrganization(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusCreated, resp)
+}
+
+// GetOrganization returns a single organization (admin only)
+func (h *Handler) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}"
+
+	req := &pb.GetOrganizationRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+	}
+
+	resp, err := h.piiService.GetOrganization(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "GET", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "GET", endpoint, http.StatusOK, resp)
+}
+
+// ListOrganizations returns onboarded organizations (admin only)
+func (h *Handler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations"
+
+	req := &pb.ListOrganizationsRequest{
+		Status: r.URL.Query().Get("status"),
+	}
+
+	// Parse limit and offset
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil && limit > 0 {
+			req.Limit = int32(limit)
+		}
+	}
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if offset, err := strconv.Atoi(offsetStr); err == nil && offset >= 0 {
+			req.Offset = int32(offset)
+		}
+	}
+
+	resp, err := h.piiService.ListOrganizations(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "GET", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "GET", endpoint, http.StatusOK, resp)
+}
+
+// SuspendOrganization blocks tokenize and detokenize for an organization (admin only)
+func (h *Handler) SuspendOrganization(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/suspend"
+
+	// The body is optional and only carries the suspension reason
+	var jsonReq struct {
+		Reason string `json:"reason"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+			h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+			return
+		}
+	}
+
+	req := &pb.SuspendOrganizationRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+		Reason:         jsonReq.Reason,
+	}
+
+	resp, err := h.piiService.SuspendOrganization(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+}
+
+// ReactivateOrganization lifts a suspension (admin only)
+func (h *Handler) ReactivateOrganization(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/reactivate"
+
+	req := &pb.ReactivateOrganizationRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+	}
+
+	resp, err := h.piiService.ReactivateOrganization(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+}
+
+// SetOrganizationCipherSuite changes the cipher suite new tokens are sealed with (admin only)
+func (h *Handler) SetOrganizationCipherSuite(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/cipher-suite"
+
+	var jsonReq struct {
+		CipherSuite string `json:"cipherSuite"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "PUT", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	req := &pb.SetOrganizationCipherSuiteRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+		CipherSuite:    jsonReq.CipherSuite,
+	}
+
+	resp, err := h.piiService.SetOrganizationCipherSuite(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "PUT", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "PUT", endpoint, http.StatusOK, resp)
+}
+
+// SetDeterministicDataTypes changes the data types tokenized deterministically (admin only)
+func (h *Handler) SetDeterministicDataTypes(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/deterministic-data-types"
+
+	var jsonReq struct {
+		DataTypes []string `json:"dataTypes"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "PUT", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	req := &pb.SetDeterministicDataTypesRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+		DataTypes:      jsonReq.DataTypes,
+	}
+
+	resp, err := h.piiService.SetDeterministicDataTypes(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "PUT", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "PUT", endpoint, http.StatusOK, resp)
+}
+
+// ShredOrganization crypto-shreds an organization (admin only)
+func (h *Handler) ShredOrganization(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/shred"
+
+	var jsonReq struct {
+		ConfirmOrganizationID string `json:"confirmOrganizationId"`
+		DeleteTokens          bool   `json:"deleteTokens"`
+		Reason                string `json:"reason"`
+		RequestedBy           string `json:"requestedBy"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	req := &pb.ShredOrganizationRequest{
+		OrganizationId:        mux.Vars(r)["organizationId"],
+		ConfirmOrganizationId: jsonReq.ConfirmOrganizationID,
+		DeleteTokens:          jsonReq.DeleteTokens,
+		Reason:                jsonReq.Reason,
+		RequestedBy:           jsonReq.RequestedBy,
+	}
+
+	resp, err := h.piiService.ShredOrganization(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+}
+
+// writeOrganizationError maps a gRPC status from an organization RPC to an HTTP error response
+func (h *Handler) writeOrganizationError(w http.ResponseWriter, start time.Time, method, endpoint string, err error) {
+	// Use the message of the underlying status rather than the client's wrapped error
+	message := err.Error()
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		message = grpcErr.GRPCStatus().Message()
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		h.writeError(w, start, method, endpoint, http.StatusBadRequest, "bad_request", "INVALID_ORGANIZATION_REQUEST", message)
+	case codes.NotFound:
+		h.writeError(w, start, method, endpoint, http.StatusNotFound, "not_found", "ORGANIZATION_NOT_FOUND", message)
+	case codes.AlreadyExists:
+		h.writeError(w, start, method, endpoint, http.StatusConflict, "conflict", "ORGANIZATION_EXISTS", message)
+	case codes.Aborted:
+		h.writeError(w, start, method, endpoint, http.StatusConflict, "conflict", "KEY_ROTATION_IN_PROGRESS", message)
+	case codes.FailedPrecondition:
+		h.writeError(w, start, method, endpoint, http.StatusConflict, "conflict", "ORGANIZATION_STATE_CONFLICT", message)
+	case codes.Unauthenticated:
+		h.writeError(w, start, method, endpoint, http.StatusUnauthorized, "unauthorized", "INVALID_ORGANIZATION_KEY", "Invalid organization key")
+	case codes.ResourceExhausted:
+		setRetryAfter(w, err)
+		h.writeError(w, start, method, endpoint, http.StatusTooManyRequests, "too_many_requests", "ORGANIZATION_LOCKED", "Too many failed organization key attempts")
+	case codes.Unavailable:
+		h.writeError(w, start, method, endpoint, http.StatusServiceUnavailable, "service_unavailable", "SERVICE_UNAVAILABLE", message)
+	default:
+		h.writeError(w, start, method, endpoint, http.StatusInternalServerError, "internal_server_error", "ORGANIZATION_REQUEST_FAILED", fmt.Sprintf("Organization request failed: %v", err))
+	}
+}
+
+// writeError writes a structured error response and records metrics
+func (h *Handler) writeError(w http.ResponseWriter, start time.Time, method, endpoint string, httpStatus int, errorType, code, message string) {
+	h.requestsTotal.WithLabelValues(method, endpoint, strconv.Itoa(httpStatus)).Inc()
+	h.requestDuration.WithLabelValues(method, endpoint).Observe(time.Since(start).Seconds())
+	errorResp := map[string]interface{}{
+		"error":   errorType,
+		"code":    code,
+		"message": message,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(errorResp)
+}
+
+// writeProto writes a protobuf message as JSON and records metrics
+func (h *Handler) writeProto(w http.ResponseWriter, start time.Time, method, endpoint string, httpStatus int, msg proto.Message) {
+	jsonBytes, err := protojson.Marshal(msg)
+	if err != nil {
+		h.writeError(w, start, method, endpoint, http.StatusInternalServerError, "internal_server_error", "MARSHAL_FAILED", fmt.Sprintf("Failed to marshal response: %v", err))
+		return
+	}
+
+	h.requestsTotal.WithLabelValues(method, endpoint, strconv.Itoa(httpStatus)).Inc()
+	h.requestDuration.WithLabelValues(method, endpoint).Observe(time.Since(start).Seconds())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(jsonBytes)
+}
+
+// UnlockOrganization clears a brute-force lockout (admin only)
+func (h *Handler) UnlockOrganization(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/unlock"
+
+	// The body is optional and only names a source address to unlock as well
+	var jsonReq struct {
+		Source string `json:"source"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+			h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+			return
+		}
+	}
+
+	req := &pb.UnlockOrganizationRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+		Source:         jsonReq.Source,
+	}
+
+	resp, err := h.piiService.UnlockOrganization(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+}
+
+// RotateTEK provisions a new TEK version for an organization (admin only)
+func (h *Handler) RotateTEK(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/rotate-tek"
+
+	req := &pb.RotateTEKRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+	}
+
+	resp, err := h.piiService.RotateTEK(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusOK, resp)
+}
+
+// RotateOrganizationKey replaces an organization's key and starts re-encrypting its
+// tokens in the background (admin only)
+func (h *Handler) RotateOrganizationKey(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/rotate-key"
+
+	var jsonReq struct {
+		OrganizationKey    string `json:"organizationKey"`
+		NewOrganizationKey string `json:"newOrganizationKey"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jsonReq); err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusBadRequest, "bad_request", "INVALID_REQUEST_BODY", "Invalid request body")
+		return
+	}
+
+	organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, jsonReq.OrganizationKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", endpoint)
+		return
+	}
+	newOrganizationKey, ok := h.requestOrganizationKey(r, orgkey.NewHeader, jsonReq.NewOrganizationKey)
+	if !ok {
+		h.writeOrganizationKeyInBody(w, start, "POST", endpoint)
+		return
+	}
+
+	req := &pb.RotateOrganizationKeyRequest{
+		OrganizationId:     mux.Vars(r)["organizationId"],
+		OrganizationKey:    organizationKey,
+		NewOrganizationKey: newOrganizationKey,
+	}
+
+	// Failed attempts count towards the brute-force lockout of the caller's address
+	ctx := lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey)
+
+	resp, err := h.piiService.RotateOrganizationKey(ctx, req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "POST", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "POST", endpoint, http.StatusAccepted, resp)
+}
+
+// GetOrganizationKeyRotation reports the progress of an organization's latest key rotation (admin only)
+func (h *Handler) GetOrganizationKeyRotation(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/admin/organizations/{organizationId}/key-rotation"
+
+	req := &pb.GetOrganizationKeyRotationRequest{
+		OrganizationId: mux.Vars(r)["organizationId"],
+	}
+
+	resp, err := h.piiService.GetOrganizationKeyRotation(r.Context(), req)
+	if err != nil {
+		h.writeOrganizationError(w, start, "GET", endpoint, err)
+		return
+	}
+
+	h.writeProto(w, start, "GET", endpoint, http.StatusOK, resp)
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"crypto/subtle"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -36,15 +38,49 @@ func (s *Server) setupRoutes() {
 	// API v1 routes
 	api := s.router.PathPrefix("/v1").Subrouter()
 
+	// Client routes (API key or JWT)
+	client := api.NewRoute().Subrouter()
+
 	// PII operations
-	api.HandleFunc("/tokenize", s.handler.Tokenize).Methods("POST")
-	api.HandleFunc("/detokenize", s.handler.Detokenize).Methods("POST")
+	client.HandleFunc("/tokenize", requireOperation(operationTokenize, s.handler.Tokenize)).Methods("POST")
+	client.HandleFunc("/detokenize", requireOperation(operationDetokenize, s.handler.Detokenize)).Methods("POST")
+	client.HandleFunc("/tokenize/batch", requireOperation(operationTokenize, s.handler.TokenizeBatch)).Methods("POST")
+	client.HandleFunc("/detokenize/batch", requireOperation(operationDetokenize, s.handler.DetokenizeBatch)).Methods("POST")
+	client.HandleFunc("/tokenize/stream", requireOperation(operationTokenize, s.handler.TokenizeStream)).Methods("POST")
+	client.HandleFunc("/detokenize/stream", requireOperation(operationDetokenize, s.handler.DetokenizeStream)).Methods("POST")
+	client.HandleFunc("/lookup", requireOperation(operationLookup, s.handler.LookupToken)).Methods("POST")
 
 	// Metrics endpoint (Prometheus)
-	api.HandleFunc("/metrics", s.handler.Metrics).Methods("GET")
+	client.HandleFunc("/metrics", requireOperation(operationMetrics, s.handler.Metrics)).Methods("GET")
 
 	// Audit logs endpoint
-	api.HandleFunc("/audit/logs", s.handler.GetAuditLogs).Methods("GET")
+	client.HandleFunc("/audit/logs", requireOperation(operationAudit, s.handler.GetAuditLogs)).Methods("GET")
+	client.Use(s.handler.auth.middleware)
+
+	// Organization lifecycle (admin only)
+	admin := api.PathPrefix("/admin").Subrouter()
+	admin.HandleFunc("/organizations", s.handler.CreateOrganization).Methods("POST")
+	admin.HandleFunc("/organizations", s.handler.ListOrganizations).Methods("GET")
+	admin.HandleFunc("/organizations/{organizationId}", s.handler.GetOrganization).Methods("GET")
+	admin.HandleFunc("/organizations/{organizationId}/suspend", s.handler.SuspendOrganization).Methods("POST")
+	admin.HandleFunc("/organizations/{organizationId}/reactivate", s.handler.ReactivateOrganization).Methods("POST")
+	admin.HandleFunc("/organizations/{organizationId}/unlock", s.handler.UnlockOrganization).Methods("POST")
+	admin.HandleFunc("/organizations/{organizationId}/cipher-suite", s.handler.SetOrganizationCipherSuite).Methods("PUT")
+	admin.HandleFunc("/organizations/{organizationId}/deterministic-data-types", s.handler.SetDeterministicDataTypes).Methods("PUT")
+	admin.HandleFunc("/organizations/{organizationId}/shred", s.handler.ShredOrganization).Methods("POST")
+	admin.HandleFunc("/organizations/{organizationId}/rotate-tek", s.handler.RotateTEK).Methods("POST")
+	admin.HandleFunc("/organizations/{organizationId}/rotate-key", s.handler.RotateOrganizationKey).Methods("POST")
+	admin.HandleFunc("/organizations/{organizationId}/key-rotation", s.handler.GetOrganizationKeyRotation).Methods("GET")
+
+	// KEK rotation (admin only)
+	admin.HandleFunc("/kek", s.handler.GetKEKStatus).Methods("GET")
+	admin.HandleFunc("/kek/rewrap", s.handler.RewrapTEKs).Methods("POST")
+
+	// API keys (admin only)
+	admin.HandleFunc("/api-keys", s.handler.CreateAPIKey).Methods("POST")
+	admin.HandleFunc("/api-keys", s.handler.ListAPIKeys).Methods("GET")
+	admin.HandleFunc("/api-keys/{keyId}/revoke", s.handler.RevokeAPIKey).Methods("POST")
+	admin.Use(adminAuthMiddleware(s.config.AdminAPIKey))
 
 	// Middleware
 	s.router.Use(loggingMiddleware)
@@ -75,11 +111,43 @@ func loggingMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// adminAuthMiddleware requires the X-Admin-Key header to match the configured admin
+// API key. Admin routes are disabled entirely when no key is configured.
+func adminAuthMiddleware(adminKey string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if adminKey == "" {
+				writeAuthError(w, http.StatusForbidden, "forbidden", "ADMIN_API_DISABLED", "Admin API is disabled")
+				return
+			}
+
+			provided := r.Header.Get("X-Admin-Key")
+			if subtle.ConstantTimeCompare([]byte(provided), []byte(adminKey)) != 1 {
+				writeAuthError(w, http.StatusUnauthorized, "unauthorized", "INVALID_ADMIN_KEY", "Invalid admin key")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeAuthError writes the error response of an authentication middleware
+func writeAuthError(w http.ResponseWriter, httpStatus int, errorType, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error":   errorType,
+		"code":    code,
+		"message": message,
+	})
+}
+
 func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Admin-Key, X-Org-Key, X-New-Org-Key")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
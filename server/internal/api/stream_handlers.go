@@ -0,0 +1,414 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxNDJSONLine bounds the length of one line of a streaming request body
+const maxNDJSONLine = 1 << 20
+
+// streamAuditChunk is the number of tokens of a stream listed in one audit entry
+const streamAuditChunk = 100
+
+// TokenizeStream tokenizes an NDJSON request body line by line through the PII
+// service's TokenizeStream, writing one NDJSON response line per request line
+func (h *Handler) TokenizeStream(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/tokenize/stream"
+
+	streamer, ok := h.piiService.(types.PIIStreamingInterface)
+	if !ok {
+		h.writeError(w, start, "POST", endpoint, http.StatusNotImplemented, "not_implemented", "STREAMING_UNAVAILABLE", "Streaming requires the remote PII service")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey))
+	defer cancel()
+	stream, err := streamer.TokenizeStream(ctx)
+	if err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusInternalServerError, "internal_server_error", "TOKENIZE_FAILED", fmt.Sprintf("Tokenize stream failed: %v", err))
+		return
+	}
+
+	decode := func(line int, raw []byte) (*pb.TokenizeStreamRequest, *pb.TokenizeStreamResponse, bool) {
+		var jsonReq struct {
+			CorrelationID    string            `json:"correlationId"`
+			Data             string            `json:"data"`
+			DataType         string            `json:"dataType"`
+			RetentionPolicy  string            `json:"retentionPolicy"`
+			ClientID         string            `json:"clientId"`
+			Metadata         map[string]string `json:"metadata"`
+			OrganizationID   string            `json:"organizationId"`
+			OrganizationKey  string            `json:"organizationKey"`
+			TokenFormat      string            `json:"tokenFormat"`
+			PreserveBin      bool              `json:"preserveBin"`
+			PreserveLastFour bool              `json:"preserveLastFour"`
+		}
+		if err := json.Unmarshal(raw, &jsonReq); err != nil {
+			return nil, &pb.TokenizeStreamResponse{
+				CorrelationId: strconv.Itoa(line),
+				Response:      &pb.TokenizeResponse{Status: "error", ErrorMessage: "Invalid JSON line"},
+				ErrorCode:     codes.InvalidArgument.String(),
+			}, false
+		}
+		caller, field, ok := bindCaller(r, "clientId", callerFields{client: jsonReq.ClientID, organization: jsonReq.OrganizationID})
+		if !ok {
+			_, message := callerMismatch(field)
+			return nil, &pb.TokenizeStreamResponse{
+				CorrelationId: correlationID(jsonReq.CorrelationID, line),
+				Response:      &pb.TokenizeResponse{Status: "error", ErrorMessage: message},
+				ErrorCode:     codes.PermissionDenied.String(),
+			}, false
+		}
+		organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, jsonReq.OrganizationKey)
+		if !ok {
+			return nil, &pb.TokenizeStreamResponse{
+				CorrelationId: correlationID(jsonReq.CorrelationID, line),
+				Response:      &pb.TokenizeResponse{Status: "error", ErrorMessage: organizationKeyInBodyMessage},
+				ErrorCode:     codes.InvalidArgument.String(),
+			}, false
+		}
+
+		return &pb.TokenizeStreamRequest{
+			CorrelationId: correlationID(jsonReq.CorrelationID, line),
+			Request: &pb.TokenizeRequest{
+				Data:             jsonReq.Data,
+				DataType:         jsonReq.DataType,
+				RetentionPolicy:  jsonReq.RetentionPolicy,
+				ClientId:         caller.client,
+				Metadata:         jsonReq.Metadata,
+				OrganizationId:   caller.organization,
+				OrganizationKey:  organizationKey,
+				TokenFormat:      jsonReq.TokenFormat,
+				PreserveBin:      jsonReq.PreserveBin,
+				PreserveLastFour: jsonReq.PreserveLastFour,
+			},
+		}, nil, true
+	}
+	token := func(resp *pb.TokenizeStreamResponse) (string, bool) {
+		return resp.GetResponse().GetReferenceHash(), resp.GetResponse().GetStatus() == "success"
+	}
+	audit := func(tokens []string) {
+		h.auditStreamTokens(ctx, "tokenize", r, tokens)
+	}
+
+	result := pipeNDJSON(w, r, stream, decode, token, audit)
+	h.tokenizeRequests.Add(float64(result.Succeeded))
+	h.finishStream(ctx, start, endpoint, "tokenize", r, result)
+}
+
+// DetokenizeStream detokenizes an NDJSON request body line by line through the PII
+// service's DetokenizeStream, writing one NDJSON response line per request line
+func (h *Handler) DetokenizeStream(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	const endpoint = "/detokenize/stream"
+
+	streamer, ok := h.piiService.(types.PIIStreamingInterface)
+	if !ok {
+		h.writeError(w, start, "POST", endpoint, http.StatusNotImplemented, "not_implemented", "STREAMING_UNAVAILABLE", "Streaming requires the remote PII service")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(lockout.WithSource(r.Context(), lockout.SourceFromRemoteAddr(r.RemoteAddr), h.config.GatewaySourceKey))
+	defer cancel()
+	stream, err := streamer.DetokenizeStream(ctx)
+	if err != nil {
+		h.writeError(w, start, "POST", endpoint, http.StatusInternalServerError, "internal_server_error", "DETOKENIZE_FAILED", fmt.Sprintf("Detokenize stream failed: %v", err))
+		return
+	}
+
+	decode := func(line int, raw []byte) (*pb.DetokenizeStreamRequest, *pb.DetokenizeStreamResponse, bool) {
+		var jsonReq struct {
+			CorrelationID     string `json:"correlationId"`
+			ReferenceHash     string `json:"referenceHash"`
+			Purpose           string `json:"purpose"`
+			RequestingService string `json:"requestingService"`
+			RequestingUser    string `json:"requestingUser"`
+			OrganizationID    string `json:"organizationId"`
+			OrganizationKey   string `json:"organizationKey"`
+		}
+		if err := json.Unmarshal(raw, &jsonReq); err != nil {
+			return nil, &pb.DetokenizeStreamResponse{
+				CorrelationId: strconv.Itoa(line),
+				Response:      &pb.DetokenizeResponse{Status: "error", ErrorMessage: "Invalid JSON line"},
+				ErrorCode:     codes.InvalidArgument.String(),
+			}, false
+		}
+		caller, field, ok := bindCaller(r, "requestingService", callerFields{client: jsonReq.RequestingService, user: jsonReq.RequestingUser, organization: jsonReq.OrganizationID})
+		if !ok {
+			_, message := callerMismatch(field)
+			return nil, &pb.DetokenizeStreamResponse{
+				CorrelationId: correlationID(jsonReq.CorrelationID, line),
+				Response:      &pb.DetokenizeResponse{Status: "error", ErrorMessage: message},
+				ErrorCode:     codes.PermissionDenied.String(),
+			}, false
+		}
+		organizationKey, ok := h.requestOrganizationKey(r, orgkey.Header, jsonReq.OrganizationKey)
+		if !ok {
+			return nil, &pb.DetokenizeStreamResponse{
+				CorrelationId: correlationID(jsonReq.CorrelationID, line),
+				Response:      &pb.DetokenizeResponse{Status: "error", ErrorMessage: organizationKeyInBodyMessage},
+				ErrorCode:     codes.InvalidArgument.String(),
+			}, false
+		}
+
+		return &pb.DetokenizeStreamRequest{
+			CorrelationId: correlationID(jsonReq.CorrelationID, line),
+			Request: &pb.DetokenizeRequest{
+				ReferenceHash:     jsonReq.ReferenceHash,
+				Purpose:           jsonReq.Purpose,
+				RequestingService: caller.client,
+				RequestingUser:    caller.user,
+				OrganizationId:    caller.organization,
+				OrganizationKey:   organizationKey,
+			},
+		}, nil, true
+	}
+	token := func(resp *pb.DetokenizeStreamResponse) (string, bool) {
+		return resp.GetReferenceHash(), resp.GetResponse().GetStatus() == "success"
+	}
+	audit := func(tokens []string) {
+		h.auditStreamTokens(ctx, "detokenize", r, tokens)
+	}
+
+	result := pipeNDJSON(w, r, stream, decode, token, audit)
+	h.detokenizeRequests.Add(float64(result.Succeeded))
+	h.finishStream(ctx, start, endpoint, "detokenize", r, result)
+}
+
+// correlationID returns the client's correlation ID, or the line number if it set none
+func correlationID(id string, line int) string {
+	if id != "" {
+		return id
+	}
+	return strconv.Itoa(line)
+}
+
+// finishStream records metrics and an audit entry summarizing a stream. The tokens
+// it created or revealed are audited along the way by auditStreamTokens.
+func (h *Handler) finishStream(ctx context.Context, start time.Time, endpoint, operation string, r *http.Request, result streamResult) {
+	h.requestsTotal.WithLabelValues("POST", endpoint, "200").Inc()
+	h.requestDuration.WithLabelValues("POST", endpoint).Observe(time.Since(start).Seconds())
+
+	caller, _ := principalFromContext(r.Context())
+	auditReq := &pbAudit.LogAccessRequest{
+		Operation:         operation,
+		RequestingService: auditRequestingService(caller.id),
+		RequestingUser:    caller.id,
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          r.RemoteAddr,
+		Metadata: map[string]string{
+			"stream_messages":  strconv.Itoa(result.Messages),
+			"stream_succeeded": strconv.Itoa(result.Succeeded),
+			"stream_failed":    strconv.Itoa(result.Messages - result.Succeeded),
+		},
+	}
+	h.auditService.LogAccess(context.WithoutCancel(ctx), auditReq)
+}
+
+// auditStreamTokens records an audit entry listing tokens a stream created or revealed
+func (h *Handler) auditStreamTokens(ctx context.Context, operation string, r *http.Request, tokens []string) {
+	caller, _ := principalFromContext(r.Context())
+	auditReq := &pbAudit.LogAccessRequest{
+		Operation:         operation,
+		RequestingService: auditRequestingService(caller.id),
+		RequestingUser:    caller.id,
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          r.RemoteAddr,
+		Metadata: map[string]string{
+			"stream_tokens":    strconv.Itoa(len(tokens)),
+			"reference_hashes": strings.Join(tokens, ","),
+		},
+	}
+	h.auditService.LogAccess(context.WithoutCancel(ctx), auditReq)
+}
+
+// streamResult summarizes a stream; it is written as the last line of the response
+type streamResult struct {
+	Messages  int `json:"messages"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// ndjsonStream is the client side of a PII stream
+type ndjsonStream[Req, Resp any] interface {
+	Send(Req) error
+	Recv() (Resp, error)
+	CloseSend() error
+}
+
+// pipeNDJSON sends each line of the request body through the stream and writes every
+// response as a line of its own as soon as it arrives. The body is read only as fast
+// as the stream accepts messages, so gRPC flow control reaches the HTTP client. Lines
+// that decode rejects are answered with the response it returns instead. The last line
+// is a summary, or an error object if the stream failed.
+//
+// token returns the token of a response and whether the message succeeded. The tokens
+// of successful messages are passed to audit in chunks of up to streamAuditChunk, so
+// every token is audited even if the stream breaks.
+func pipeNDJSON[Req, Resp proto.Message](w http.ResponseWriter, r *http.Request, stream ndjsonStream[Req, Resp], decode func(line int, raw []byte) (Req, Resp, bool), token func(Resp) (string, bool), audit func(tokens []string)) streamResult {
+	out := newNDJSONWriter(w)
+
+	var (
+		mu     sync.Mutex
+		result streamResult
+	)
+	count := func(ok bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		result.Messages++
+		if ok {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+
+	// Send the body in the background so responses flow back while the client uploads
+	sent := make(chan error, 1)
+	go func() {
+		defer stream.CloseSend()
+
+		scanner := bufio.NewScanner(r.Body)
+		scanner.Buffer(make([]byte, 64*1024), maxNDJSONLine)
+		line := 0
+		for scanner.Scan() {
+			line++
+			raw := scanner.Bytes()
+			if len(raw) == 0 {
+				continue
+			}
+
+			req, rejected, ok := decode(line, raw)
+			if !ok {
+				count(false)
+				out.write(rejected)
+				continue
+			}
+			if err := stream.Send(req); err != nil {
+				sent <- err
+				return
+			}
+		}
+		sent <- scanner.Err()
+	}()
+
+	var (
+		streamErr error
+		tokens    []string
+	)
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			streamErr = err
+			break
+		}
+		hash, ok := token(resp)
+		count(ok)
+		out.write(resp)
+
+		if ok {
+			tokens = append(tokens, hash)
+			if len(tokens) == streamAuditChunk {
+				audit(tokens)
+				tokens = nil
+			}
+		}
+	}
+	if len(tokens) > 0 {
+		audit(tokens)
+	}
+
+	// The body must not be read after the handler returns
+	if err := <-sent; err != nil && streamErr == nil {
+		streamErr = err
+	}
+
+	if streamErr != nil {
+		log.Printf("❌ [API] Stream failed: %v", streamErr)
+		out.writeJSON(map[string]interface{}{
+			"error":   "stream_failed",
+			"code":    "STREAM_FAILED",
+			"message": fmt.Sprintf("Stream failed: %v", streamErr),
+		})
+	} else {
+		out.writeJSON(map[string]interface{}{"summary": result})
+	}
+
+	return result
+}
+
+// ndjsonWriter writes newline-delimited JSON, flushing every line to the client
+type ndjsonWriter struct {
+	mu sync.Mutex
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// newNDJSONWriter starts a 200 NDJSON response. The request body stays readable while
+// the response is written, and the server's read and write timeouts are lifted since
+// a stream lasts as long as the client keeps sending.
+func newNDJSONWriter(w http.ResponseWriter) *ndjsonWriter {
+	rc := http.NewResponseController(w)
+	// HTTP/2 is always full duplex and reports ErrNotSupported here
+	_ = rc.EnableFullDuplex()
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	_ = rc.Flush()
+
+	return &ndjsonWriter{w: w, rc: rc}
+}
+
+// write writes a protobuf message as one line
+func (o *ndjsonWriter) write(msg proto.Message) {
+	line, err := protojson.Marshal(msg)
+	if err != nil {
+		log.Printf("❌ [API] Failed to marshal stream response: %v", err)
+		return
+	}
+	o.writeLine(line)
+}
+
+// writeJSON writes a JSON value as one line
+func (o *ndjsonWriter) writeJSON(v interface{}) {
+	line, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("❌ [API] Failed to marshal stream line: %v", err)
+		return
+	}
+	o.writeLine(line)
+}
+
+func (o *ndjsonWriter) writeLine(line []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.w.Write(append(line, '\n'))
+	_ = o.rc.Flush()
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// echoDetokenizeStream answers each detokenize message in order, failing tokens that start with "bad"
+type echoDetokenizeStream struct {
+	messages chan *pb.DetokenizeStreamRequest
+}
+
+func (s *echoDetokenizeStream) Send(req *pb.DetokenizeStreamRequest) error {
+	s.messages <- req
+	return nil
+}
+
+func (s *echoDetokenizeStream) Recv() (*pb.DetokenizeStreamResponse, error) {
+	req, ok := <-s.messages
+	if !ok {
+		return nil, io.EOF
+	}
+	status := "success"
+	if strings.HasPrefix(req.Request.ReferenceHash, "bad") {
+		status = "error"
+	}
+	return &pb.DetokenizeStreamResponse{
+		CorrelationId: req.CorrelationId,
+		Response:      &pb.DetokenizeResponse{Status: status},
+		ReferenceHash: req.Request.ReferenceHash,
+	}, nil
+}
+
+func (s *echoDetokenizeStream) CloseSend() error {
+	close(s.messages)
+	return nil
+}
+
+func TestPipeNDJSONAuditsEveryToken(t *testing.T) {
+	var body strings.Builder
+	for i := range 250 {
+		fmt.Fprintf(&body, "tok_%03d\n", i)
+	}
+	body.WriteString("bad_token\n")
+
+	stream := &echoDetokenizeStream{messages: make(chan *pb.DetokenizeStreamRequest, 1)}
+	decode := func(line int, raw []byte) (*pb.DetokenizeStreamRequest, *pb.DetokenizeStreamResponse, bool) {
+		return &pb.DetokenizeStreamRequest{
+			CorrelationId: correlationID("", line),
+			Request:       &pb.DetokenizeRequest{ReferenceHash: string(raw)},
+		}, nil, true
+	}
+	token := func(resp *pb.DetokenizeStreamResponse) (string, bool) {
+		return resp.GetReferenceHash(), resp.GetResponse().GetStatus() == "success"
+	}
+	var chunks [][]string
+	audit := func(tokens []string) {
+		chunks = append(chunks, tokens)
+	}
+
+	r := httptest.NewRequest("POST", "/v1/detokenize/stream", strings.NewReader(body.String()))
+	result := pipeNDJSON(httptest.NewRecorder(), r, stream, decode, token, audit)
+
+	if result.Messages != 251 || result.Succeeded != 250 || result.Failed != 1 {
+		t.Errorf("result = %+v, want 251 messages with 250 succeeded", result)
+	}
+	if len(chunks) != 3 || len(chunks[0]) != streamAuditChunk || len(chunks[1]) != streamAuditChunk || len(chunks[2]) != 50 {
+		t.Fatalf("audited %d chunks, want two of %d and one of 50", len(chunks), streamAuditChunk)
+	}
+	seen := make(map[string]bool)
+	for _, chunk := range chunks {
+		for _, hash := range chunk {
+			seen[hash] = true
+		}
+	}
+	for i := range 250 {
+		if hash := fmt.Sprintf("tok_%03d", i); !seen[hash] {
+			t.Errorf("%s was not audited", hash)
+		}
+	}
+	if seen["bad_token"] {
+		t.Error("a failed message was audited as revealed")
+	}
+}
@@ -0,0 +1,65 @@
+package envelope
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// deterministicKeyInfo starts the HKDF info of deterministic token keys; the data type
+// follows it
+const deterministicKeyInfo = "mistokenly/pii/deterministic-key/v1"
+
+// blindIndexKeyInfo starts the HKDF info of blind index keys; the data type follows it
+const blindIndexKeyInfo = "mistokenly/pii/blind-index-key/v1"
+
+// DeriveDeterministicKey derives the HMAC key of deterministic reference hashes for a
+// data type from the organization's final encryption key (see DeriveKey) with
+// HKDF-SHA256. Without both the TEK and the organization key nobody can compute the
+// token of a guessed value.
+func DeriveDeterministicKey(key []byte, dataType string) ([]byte, error) {
+	kdf := hkdf.New(sha256.New, key, nil, dataTypeInfo(deterministicKeyInfo, dataType))
+	deterministicKey := make([]byte, 32)
+	if _, err := io.ReadFull(kdf, deterministicKey); err != nil {
+		return nil, fmt.Errorf("failed to derive deterministic key with HKDF: %w", err)
+	}
+
+	return deterministicKey, nil
+}
+
+// DeterministicReferenceHash returns the reference hash of a normalized value: the
+// HMAC-SHA256 under a key from DeriveDeterministicKey, truncated to the 32 hex
+// characters of random reference hashes
+func DeterministicReferenceHash(key []byte, normalizedValue string) string {
+	return truncatedHMAC(key, normalizedValue)
+}
+
+// truncatedHMAC returns the first 16 bytes of HMAC-SHA256 of value, hex-encoded
+func truncatedHMAC(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// DeriveBlindIndexKey derives the HMAC key of blind indexes for a data type from the
+// organization's final encryption key (see DeriveKey) with HKDF-SHA256. It differs from
+// the deterministic token key, so a blind index never equals a reference hash.
+func DeriveBlindIndexKey(key []byte, dataType string) ([]byte, error) {
+	kdf := hkdf.New(sha256.New, key, nil, dataTypeInfo(blindIndexKeyInfo, dataType))
+	blindIndexKey := make([]byte, 32)
+	if _, err := io.ReadFull(kdf, blindIndexKey); err != nil {
+		return nil, fmt.Errorf("failed to derive blind index key with HKDF: %w", err)
+	}
+
+	return blindIndexKey, nil
+}
+
+// BlindIndex returns the blind index of a normalized value under a key from
+// DeriveBlindIndexKey. It reveals nothing about the value without the key.
+func BlindIndex(key []byte, normalizedValue string) string {
+	return truncatedHMAC(key, normalizedValue)
+}
@@ -0,0 +1,232 @@
+// Package envelope implements the envelope encryption primitives shared by the PII
+// and persistence services: wrapping TEKs with the KEK, deriving the final encryption
+// key from a TEK and an organization key, and authenticated encryption of PII with a
+// choice of cipher suites.
+package envelope
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// NonceSize is the AES-GCM nonce (IV) length in bytes
+const NonceSize = 12
+
+// wrappedTEKMagic starts every TEK wrapped by WrapTEKWithRing. It is followed by the
+// KEK ID length (one byte), the KEK ID and the output of WrapTEK. TEKs wrapped before
+// KEK IDs were introduced have no header.
+var wrappedTEKMagic = []byte("MKEK")
+
+// KeyRing resolves locally held KEKs by ID; it is satisfied by types.StaticKEKProvider
+type KeyRing interface {
+	GetKEK() ([]byte, error)
+	CurrentKEKID() string
+	GetKEKByID(id string) ([]byte, error)
+	KEKIDs() []string
+}
+
+// WrapTEKWithRing wraps a TEK with the ring's current KEK and records the KEK ID in a header
+func WrapTEKWithRing(ring KeyRing, tek []byte) ([]byte, error) {
+	kekID := ring.CurrentKEKID()
+	kek, err := ring.GetKEK()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KEK: %w", err)
+	}
+	defer clear(kek)
+
+	wrapped, err := WrapTEK(kek, tek)
+	if err != nil {
+		return nil, err
+	}
+
+	return AddKEKHeader(kekID, wrapped)
+}
+
+// AddKEKHeader prefixes a wrapped TEK with the header naming the KEK that wrapped it
+func AddKEKHeader(kekID string, wrapped []byte) ([]byte, error) {
+	if kekID == "" || len(kekID) > 255 {
+		return nil, fmt.Errorf("invalid KEK ID %q", kekID)
+	}
+
+	header := make([]byte, 0, len(wrappedTEKMagic)+1+len(kekID)+len(wrapped))
+	header = append(header, wrappedTEKMagic...)
+	header = append(header, byte(len(kekID)))
+	header = append(header, kekID...)
+	return append(header, wrapped...), nil
+}
+
+// UnwrapTEKWithRing unwraps a TEK with the KEK named in its header. TEKs without a
+// header are tried against every KEK in the ring.
+func UnwrapTEKWithRing(ring KeyRing, encryptedTEK []byte) ([]byte, error) {
+	kekID, body, ok := SplitKEKHeader(encryptedTEK)
+	if !ok {
+		return unwrapLegacyTEK(ring, encryptedTEK)
+	}
+
+	tek, err := unwrapWithKEKID(ring, kekID, body)
+	if err == nil {
+		return tek, nil
+	}
+
+	// A headerless TEK whose IV happens to start with the magic is still readable
+	if tek, legacyErr := unwrapLegacyTEK(ring, encryptedTEK); legacyErr == nil {
+		return tek, nil
+	}
+	return nil, fmt.Errorf("failed to unwrap TEK with KEK %q: %w", kekID, err)
+}
+
+// unwrapWithKEKID unwraps a TEK body with one KEK of the ring
+func unwrapWithKEKID(ring KeyRing, kekID string, body []byte) ([]byte, error) {
+	kek, err := ring.GetKEKByID(kekID)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(kek)
+
+	return UnwrapTEK(kek, body)
+}
+
+// unwrapLegacyTEK unwraps a TEK without a KEK ID header by trying every KEK in the ring
+func unwrapLegacyTEK(ring KeyRing, encryptedTEK []byte) ([]byte, error) {
+	for _, kekID := range ring.KEKIDs() {
+		kek, err := ring.GetKEKByID(kekID)
+		if err != nil {
+			continue
+		}
+		tek, err := UnwrapTEK(kek, encryptedTEK)
+		clear(kek)
+		if err == nil {
+			return tek, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to decrypt TEK with any KEK in the ring")
+}
+
+// WrappedKEKID returns the ID of the KEK that wrapped a TEK, or "" if the TEK was
+// wrapped before KEK IDs were introduced
+func WrappedKEKID(encryptedTEK []byte) string {
+	kekID, _, _ := SplitKEKHeader(encryptedTEK)
+	return kekID
+}
+
+// SplitKEKHeader separates the KEK ID header from a wrapped TEK. It reports false for
+// TEKs without a header.
+func SplitKEKHeader(encryptedTEK []byte) (string, []byte, bool) {
+	if !bytes.HasPrefix(encryptedTEK, wrappedTEKMagic) || len(encryptedTEK) <= len(wrappedTEKMagic) {
+		return "", nil, false
+	}
+
+	rest := encryptedTEK[len(wrappedTEKMagic):]
+	idLen := int(rest[0])
+	if idLen == 0 || len(rest) < 1+idLen+NonceSize {
+		return "", nil, false
+	}
+
+	return string(rest[1 : 1+idLen]), rest[1+idLen:], true
+}
+
+// WrapTEK encrypts a TEK with the KEK. The IV is prepended to the ciphertext.
+func WrapTEK(kek, tek []byte) ([]byte, error) {
+	ciphertext, iv, err := Encrypt(kek, tek)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(iv, ciphertext...), nil
+}
+
+// UnwrapTEK decrypts a TEK produced by WrapTEK
+func UnwrapTEK(kek, encryptedTEK []byte) ([]byte, error) {
+	if len(encryptedTEK) < NonceSize {
+		return nil, fmt.Errorf("encrypted TEK too short")
+	}
+
+	tek, err := Decrypt(kek, encryptedTEK[NonceSize:], encryptedTEK[:NonceSize])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt TEK: %w", err)
+	}
+
+	return tek, nil
+}
+
+// DeriveKey derives the final encryption key from a TEK and an organization key with
+// HKDF-SHA256. The TEK is the input key material and the organization key the salt.
+func DeriveKey(orgKey string, tek []byte) ([]byte, error) {
+	salt := []byte(orgKey)
+	defer clear(salt)
+	kdf := hkdf.New(sha256.New, tek, salt, nil)
+
+	// Derive 32-byte key for AES-256
+	derivedKey := make([]byte, 32)
+	if _, err := io.ReadFull(kdf, derivedKey); err != nil {
+		return nil, fmt.Errorf("failed to derive key with HKDF: %w", err)
+	}
+
+	return derivedKey, nil
+}
+
+// Encrypt encrypts plaintext with AES-GCM under key and a fresh random IV
+func Encrypt(key, plaintext []byte) ([]byte, []byte, error) {
+	return EncryptWithAAD(key, plaintext, nil)
+}
+
+// EncryptWithAAD encrypts plaintext with AES-GCM under key and a fresh random IV,
+// authenticating aad alongside it. The same aad must be given to DecryptWithAAD.
+func EncryptWithAAD(key, plaintext, aad []byte) ([]byte, []byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iv := make([]byte, NonceSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate IV: %w", err)
+	}
+
+	return gcm.Seal(nil, iv, plaintext, aad), iv, nil
+}
+
+// Decrypt decrypts and authenticates AES-GCM ciphertext
+func Decrypt(key, ciphertext, iv []byte) ([]byte, error) {
+	return DecryptWithAAD(key, ciphertext, iv, nil)
+}
+
+// DecryptWithAAD decrypts and authenticates AES-GCM ciphertext together with aad
+func DecryptWithAAD(key, ciphertext, iv, aad []byte) ([]byte, error) {
+	if len(iv) != NonceSize {
+		return nil, fmt.Errorf("invalid IV length: got %d bytes, expected %d bytes for AES-GCM", len(iv), NonceSize)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, iv, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM cipher: %w", err)
+	}
+
+	return gcm, nil
+}
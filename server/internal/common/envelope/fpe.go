@@ -0,0 +1,45 @@
+package envelope
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// fpeKeyInfo starts the HKDF info of format-preserving encryption keys; the data type
+// follows it
+const fpeKeyInfo = "mistokenly/pii/fpe-key/ff1/v1"
+
+// fpeReferenceMarker starts the input of FPEReferenceHash
+const fpeReferenceMarker = "mistokenly/pii/fpe-reference/v1"
+
+// DeriveFPEKey derives the FF1 key of a data type from the organization's final
+// encryption key (see DeriveKey) with HKDF-SHA256, so format-preserving tokens need
+// both the TEK and the organization key and every data type has its own permutation
+func DeriveFPEKey(key []byte, dataType string) ([]byte, error) {
+	kdf := hkdf.New(sha256.New, key, nil, dataTypeInfo(fpeKeyInfo, dataType))
+	fpeKey := make([]byte, 32)
+	if _, err := io.ReadFull(kdf, fpeKey); err != nil {
+		return nil, fmt.Errorf("failed to derive FPE key with HKDF: %w", err)
+	}
+
+	return fpeKey, nil
+}
+
+// FPEReferenceHash returns the reference hash a format-preserving token is stored
+// under: a hash of the organization and the token's digits, in the same 32 hex
+// character form as random reference hashes. Formatting characters are not part of
+// it, so a token is found however the caller punctuates it.
+func FPEReferenceHash(organizationID, tokenDigits string) string {
+	h := sha256.New()
+	h.Write([]byte(fpeReferenceMarker))
+	for _, field := range []string{organizationID, tokenDigits} {
+		binary.Write(h, binary.BigEndian, uint32(len(field)))
+		h.Write([]byte(field))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
@@ -0,0 +1,176 @@
+package envelope
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/PlainFunction/mistokenly/internal/common/gcmsiv"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Suite identifies the AEAD a ciphertext was sealed with. The value is stored in the
+// ciphertext header, so existing values must never change.
+type Suite byte
+
+const (
+	SuiteAES256GCM         Suite = 1
+	SuiteAES256GCMSIV      Suite = 2 // Nonce-misuse resistant (RFC 8452)
+	SuiteXChaCha20Poly1305 Suite = 3 // 24-byte random nonces
+
+	// DefaultSuite is used for organizations that did not choose a suite
+	DefaultSuite = SuiteAES256GCM
+)
+
+var suiteNames = map[Suite]string{
+	SuiteAES256GCM:         "aes-256-gcm",
+	SuiteAES256GCMSIV:      "aes-256-gcm-siv",
+	SuiteXChaCha20Poly1305: "xchacha20-poly1305",
+}
+
+// ParseSuite returns the suite with the given name; an empty name selects DefaultSuite
+func ParseSuite(name string) (Suite, error) {
+	if name == "" {
+		return DefaultSuite, nil
+	}
+	for suite, suiteName := range suiteNames {
+		if strings.EqualFold(name, suiteName) {
+			return suite, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown cipher suite %q; expected aes-256-gcm, aes-256-gcm-siv or xchacha20-poly1305", name)
+}
+
+func (s Suite) String() string {
+	if name, ok := suiteNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("suite(%d)", byte(s))
+}
+
+// NonceSize returns the nonce length of the suite in bytes
+func (s Suite) NonceSize() int {
+	if s == SuiteXChaCha20Poly1305 {
+		return chacha20poly1305.NonceSizeX
+	}
+	return NonceSize
+}
+
+// newAEAD returns the suite's AEAD keyed with a 32-byte key
+func (s Suite) newAEAD(key []byte) (cipher.AEAD, error) {
+	switch s {
+	case SuiteAES256GCM:
+		return newGCM(key)
+	case SuiteAES256GCMSIV:
+		return gcmsiv.New(key)
+	case SuiteXChaCha20Poly1305:
+		return chacha20poly1305.NewX(key)
+	default:
+		return nil, fmt.Errorf("unsupported cipher suite %s", s)
+	}
+}
+
+// ValidNonceSize reports whether n is the nonce length of any suite
+func ValidNonceSize(n int) bool {
+	for suite := range suiteNames {
+		if suite.NonceSize() == n {
+			return true
+		}
+	}
+	return false
+}
+
+// ciphertextHeaderVersion is the first byte of every ciphertext header
+const ciphertextHeaderVersion = 1
+
+// HeaderSize is the length of the header that Seal prefixes to ciphertexts
+const HeaderSize = 7
+
+// Header describes a sealed ciphertext: the header version, the suite, the version of
+// the key that sealed it (big-endian uint32) and the nonce length
+type Header struct {
+	Suite      Suite
+	KeyVersion int
+	NonceSize  int
+}
+
+func (h Header) marshal() []byte {
+	header := make([]byte, HeaderSize)
+	header[0] = ciphertextHeaderVersion
+	header[1] = byte(h.Suite)
+	binary.BigEndian.PutUint32(header[2:6], uint32(h.KeyVersion))
+	header[6] = byte(h.NonceSize)
+	return header
+}
+
+// ParseHeader splits a sealed ciphertext into its header and the AEAD output
+func ParseHeader(sealed []byte) (Header, []byte, error) {
+	if len(sealed) < HeaderSize {
+		return Header{}, nil, fmt.Errorf("ciphertext too short for a header")
+	}
+	if sealed[0] != ciphertextHeaderVersion {
+		return Header{}, nil, fmt.Errorf("unsupported ciphertext header version %d", sealed[0])
+	}
+
+	header := Header{
+		Suite:      Suite(sealed[1]),
+		KeyVersion: int(binary.BigEndian.Uint32(sealed[2:6])),
+		NonceSize:  int(sealed[6]),
+	}
+	if _, ok := suiteNames[header.Suite]; !ok {
+		return Header{}, nil, fmt.Errorf("unsupported cipher suite %d", sealed[1])
+	}
+	if header.NonceSize != header.Suite.NonceSize() {
+		return Header{}, nil, fmt.Errorf("nonce length %d does not match cipher suite %s", header.NonceSize, header.Suite)
+	}
+	return header, sealed[HeaderSize:], nil
+}
+
+// Seal encrypts plaintext with the suite under key and a fresh random nonce. The
+// result starts with a header naming the suite and keyVersion; the header is
+// authenticated together with aad.
+func Seal(suite Suite, keyVersion int, key, plaintext, aad []byte) ([]byte, []byte, error) {
+	aead, err := suite.newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	header := Header{Suite: suite, KeyVersion: keyVersion, NonceSize: len(nonce)}.marshal()
+	sealed := aead.Seal(header, nonce, plaintext, headerAAD(header, aad))
+	return sealed, nonce, nil
+}
+
+// Open decrypts a ciphertext produced by Seal with the suite named in its header
+func Open(key, sealed, nonce, aad []byte) ([]byte, error) {
+	header, ciphertext, err := ParseHeader(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != header.NonceSize {
+		return nil, fmt.Errorf("invalid nonce length: got %d bytes, expected %d bytes for %s", len(nonce), header.NonceSize, header.Suite)
+	}
+
+	aead, err := header.Suite.newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, headerAAD(sealed[:HeaderSize], aad))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// headerAAD returns the additional data authenticated by Seal: the header, then aad
+func headerAAD(header, aad []byte) []byte {
+	return append(append(make([]byte, 0, len(header)+len(aad)), header...), aad...)
+}
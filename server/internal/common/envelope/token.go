@@ -0,0 +1,158 @@
+package envelope
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Token formats record how a PII ciphertext was produced, so tokens written by older
+// releases keep decrypting after the format changes
+const (
+	// TokenFormatV2 tokens are AES-256-GCM ciphertexts without additional authenticated data
+	TokenFormatV2 = 2
+	// TokenFormatV3 tokens bind the ciphertext to its record with RecordAAD
+	TokenFormatV3 = 3
+	// TokenFormatV4 tokens are also encrypted with a field key derived per data type
+	// and record with DeriveFieldKey
+	TokenFormatV4 = 4
+	// TokenFormatV5 tokens are sealed with Seal: the ciphertext starts with a header
+	// naming the cipher suite, so it may use any Suite
+	TokenFormatV5 = 5
+
+	// CurrentTokenFormat is the format new tokens are written in
+	CurrentTokenFormat = TokenFormatV5
+)
+
+// KeySaltSize is the length of the random per-record salt of TokenFormatV4 tokens
+const KeySaltSize = 16
+
+// fieldKeyInfo starts the HKDF info of field keys; the data type follows it
+const fieldKeyInfo = "mistokenly/pii/field-key/v4"
+
+// recordAADMarker starts the additional authenticated data of TokenFormatV3 tokens
+const recordAADMarker = "mistokenly/pii/v3"
+
+// TokenType returns the token type reported to clients for a token format
+func TokenType(format int) string {
+	return fmt.Sprintf("PII_TOKEN_V%d_ENVELOPE", format)
+}
+
+// RecordAAD returns the additional authenticated data binding a PII ciphertext to its
+// token record. A ciphertext and IV copied to another row, or a row whose data type
+// or TEK version was altered, then fail authentication. The organization key is bound
+// through the derived encryption key rather than the AAD.
+//
+// Every field is length-prefixed so that no two records produce the same bytes.
+func RecordAAD(referenceHash, organizationID, dataType string, tekVersion int) []byte {
+	aad := make([]byte, 0, len(recordAADMarker)+len(referenceHash)+len(organizationID)+len(dataType)+16)
+	aad = append(aad, recordAADMarker...)
+	for _, field := range []string{referenceHash, organizationID, dataType} {
+		aad = binary.BigEndian.AppendUint32(aad, uint32(len(field)))
+		aad = append(aad, field...)
+	}
+	return binary.BigEndian.AppendUint32(aad, uint32(tekVersion))
+}
+
+// TokenAAD returns the additional authenticated data a token of the given format was
+// encrypted with: none for TokenFormatV2, RecordAAD from TokenFormatV3 on
+func TokenAAD(format int, referenceHash, organizationID, dataType string, tekVersion int) []byte {
+	if format < TokenFormatV3 {
+		return nil
+	}
+	return RecordAAD(referenceHash, organizationID, dataType, tekVersion)
+}
+
+// NewKeySalt returns a random per-record salt for DeriveFieldKey
+func NewKeySalt() ([]byte, error) {
+	salt := make([]byte, KeySaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate key salt: %w", err)
+	}
+	return salt, nil
+}
+
+// DeriveFieldKey derives the key that encrypts one record from the organization's
+// final encryption key (see DeriveKey) with HKDF-SHA256. The data type goes into the
+// info and the record's random salt is the salt, so every field type and every record
+// has its own key and a leaked field key exposes nothing else.
+func DeriveFieldKey(key []byte, dataType string, keySalt []byte) ([]byte, error) {
+	if len(keySalt) != KeySaltSize {
+		return nil, fmt.Errorf("invalid key salt length: got %d bytes, expected %d", len(keySalt), KeySaltSize)
+	}
+
+	kdf := hkdf.New(sha256.New, key, keySalt, dataTypeInfo(fieldKeyInfo, dataType))
+	fieldKey := make([]byte, 32)
+	if _, err := io.ReadFull(kdf, fieldKey); err != nil {
+		return nil, fmt.Errorf("failed to derive field key with HKDF: %w", err)
+	}
+
+	return fieldKey, nil
+}
+
+// dataTypeInfo returns an HKDF info: the label, then the length-prefixed data type
+func dataTypeInfo(label, dataType string) []byte {
+	info := make([]byte, 0, len(label)+4+len(dataType))
+	info = append(info, label...)
+	info = binary.BigEndian.AppendUint32(info, uint32(len(dataType)))
+	return append(info, dataType...)
+}
+
+// TokenKey returns the key a token of the given format is encrypted with: the
+// organization's final encryption key itself before TokenFormatV4, a field key from it on
+func TokenKey(format int, key []byte, dataType string, keySalt []byte) ([]byte, error) {
+	if format < TokenFormatV4 {
+		return key, nil
+	}
+	return DeriveFieldKey(key, dataType, keySalt)
+}
+
+// SealToken encrypts a token in the current format with the suite, recording the TEK
+// version in the ciphertext header
+func SealToken(suite Suite, tekVersion int, key, plaintext, aad []byte) ([]byte, []byte, error) {
+	return Seal(suite, tekVersion, key, plaintext, aad)
+}
+
+// OpenToken decrypts a token ciphertext of the given format. Tokens before
+// TokenFormatV5 are AES-256-GCM without a header; later ones dispatch on their header.
+func OpenToken(format int, key, ciphertext, iv, aad []byte) ([]byte, error) {
+	if format < TokenFormatV5 {
+		return DecryptWithAAD(key, ciphertext, iv, aad)
+	}
+	return Open(key, ciphertext, iv, aad)
+}
+
+// ResealToken encrypts plaintext in the same format, cipher suite and key version as
+// the token ciphertext it replaces
+func ResealToken(format int, previous, key, plaintext, aad []byte) ([]byte, []byte, error) {
+	if format < TokenFormatV5 {
+		return EncryptWithAAD(key, plaintext, aad)
+	}
+
+	header, _, err := ParseHeader(previous)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Seal(header.Suite, header.KeyVersion, key, plaintext, aad)
+}
+
+// TokenKeyVersion returns the TEK version that encrypted a token: the one named in the
+// ciphertext header from TokenFormatV5 on, otherwise the version recorded on the token
+func TokenKeyVersion(format int, ciphertext []byte, recorded int) (int, error) {
+	if format < TokenFormatV5 {
+		return max(recorded, 1), nil
+	}
+
+	header, _, err := ParseHeader(ciphertext)
+	if err != nil {
+		return 0, err
+	}
+	if recorded != 0 && header.KeyVersion != recorded {
+		return 0, fmt.Errorf("ciphertext header names TEK version %d but the token records version %d", header.KeyVersion, recorded)
+	}
+	return header.KeyVersion, nil
+}
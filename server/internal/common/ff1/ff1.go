@@ -0,0 +1,219 @@
+// Package ff1 implements the FF1 format-preserving encryption mode of NIST SP 800-38G
+// (Rev. 1) over AES. FF1 encrypts a string of numerals in a given radix to another
+// string of the same length and radix, for example a 16-digit card number to another
+// 16-digit number.
+package ff1
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	// MinDomainSize is the smallest number of possible inputs, radix^length, that
+	// SP 800-38G Rev. 1 allows
+	MinDomainSize = 1000000
+
+	// MaxRadix is the largest supported radix; numerals are 0-9 followed by a-z
+	MaxRadix = 36
+
+	// maxLength bounds the input length; the standard allows up to 2^32 numerals but
+	// format-preserving tokens are short
+	maxLength = 256
+
+	rounds    = 10
+	blockSize = aes.BlockSize
+)
+
+const numerals = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// Cipher is FF1 with a fixed key and radix
+type Cipher struct {
+	block     cipher.Block
+	radix     int
+	minLength int
+}
+
+// New returns FF1 keyed with a 16, 24 or 32-byte AES key for numerals in the given radix
+func New(key []byte, radix int) (*Cipher, error) {
+	if radix < 2 || radix > MaxRadix {
+		return nil, fmt.Errorf("ff1: unsupported radix %d", radix)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("ff1: %w", err)
+	}
+
+	minLength := 2
+	for domain := radix * radix; domain < MinDomainSize; domain *= radix {
+		minLength++
+	}
+	return &Cipher{block: block, radix: radix, minLength: minLength}, nil
+}
+
+// MinLength returns the shortest input the cipher accepts, so that radix^length is at
+// least MinDomainSize
+func (c *Cipher) MinLength() int {
+	return c.minLength
+}
+
+// Encrypt encrypts a string of numerals under the tweak
+func (c *Cipher) Encrypt(x string, tweak []byte) (string, error) {
+	return c.crypt(x, tweak, true)
+}
+
+// Decrypt reverses Encrypt with the same tweak
+func (c *Cipher) Decrypt(x string, tweak []byte) (string, error) {
+	return c.crypt(x, tweak, false)
+}
+
+// crypt runs the ten Feistel rounds of FF1 (SP 800-38G, Algorithms 7 and 8)
+func (c *Cipher) crypt(x string, tweak []byte, encrypt bool) (string, error) {
+	n := len(x)
+	if n < c.minLength || n > maxLength {
+		return "", fmt.Errorf("ff1: input length %d is outside [%d, %d] for radix %d", n, c.minLength, maxLength, c.radix)
+	}
+	for i := 0; i < n; i++ {
+		if numeralValue(x[i]) >= c.radix {
+			return "", fmt.Errorf("ff1: invalid numeral %q for radix %d", x[i], c.radix)
+		}
+	}
+
+	u := n / 2
+	v := n - u
+	a, b := x[:u], x[u:]
+
+	// byteLen is b in the standard, the byte length of NUM(B) for the longer half; d is
+	// the byte length of y
+	byteLen := int(math.Ceil(math.Ceil(float64(v)*math.Log2(float64(c.radix))) / 8))
+	d := 4*((byteLen+3)/4) + 4
+
+	p := [blockSize]byte{1, 2, 1}
+	p[3] = byte(c.radix >> 16)
+	p[4] = byte(c.radix >> 8)
+	p[5] = byte(c.radix)
+	p[6] = 10
+	p[7] = byte(u)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(len(tweak)))
+
+	// Q is the tweak, zero padding, the round number and NUM(B), a multiple of 16 bytes
+	padding := (-len(tweak) - byteLen - 1) % blockSize
+	if padding < 0 {
+		padding += blockSize
+	}
+	q := make([]byte, len(tweak)+padding+1+byteLen)
+	copy(q, tweak)
+
+	radix := big.NewInt(int64(c.radix))
+	modU := new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	modV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+
+	numA, numB := c.num(a), c.num(b)
+	y := new(big.Int)
+	s := make([]byte, ((d+blockSize-1)/blockSize)*blockSize)
+
+	for round := 0; round < rounds; round++ {
+		i := round
+		if !encrypt {
+			i = rounds - 1 - round
+		}
+
+		// Encryption feeds B into the round function, decryption A
+		in := numB
+		if !encrypt {
+			in = numA
+		}
+		q[len(q)-byteLen-1] = byte(i)
+		in.FillBytes(q[len(q)-byteLen:])
+
+		c.expand(s, c.prf(p[:], q))
+		y.SetBytes(s[:d])
+
+		mod := modU
+		if i%2 == 1 {
+			mod = modV
+		}
+
+		if encrypt {
+			cNum := new(big.Int).Add(numA, y)
+			cNum.Mod(cNum, mod)
+			numA, numB = numB, cNum
+		} else {
+			cNum := new(big.Int).Sub(numB, y)
+			cNum.Mod(cNum, mod)
+			numA, numB = cNum, numA
+		}
+	}
+
+	return c.str(numA, u) + c.str(numB, v), nil
+}
+
+// prf is the CBC-MAC of P || Q with a zero IV
+func (c *Cipher) prf(p, q []byte) [blockSize]byte {
+	var r [blockSize]byte
+	c.block.Encrypt(r[:], p)
+	for len(q) > 0 {
+		for i := 0; i < blockSize; i++ {
+			r[i] ^= q[i]
+		}
+		c.block.Encrypt(r[:], r[:])
+		q = q[blockSize:]
+	}
+	return r
+}
+
+// expand fills s with R || CIPH(R xor [1]) || CIPH(R xor [2]) || ...
+func (c *Cipher) expand(s []byte, r [blockSize]byte) {
+	copy(s, r[:])
+	for j := 1; j*blockSize < len(s); j++ {
+		var block [blockSize]byte
+		binary.BigEndian.PutUint64(block[8:], uint64(j))
+		for i := range block {
+			block[i] ^= r[i]
+		}
+		c.block.Encrypt(s[j*blockSize:], block[:])
+	}
+}
+
+// num is the value of the numeral string in the cipher's radix
+func (c *Cipher) num(x string) *big.Int {
+	radix := big.NewInt(int64(c.radix))
+	value := new(big.Int)
+	for i := 0; i < len(x); i++ {
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(numeralValue(x[i]))))
+	}
+	return value
+}
+
+// str is the numeral string of the given length that represents value
+func (c *Cipher) str(value *big.Int, length int) string {
+	radix := big.NewInt(int64(c.radix))
+	value = new(big.Int).Set(value)
+	digit := new(big.Int)
+
+	out := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		value.DivMod(value, radix, digit)
+		out[i] = numerals[digit.Int64()]
+	}
+	return string(out)
+}
+
+// numeralValue returns the value of a numeral, or MaxRadix if it is not one
+func numeralValue(ch byte) int {
+	switch {
+	case ch >= '0' && ch <= '9':
+		return int(ch - '0')
+	case ch >= 'a' && ch <= 'z':
+		return int(ch-'a') + 10
+	default:
+		return MaxRadix
+	}
+}
@@ -0,0 +1,123 @@
+package ff1
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// Sample values from NIST's FF1 examples for SP 800-38G
+// (https://csrc.nist.gov/groups/ST/toolkit/documents/Examples/FF1samples.pdf)
+var nistSamples = []struct {
+	name       string
+	key        string
+	radix      int
+	tweak      string
+	plaintext  string
+	ciphertext string
+}{
+	{"Sample 1", "2b7e151628aed2a6abf7158809cf4f3c", 10, "", "0123456789", "2433477484"},
+	{"Sample 2", "2b7e151628aed2a6abf7158809cf4f3c", 10, "39383736353433323130", "0123456789", "6124200773"},
+	{"Sample 3", "2b7e151628aed2a6abf7158809cf4f3c", 36, "3737373770717273373737", "0123456789abcdefghi", "a9tv40mll9kdu509eum"},
+	{"Sample 4", "2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f", 10, "", "0123456789", "2830668132"},
+	{"Sample 5", "2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f", 10, "39383736353433323130", "0123456789", "2496655549"},
+	{"Sample 6", "2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f", 36, "3737373770717273373737", "0123456789abcdefghi", "xbj3kv35jrawxv32ysr"},
+	{"Sample 7", "2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f7f036d6f04fc6a94", 10, "", "0123456789", "6657667009"},
+	{"Sample 8", "2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f7f036d6f04fc6a94", 10, "39383736353433323130", "0123456789", "1001623463"},
+	{"Sample 9", "2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f7f036d6f04fc6a94", 36, "3737373770717273373737", "0123456789abcdefghi", "xs8a0azh2avyalyzuwd"},
+}
+
+func TestNISTSamples(t *testing.T) {
+	for _, sample := range nistSamples {
+		t.Run(sample.name, func(t *testing.T) {
+			key, _ := hex.DecodeString(sample.key)
+			tweak, _ := hex.DecodeString(sample.tweak)
+
+			c, err := New(key, sample.radix)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+
+			ciphertext, err := c.Encrypt(sample.plaintext, tweak)
+			if err != nil {
+				t.Fatalf("Encrypt: %v", err)
+			}
+			if ciphertext != sample.ciphertext {
+				t.Errorf("Encrypt = %s, want %s", ciphertext, sample.ciphertext)
+			}
+
+			plaintext, err := c.Decrypt(sample.ciphertext, tweak)
+			if err != nil {
+				t.Fatalf("Decrypt: %v", err)
+			}
+			if plaintext != sample.plaintext {
+				t.Errorf("Decrypt = %s, want %s", plaintext, sample.plaintext)
+			}
+		})
+	}
+}
+
+func TestRoundTripLengths(t *testing.T) {
+	key, _ := hex.DecodeString("2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f7f036d6f04fc6a94")
+	c, err := New(key, 10)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	// Odd and even lengths, and lengths whose halves need more than one AES block
+	for _, length := range []int{c.MinLength(), 7, 9, 16, 19, 40, 101, maxLength} {
+		plaintext := strings.Repeat("9876543210", maxLength/10+1)[:length]
+		tweak := []byte("tweak")
+
+		ciphertext, err := c.Encrypt(plaintext, tweak)
+		if err != nil {
+			t.Fatalf("Encrypt of %d digits: %v", length, err)
+		}
+		if len(ciphertext) != length || strings.Trim(ciphertext, "0123456789") != "" {
+			t.Fatalf("Encrypt of %d digits returned %q", length, ciphertext)
+		}
+
+		decrypted, err := c.Decrypt(ciphertext, tweak)
+		if err != nil {
+			t.Fatalf("Decrypt of %d digits: %v", length, err)
+		}
+		if decrypted != plaintext {
+			t.Fatalf("round trip of %d digits gave %s, want %s", length, decrypted, plaintext)
+		}
+
+		if other, _ := c.Decrypt(ciphertext, []byte("other")); other == plaintext {
+			t.Errorf("Decrypt of %d digits with another tweak returned the plaintext", length)
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	key := make([]byte, 32)
+
+	if _, err := New(key, 1); err == nil {
+		t.Error("New accepted radix 1")
+	}
+	if _, err := New(key, MaxRadix+1); err == nil {
+		t.Error("New accepted a radix above MaxRadix")
+	}
+	if _, err := New(key[:15], 10); err == nil {
+		t.Error("New accepted a 15-byte key")
+	}
+
+	c, err := New(key, 10)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if c.MinLength() != 6 {
+		t.Errorf("MinLength for radix 10 = %d, want 6", c.MinLength())
+	}
+	if _, err := c.Encrypt("12345", nil); err == nil {
+		t.Error("Encrypt accepted an input below the minimum domain size")
+	}
+	if _, err := c.Encrypt(strings.Repeat("1", maxLength+1), nil); err == nil {
+		t.Error("Encrypt accepted an input above the maximum length")
+	}
+	if _, err := c.Encrypt("12345a", nil); err == nil {
+		t.Error("Encrypt accepted a numeral outside the radix")
+	}
+}
@@ -0,0 +1,162 @@
+// Package gcmsiv implements AES-GCM-SIV (RFC 8452), a nonce-misuse-resistant AEAD.
+// Repeating a nonce under the same key only reveals whether two messages were
+// identical, instead of breaking confidentiality and authenticity as with AES-GCM.
+package gcmsiv
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// NonceSize is the AES-GCM-SIV nonce length in bytes
+	NonceSize = 12
+	// TagSize is the AES-GCM-SIV tag length in bytes
+	TagSize = 16
+
+	blockSize = 16
+)
+
+var errOpen = errors.New("cipher: message authentication failed")
+
+type aead struct {
+	keyGen cipher.Block // Key-generating key; per-nonce keys are derived from it
+	keyLen int
+}
+
+// New returns AES-GCM-SIV with a 16-byte (AES-128) or 32-byte (AES-256) key
+func New(key []byte) (cipher.AEAD, error) {
+	if len(key) != 16 && len(key) != 32 {
+		return nil, fmt.Errorf("gcmsiv: invalid key size %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &aead{keyGen: block, keyLen: len(key)}, nil
+}
+
+func (a *aead) NonceSize() int { return NonceSize }
+
+func (a *aead) Overhead() int { return TagSize }
+
+func (a *aead) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	if len(nonce) != NonceSize {
+		panic("gcmsiv: incorrect nonce length given to AES-GCM-SIV")
+	}
+
+	authKey, encBlock := a.deriveKeys(nonce)
+	defer clear(authKey)
+
+	tag := a.tag(authKey, encBlock, nonce, plaintext, additionalData)
+
+	ret, out := sliceForAppend(dst, len(plaintext)+TagSize)
+	ctr(encBlock, tag, out[:len(plaintext)], plaintext)
+	copy(out[len(plaintext):], tag[:])
+	return ret
+}
+
+func (a *aead) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != NonceSize {
+		panic("gcmsiv: incorrect nonce length given to AES-GCM-SIV")
+	}
+	if len(ciphertext) < TagSize {
+		return nil, errOpen
+	}
+
+	authKey, encBlock := a.deriveKeys(nonce)
+	defer clear(authKey)
+
+	var tag [TagSize]byte
+	copy(tag[:], ciphertext[len(ciphertext)-TagSize:])
+	ciphertext = ciphertext[:len(ciphertext)-TagSize]
+
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	ctr(encBlock, tag, out, ciphertext)
+
+	expected := a.tag(authKey, encBlock, nonce, out, additionalData)
+	if subtle.ConstantTimeCompare(expected[:], tag[:]) != 1 {
+		clear(out)
+		return nil, errOpen
+	}
+	return ret, nil
+}
+
+// deriveKeys derives the per-nonce message authentication key and encryption cipher
+func (a *aead) deriveKeys(nonce []byte) ([]byte, cipher.Block) {
+	var in, out [blockSize]byte
+	copy(in[4:], nonce)
+
+	// Each AES output contributes its first 8 bytes: two for the authentication key,
+	// then two (AES-128) or four (AES-256) for the encryption key
+	derived := make([]byte, 0, 16+a.keyLen)
+	for i := uint32(0); len(derived) < cap(derived); i++ {
+		binary.LittleEndian.PutUint32(in[:4], i)
+		a.keyGen.Encrypt(out[:], in[:])
+		derived = append(derived, out[:8]...)
+	}
+	defer clear(derived[16:])
+
+	encBlock, err := aes.NewCipher(derived[16:])
+	if err != nil {
+		// Unreachable: the derived key has the same valid length as the key-generating key
+		panic(err)
+	}
+	return derived[:16], encBlock
+}
+
+// tag computes the authentication tag over the plaintext and additional data
+func (a *aead) tag(authKey []byte, encBlock cipher.Block, nonce, plaintext, additionalData []byte) [TagSize]byte {
+	var lengths [blockSize]byte
+	binary.LittleEndian.PutUint64(lengths[:8], uint64(len(additionalData))*8)
+	binary.LittleEndian.PutUint64(lengths[8:], uint64(len(plaintext))*8)
+
+	p := newPolyval(authKey)
+	p.update(additionalData)
+	p.update(plaintext)
+	p.update(lengths[:])
+
+	s := p.sum()
+	for i := range nonce {
+		s[i] ^= nonce[i]
+	}
+	s[15] &= 0x7f
+
+	var tag [TagSize]byte
+	encBlock.Encrypt(tag[:], s[:])
+	return tag
+}
+
+// ctr applies AES-CTR with the tag as the initial counter block. Only the first 32
+// bits, read little-endian, are incremented.
+func ctr(block cipher.Block, tag [TagSize]byte, dst, src []byte) {
+	counter := tag
+	counter[15] |= 0x80
+
+	var keystream [blockSize]byte
+	for len(src) > 0 {
+		block.Encrypt(keystream[:], counter[:])
+		n := subtle.XORBytes(dst, src, keystream[:])
+		dst, src = dst[n:], src[n:]
+
+		binary.LittleEndian.PutUint32(counter[:4], binary.LittleEndian.Uint32(counter[:4])+1)
+	}
+	clear(keystream[:])
+}
+
+// sliceForAppend extends in by n bytes, returning the whole slice and the extension
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+	tail = head[len(in):]
+	return
+}
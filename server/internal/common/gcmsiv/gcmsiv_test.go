@@ -0,0 +1,139 @@
+package gcmsiv
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from RFC 8452, Appendix C. result is the ciphertext followed by the tag.
+var rfc8452Vectors = []struct {
+	name      string
+	key       string
+	nonce     string
+	aad       string
+	plaintext string
+	result    string
+}{
+	// C.1, AEAD_AES_128_GCM_SIV
+	{"C.1 empty", "01000000000000000000000000000000", "030000000000000000000000", "", "",
+		"dc20e2d83f25705bb49e439eca56de25"},
+	{"C.1 8 bytes", "01000000000000000000000000000000", "030000000000000000000000", "", "0100000000000000",
+		"b5d839330ac7b786578782fff6013b815b287c22493a364c"},
+	{"C.1 12 bytes", "01000000000000000000000000000000", "030000000000000000000000", "", "010000000000000000000000",
+		"7323ea61d05932260047d942a4978db357391a0bc4fdec8b0d106639"},
+	{"C.1 16 bytes", "01000000000000000000000000000000", "030000000000000000000000", "", "01000000000000000000000000000000",
+		"743f7c8077ab25f8624e2e948579cf77303aaf90f6fe21199c6068577437a0c4"},
+	{"C.1 32 bytes", "01000000000000000000000000000000", "030000000000000000000000", "", "0100000000000000000000000000000002000000000000000000000000000000",
+		"84e07e62ba83a6585417245d7ec413a9fe427d6315c09b57ce45f2e3936a94451a8e45dcd4578c667cd86847bf6155ff"},
+	{"C.1 AAD, 8 bytes", "01000000000000000000000000000000", "030000000000000000000000", "01", "0200000000000000",
+		"1e6daba35669f4273b0a1a2560969cdf790d99759abd1508"},
+
+	// C.2, AEAD_AES_256_GCM_SIV
+	{"C.2 empty", "0100000000000000000000000000000000000000000000000000000000000000", "030000000000000000000000", "", "",
+		"07f5f4169bbf55a8400cd47ea6fd400f"},
+	{"C.2 8 bytes", "0100000000000000000000000000000000000000000000000000000000000000", "030000000000000000000000", "", "0100000000000000",
+		"c2ef328e5c71c83b843122130f7364b761e0b97427e3df28"},
+	{"C.2 12 bytes", "0100000000000000000000000000000000000000000000000000000000000000", "030000000000000000000000", "", "010000000000000000000000",
+		"9aab2aeb3faa0a34aea8e2b18ca50da9ae6559e48fd10f6e5c9ca17e"},
+	{"C.2 16 bytes", "0100000000000000000000000000000000000000000000000000000000000000", "030000000000000000000000", "", "01000000000000000000000000000000",
+		"85a01b63025ba19b7fd3ddfc033b3e76c9eac6fa700942702e90862383c6c366"},
+	{"C.2 AAD, 8 bytes", "0100000000000000000000000000000000000000000000000000000000000000", "030000000000000000000000", "01", "0200000000000000",
+		"1de22967237a813291213f267e3b452f02d01ae33e4ec854"},
+
+	// C.3, counter wrap
+	{"C.3 counter wrap 1", "0000000000000000000000000000000000000000000000000000000000000000", "000000000000000000000000", "", "000000000000000000000000000000004db923dc793ee6497c76dcc03a98e108",
+		"f3f80f2cf0cb2dd9c5984fcda908456cc537703b5ba70324a6793a7bf218d3eaffffffff000000000000000000000000"},
+	{"C.3 counter wrap 2", "0000000000000000000000000000000000000000000000000000000000000000", "000000000000000000000000", "", "eb3640277c7ffd1303c7a542d02d3e4c0000000000000000",
+		"18ce4f0b8cb4d0cac65fea8f79257b20888e53e72299e56dffffffff000000000000000000000000"},
+}
+
+func TestRFC8452Vectors(t *testing.T) {
+	for _, v := range rfc8452Vectors {
+		t.Run(v.name, func(t *testing.T) {
+			key, _ := hex.DecodeString(v.key)
+			nonce, _ := hex.DecodeString(v.nonce)
+			aad, _ := hex.DecodeString(v.aad)
+			plaintext, _ := hex.DecodeString(v.plaintext)
+			want, _ := hex.DecodeString(v.result)
+
+			a, err := New(key)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+
+			got := a.Seal(nil, nonce, plaintext, aad)
+			if !bytes.Equal(got, want) {
+				t.Errorf("Seal = %x, want %x", got, want)
+			}
+
+			opened, err := a.Open(nil, nonce, want, aad)
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			if !bytes.Equal(opened, plaintext) {
+				t.Errorf("Open = %x, want %x", opened, plaintext)
+			}
+		})
+	}
+}
+
+func TestOpenRejectsTampering(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	nonce := bytes.Repeat([]byte{9}, NonceSize)
+	aad := []byte("record")
+
+	a, err := New(key)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	sealed := a.Seal(nil, nonce, []byte("4111111111111111"), aad)
+
+	for i := range sealed {
+		tampered := bytes.Clone(sealed)
+		tampered[i] ^= 0x80
+		if _, err := a.Open(nil, nonce, tampered, aad); err == nil {
+			t.Fatalf("Open accepted a ciphertext with byte %d flipped", i)
+		}
+	}
+	if _, err := a.Open(nil, nonce, sealed, []byte("other record")); err == nil {
+		t.Error("Open accepted different additional data")
+	}
+	otherNonce := bytes.Clone(nonce)
+	otherNonce[0] ^= 1
+	if _, err := a.Open(nil, otherNonce, sealed, aad); err == nil {
+		t.Error("Open accepted a different nonce")
+	}
+	if _, err := a.Open(nil, nonce, sealed[:TagSize-1], aad); err == nil {
+		t.Error("Open accepted a ciphertext shorter than the tag")
+	}
+}
+
+func TestSealAppendsToDst(t *testing.T) {
+	a, err := New(make([]byte, 16))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	nonce := make([]byte, NonceSize)
+
+	prefix := []byte("prefix")
+	sealed := a.Seal(bytes.Clone(prefix), nonce, []byte("plaintext"), nil)
+	if !bytes.HasPrefix(sealed, prefix) {
+		t.Fatal("Seal did not append to dst")
+	}
+	opened, err := a.Open(bytes.Clone(prefix), nonce, sealed[len(prefix):], nil)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if string(opened) != "prefixplaintext" {
+		t.Errorf("Open = %q, want %q", opened, "prefixplaintext")
+	}
+}
+
+func TestInvalidKeySize(t *testing.T) {
+	for _, size := range []int{0, 15, 24, 33} {
+		if _, err := New(make([]byte, size)); err == nil {
+			t.Errorf("New accepted a %d-byte key", size)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package gcmsiv
+
+import "encoding/binary"
+
+// fieldElement is an element of GF(2^128) modulo x^128 + x^127 + x^126 + x^121 + 1
+// in POLYVAL's little-endian order: bit i of lo is the coefficient of x^i, bit i of
+// hi that of x^(64+i)
+type fieldElement struct {
+	lo, hi uint64
+}
+
+func loadElement(b []byte) fieldElement {
+	return fieldElement{
+		lo: binary.LittleEndian.Uint64(b[:8]),
+		hi: binary.LittleEndian.Uint64(b[8:16]),
+	}
+}
+
+// mulX multiplies by x
+func (e fieldElement) mulX() fieldElement {
+	carry := -(e.hi >> 63) // All ones if x^127 is set
+	return fieldElement{
+		lo: e.lo<<1 ^ carry&1,
+		hi: (e.hi<<1 | e.lo>>63) ^ carry&(1<<63|1<<62|1<<57),
+	}
+}
+
+// divX multiplies by x^-1, which is x^127 + x^126 + x^125 + x^120
+func (e fieldElement) divX() fieldElement {
+	odd := -(e.lo & 1) // All ones if the constant term is set; add the modulus first
+	lo := e.lo ^ odd&1
+	hi := e.hi ^ odd&(1<<63|1<<62|1<<57)
+	return fieldElement{
+		lo: lo>>1 | hi<<63,
+		hi: hi>>1 | odd&(1<<63),
+	}
+}
+
+// mul multiplies two elements in constant time
+func mul(a, b fieldElement) fieldElement {
+	var r fieldElement
+	for _, word := range [2]uint64{a.lo, a.hi} {
+		for i := 0; i < 64; i++ {
+			bit := -(word >> i & 1)
+			r.lo ^= b.lo & bit
+			r.hi ^= b.hi & bit
+			b = b.mulX()
+		}
+	}
+	return r
+}
+
+// polyval computes POLYVAL(H, X_1, ..., X_n) over zero-padded input:
+// S_j = (S_{j-1} + X_j) * H * x^-128
+type polyval struct {
+	h fieldElement // H * x^-128, so each block needs one multiplication
+	s fieldElement
+}
+
+func newPolyval(key []byte) *polyval {
+	h := loadElement(key)
+	for i := 0; i < 128; i++ {
+		h = h.divX()
+	}
+	return &polyval{h: h}
+}
+
+// update absorbs data, zero-padding its last block
+func (p *polyval) update(data []byte) {
+	for len(data) > 0 {
+		var block [blockSize]byte
+		n := copy(block[:], data)
+		data = data[n:]
+
+		x := loadElement(block[:])
+		p.s = mul(fieldElement{lo: p.s.lo ^ x.lo, hi: p.s.hi ^ x.hi}, p.h)
+	}
+}
+
+func (p *polyval) sum() [blockSize]byte {
+	var out [blockSize]byte
+	binary.LittleEndian.PutUint64(out[:8], p.s.lo)
+	binary.LittleEndian.PutUint64(out[8:], p.s.hi)
+	return out
+}
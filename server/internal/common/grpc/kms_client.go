@@ -0,0 +1,167 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/PlainFunction/mistokenly/proto/kms"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// kmsRequestTimeout bounds every call to the key service
+	kmsRequestTimeout = 10 * time.Second
+
+	// kmsKeyInfoTTL is how long the key service's KEK ring is cached, so a KEK rotation
+	// on the key service is picked up without a restart
+	kmsKeyInfoTTL = time.Minute
+)
+
+// KMSServiceGRPCClient is a types.KEKProvider backed by the remote key service. TEKs
+// are sent to the key service to be wrapped and unwrapped, so this process never
+// holds the KEK.
+type KMSServiceGRPCClient struct {
+	conn              *grpc.ClientConn
+	client            pb.KMSServiceClient
+	requestingService string // Recorded in the key service's audit log
+
+	mu               sync.Mutex
+	currentKEKID     string
+	kekIDs           []string
+	keyInfoExpiresAt time.Time
+}
+
+// NewKMSServiceGRPCClient creates a new gRPC client for the key service
+func NewKMSServiceGRPCClient(serviceAddr, requestingService string) (*KMSServiceGRPCClient, error) {
+	log.Printf("[gRPC Client] Connecting to KMS service at %s", serviceAddr)
+
+	// Create gRPC connection
+	conn, err := grpc.Dial(serviceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to KMS service: %w", err)
+	}
+
+	c := &KMSServiceGRPCClient{
+		conn:              conn,
+		client:            pb.NewKMSServiceClient(conn),
+		requestingService: requestingService,
+	}
+
+	// Test the connection by loading the KEK ring
+	if err := c.refreshKeyInfo(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to verify KMS service connection: %w", err)
+	}
+
+	log.Printf("[gRPC Client] Successfully connected to KMS service (current KEK: %s)", c.CurrentKEKID())
+
+	return c, nil
+}
+
+// Close closes the gRPC connection
+func (c *KMSServiceGRPCClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// WrapTEK calls the remote key service to wrap a TEK with the current KEK
+func (c *KMSServiceGRPCClient) WrapTEK(tek []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.WrapKey(ctx, &pb.WrapKeyRequest{
+		Key:               tek,
+		RequestingService: c.requestingService,
+	})
+	if err != nil {
+		log.Printf("[gRPC Client] WrapKey failed: %v", err)
+		return nil, fmt.Errorf("gRPC wrap key failed: %w", err)
+	}
+
+	return resp.WrappedKey, nil
+}
+
+// UnwrapTEK calls the remote key service to unwrap a TEK
+func (c *KMSServiceGRPCClient) UnwrapTEK(encryptedTEK []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.UnwrapKey(ctx, &pb.UnwrapKeyRequest{
+		WrappedKey:        encryptedTEK,
+		RequestingService: c.requestingService,
+	})
+	if err != nil {
+		log.Printf("[gRPC Client] UnwrapKey failed: %v", err)
+		return nil, fmt.Errorf("gRPC unwrap key failed: %w", err)
+	}
+
+	return resp.Key, nil
+}
+
+// CurrentKEKID returns the ID of the KEK the key service wraps new TEKs with
+func (c *KMSServiceGRPCClient) CurrentKEKID() string {
+	currentKEKID, _ := c.keyInfo()
+	return currentKEKID
+}
+
+// KEKIDs returns the KEKs the key service can unwrap with, current first
+func (c *KMSServiceGRPCClient) KEKIDs() []string {
+	_, kekIDs := c.keyInfo()
+	return kekIDs
+}
+
+// WrappedKEKID asks the key service which KEK wrapped a TEK. It returns "" if the key
+// service cannot be reached.
+func (c *KMSServiceGRPCClient) WrappedKEKID(encryptedTEK []byte) string {
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetKeyInfo(ctx, &pb.GetKeyInfoRequest{WrappedKeys: [][]byte{encryptedTEK}})
+	if err != nil || len(resp.WrappedKekIds) != 1 {
+		log.Printf("[gRPC Client] GetKeyInfo failed: %v", err)
+		return ""
+	}
+	return resp.WrappedKekIds[0]
+}
+
+// keyInfo returns the cached KEK ring, refreshing it once it has expired. A stale ring
+// is kept if the key service cannot be reached.
+func (c *KMSServiceGRPCClient) keyInfo() (string, []string) {
+	c.mu.Lock()
+	expired := time.Now().After(c.keyInfoExpiresAt)
+	c.mu.Unlock()
+
+	if expired {
+		if err := c.refreshKeyInfo(); err != nil {
+			log.Printf("⚠️  [gRPC Client] Failed to refresh KEK ring from KMS service: %v", err)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.currentKEKID, append([]string(nil), c.kekIDs...)
+}
+
+// refreshKeyInfo loads the current KEK and the KEK ring from the key service
+func (c *KMSServiceGRPCClient) refreshKeyInfo() error {
+	ctx, cancel := context.WithTimeout(context.Background(), kmsRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetKeyInfo(ctx, &pb.GetKeyInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("gRPC get key info failed: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.currentKEKID = resp.CurrentKekId
+	c.kekIDs = resp.KekIds
+	c.keyInfoExpiresAt = time.Now().Add(kmsKeyInfoTTL)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/PlainFunction/mistokenly/internal/common/types"
 	pb "github.com/PlainFunction/mistokenly/proto/persistence"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -83,6 +84,19 @@ func (c *PersistenceServiceGRPCClient) RetrievePIIToken(ctx context.Context, req
 	return resp, nil
 }
 
+// LookupPIITokens calls the remote Persistence service to find tokens by blind index
+func (c *PersistenceServiceGRPCClient) LookupPIITokens(ctx context.Context, req *pb.LookupPIITokensRequest) (*pb.LookupPIITokensResponse, error) {
+	log.Printf("[gRPC Client] Calling remote LookupPIITokens for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.LookupPIITokens(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] LookupPIITokens failed: %v", err)
+		return nil, fmt.Errorf("gRPC lookup PII tokens failed: %w", err)
+	}
+
+	return resp, nil
+}
+
 // HealthCheck calls the remote Persistence service health check
 func (c *PersistenceServiceGRPCClient) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
 	log.Printf("[gRPC Client] Calling remote HealthCheck")
@@ -119,8 +133,243 @@ func (c *PersistenceServiceGRPCClient) RetrieveTEK(ctx context.Context, req *pb.
 	resp, err := c.client.RetrieveTEK(ctx, req)
 	if err != nil {
 		log.Printf("[gRPC Client] RetrieveTEK failed: %v", err)
+		// Surface not-found and key-mismatch as typed errors
+		if tekErr := types.TEKError(err); tekErr != err {
+			return nil, tekErr
+		}
 		return nil, fmt.Errorf("gRPC retrieve TEK failed: %w", err)
 	}
 
 	return resp, nil
 }
+
+// CreateOrganization calls the remote Persistence service to onboard an organization
+func (c *PersistenceServiceGRPCClient) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote CreateOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.CreateOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] CreateOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC create organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetOrganization calls the remote Persistence service to load an organization
+func (c *PersistenceServiceGRPCClient) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote GetOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.GetOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] GetOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC get organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListOrganizations calls the remote Persistence service to list organizations
+func (c *PersistenceServiceGRPCClient) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ListOrganizations")
+
+	resp, err := c.client.ListOrganizations(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ListOrganizations failed: %v", err)
+		return nil, fmt.Errorf("gRPC list organizations failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SuspendOrganization calls the remote Persistence service to suspend an organization
+func (c *PersistenceServiceGRPCClient) SuspendOrganization(ctx context.Context, req *pb.SuspendOrganizationRequest) (*pb.SuspendOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote SuspendOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.SuspendOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] SuspendOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC suspend organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ReactivateOrganization calls the remote Persistence service to reactivate an organization
+func (c *PersistenceServiceGRPCClient) ReactivateOrganization(ctx context.Context, req *pb.ReactivateOrganizationRequest) (*pb.ReactivateOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ReactivateOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.ReactivateOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ReactivateOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC reactivate organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UnlockOrganization calls the remote Persistence service to clear an organization's lockout
+func (c *PersistenceServiceGRPCClient) UnlockOrganization(ctx context.Context, req *pb.UnlockOrganizationRequest) (*pb.UnlockOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote UnlockOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.UnlockOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] UnlockOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC unlock organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetOrganizationCipherSuite calls the remote Persistence service to change an organization's cipher suite
+func (c *PersistenceServiceGRPCClient) SetOrganizationCipherSuite(ctx context.Context, req *pb.SetOrganizationCipherSuiteRequest) (*pb.SetOrganizationCipherSuiteResponse, error) {
+	log.Printf("[gRPC Client] Calling remote SetOrganizationCipherSuite for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.SetOrganizationCipherSuite(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] SetOrganizationCipherSuite failed: %v", err)
+		return nil, fmt.Errorf("gRPC set organization cipher suite failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetDeterministicDataTypes calls the remote Persistence service to change the data types an organization tokenizes deterministically
+func (c *PersistenceServiceGRPCClient) SetDeterministicDataTypes(ctx context.Context, req *pb.SetDeterministicDataTypesRequest) (*pb.SetDeterministicDataTypesResponse, error) {
+	log.Printf("[gRPC Client] Calling remote SetDeterministicDataTypes for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.SetDeterministicDataTypes(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] SetDeterministicDataTypes failed: %v", err)
+		return nil, fmt.Errorf("gRPC set deterministic data types failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ShredOrganization calls the remote Persistence service to crypto-shred an organization
+func (c *PersistenceServiceGRPCClient) ShredOrganization(ctx context.Context, req *pb.ShredOrganizationRequest) (*pb.ShredOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ShredOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.ShredOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ShredOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC shred organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RotateTEK calls the remote Persistence service to rotate an organization's TEK
+func (c *PersistenceServiceGRPCClient) RotateTEK(ctx context.Context, req *pb.RotateTEKRequest) (*pb.RotateTEKResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RotateTEK for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.RotateTEK(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RotateTEK failed: %v", err)
+		return nil, fmt.Errorf("gRPC rotate TEK failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RotateOrganizationKey calls the remote Persistence service to rotate an organization's key
+func (c *PersistenceServiceGRPCClient) RotateOrganizationKey(ctx context.Context, req *pb.RotateOrganizationKeyRequest) (*pb.RotateOrganizationKeyResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RotateOrganizationKey for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.RotateOrganizationKey(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RotateOrganizationKey failed: %v", err)
+		return nil, fmt.Errorf("gRPC rotate organization key failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetOrganizationKeyRotation calls the remote Persistence service for an organization's key rotation progress
+func (c *PersistenceServiceGRPCClient) GetOrganizationKeyRotation(ctx context.Context, req *pb.GetOrganizationKeyRotationRequest) (*pb.GetOrganizationKeyRotationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote GetOrganizationKeyRotation for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.GetOrganizationKeyRotation(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] GetOrganizationKeyRotation failed: %v", err)
+		return nil, fmt.Errorf("gRPC get organization key rotation failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RewrapTEKs calls the remote Persistence service to rewrap stored TEKs under the current KEK
+func (c *PersistenceServiceGRPCClient) RewrapTEKs(ctx context.Context, req *pb.RewrapTEKsRequest) (*pb.RewrapTEKsResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RewrapTEKs")
+
+	resp, err := c.client.RewrapTEKs(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RewrapTEKs failed: %v", err)
+		return nil, fmt.Errorf("gRPC rewrap TEKs failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetKEKStatus calls the remote Persistence service for the KEK references of stored TEKs
+func (c *PersistenceServiceGRPCClient) GetKEKStatus(ctx context.Context, req *pb.GetKEKStatusRequest) (*pb.GetKEKStatusResponse, error) {
+	log.Printf("[gRPC Client] Calling remote GetKEKStatus")
+
+	resp, err := c.client.GetKEKStatus(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] GetKEKStatus failed: %v", err)
+		return nil, fmt.Errorf("gRPC get KEK status failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreateAPIKey calls the remote Persistence service to store a new API key
+func (c *PersistenceServiceGRPCClient) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	log.Printf("[gRPC Client] Calling remote CreateAPIKey for client: %s", req.ClientId)
+
+	resp, err := c.client.CreateAPIKey(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] CreateAPIKey failed: %v", err)
+		return nil, fmt.Errorf("gRPC create API key failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListAPIKeys calls the remote Persistence service to list API keys
+func (c *PersistenceServiceGRPCClient) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ListAPIKeys")
+
+	resp, err := c.client.ListAPIKeys(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ListAPIKeys failed: %v", err)
+		return nil, fmt.Errorf("gRPC list API keys failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RevokeAPIKey calls the remote Persistence service to revoke an API key
+func (c *PersistenceServiceGRPCClient) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RevokeAPIKey for key: %s", req.KeyId)
+
+	resp, err := c.client.RevokeAPIKey(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RevokeAPIKey failed: %v", err)
+		return nil, fmt.Errorf("gRPC revoke API key failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// VerifyAPIKey calls the remote Persistence service to verify an API key
+func (c *PersistenceServiceGRPCClient) VerifyAPIKey(ctx context.Context, req *pb.VerifyAPIKeyRequest) (*pb.VerifyAPIKeyResponse, error) {
+	resp, err := c.client.VerifyAPIKey(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("gRPC verify API key failed: %w", err)
+	}
+
+	return resp, nil
+}
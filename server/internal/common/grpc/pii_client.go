@@ -83,6 +83,71 @@ func (c *PIIServiceGRPCClient) Detokenize(ctx context.Context, req *pb.Detokeniz
 	return resp, nil
 }
 
+// TokenizeBatch calls the remote PII service to tokenize a batch of values
+func (c *PIIServiceGRPCClient) TokenizeBatch(ctx context.Context, req *pb.TokenizeBatchRequest) (*pb.TokenizeBatchResponse, error) {
+	log.Printf("[gRPC Client] Calling remote TokenizeBatch with %d items", len(req.Items))
+
+	resp, err := c.client.TokenizeBatch(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] TokenizeBatch failed: %v", err)
+		return nil, fmt.Errorf("gRPC tokenize batch failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DetokenizeBatch calls the remote PII service to detokenize a batch of tokens
+func (c *PIIServiceGRPCClient) DetokenizeBatch(ctx context.Context, req *pb.DetokenizeBatchRequest) (*pb.DetokenizeBatchResponse, error) {
+	log.Printf("[gRPC Client] Calling remote DetokenizeBatch with %d tokens", len(req.ReferenceHashes))
+
+	resp, err := c.client.DetokenizeBatch(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] DetokenizeBatch failed: %v", err)
+		return nil, fmt.Errorf("gRPC detokenize batch failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// TokenizeStream opens a tokenization stream to the remote PII service
+func (c *PIIServiceGRPCClient) TokenizeStream(ctx context.Context) (pb.PIIService_TokenizeStreamClient, error) {
+	log.Printf("[gRPC Client] Opening remote TokenizeStream")
+
+	stream, err := c.client.TokenizeStream(ctx)
+	if err != nil {
+		log.Printf("[gRPC Client] TokenizeStream failed: %v", err)
+		return nil, fmt.Errorf("gRPC tokenize stream failed: %w", err)
+	}
+
+	return stream, nil
+}
+
+// DetokenizeStream opens a detokenization stream to the remote PII service
+func (c *PIIServiceGRPCClient) DetokenizeStream(ctx context.Context) (pb.PIIService_DetokenizeStreamClient, error) {
+	log.Printf("[gRPC Client] Opening remote DetokenizeStream")
+
+	stream, err := c.client.DetokenizeStream(ctx)
+	if err != nil {
+		log.Printf("[gRPC Client] DetokenizeStream failed: %v", err)
+		return nil, fmt.Errorf("gRPC detokenize stream failed: %w", err)
+	}
+
+	return stream, nil
+}
+
+// LookupToken calls the remote PII service to find the tokens of a value
+func (c *PIIServiceGRPCClient) LookupToken(ctx context.Context, req *pb.LookupTokenRequest) (*pb.LookupTokenResponse, error) {
+	log.Printf("[gRPC Client] Calling remote LookupToken for data type: %s", req.DataType)
+
+	resp, err := c.client.LookupToken(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] LookupToken failed: %v", err)
+		return nil, fmt.Errorf("gRPC lookup token failed: %w", err)
+	}
+
+	return resp, nil
+}
+
 // HealthCheck calls the remote PII service health check
 func (c *PIIServiceGRPCClient) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
 	log.Printf("[gRPC Client] Calling remote HealthCheck")
@@ -96,3 +161,185 @@ func (c *PIIServiceGRPCClient) HealthCheck(ctx context.Context, req *pb.HealthCh
 
 	return resp, nil
 }
+
+// CreateOrganization calls the remote PII service to onboard an organization
+func (c *PIIServiceGRPCClient) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote CreateOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.CreateOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] CreateOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC create organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetOrganization calls the remote PII service to load an organization
+func (c *PIIServiceGRPCClient) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote GetOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.GetOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] GetOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC get organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListOrganizations calls the remote PII service to list organizations
+func (c *PIIServiceGRPCClient) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ListOrganizations")
+
+	resp, err := c.client.ListOrganizations(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ListOrganizations failed: %v", err)
+		return nil, fmt.Errorf("gRPC list organizations failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SuspendOrganization calls the remote PII service to suspend an organization
+func (c *PIIServiceGRPCClient) SuspendOrganization(ctx context.Context, req *pb.SuspendOrganizationRequest) (*pb.SuspendOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote SuspendOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.SuspendOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] SuspendOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC suspend organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ReactivateOrganization calls the remote PII service to reactivate an organization
+func (c *PIIServiceGRPCClient) ReactivateOrganization(ctx context.Context, req *pb.ReactivateOrganizationRequest) (*pb.ReactivateOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ReactivateOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.ReactivateOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ReactivateOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC reactivate organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UnlockOrganization calls the remote PII service to clear an organization's lockout
+func (c *PIIServiceGRPCClient) UnlockOrganization(ctx context.Context, req *pb.UnlockOrganizationRequest) (*pb.UnlockOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote UnlockOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.UnlockOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] UnlockOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC unlock organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetOrganizationCipherSuite calls the remote PII service to change an organization's cipher suite
+func (c *PIIServiceGRPCClient) SetOrganizationCipherSuite(ctx context.Context, req *pb.SetOrganizationCipherSuiteRequest) (*pb.SetOrganizationCipherSuiteResponse, error) {
+	log.Printf("[gRPC Client] Calling remote SetOrganizationCipherSuite for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.SetOrganizationCipherSuite(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] SetOrganizationCipherSuite failed: %v", err)
+		return nil, fmt.Errorf("gRPC set organization cipher suite failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetDeterministicDataTypes calls the remote PII service to change the data types an organization tokenizes deterministically
+func (c *PIIServiceGRPCClient) SetDeterministicDataTypes(ctx context.Context, req *pb.SetDeterministicDataTypesRequest) (*pb.SetDeterministicDataTypesResponse, error) {
+	log.Printf("[gRPC Client] Calling remote SetDeterministicDataTypes for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.SetDeterministicDataTypes(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] SetDeterministicDataTypes failed: %v", err)
+		return nil, fmt.Errorf("gRPC set deterministic data types failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ShredOrganization calls the remote PII service to crypto-shred an organization
+func (c *PIIServiceGRPCClient) ShredOrganization(ctx context.Context, req *pb.ShredOrganizationRequest) (*pb.ShredOrganizationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote ShredOrganization for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.ShredOrganization(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] ShredOrganization failed: %v", err)
+		return nil, fmt.Errorf("gRPC shred organization failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RotateTEK calls the remote PII service to rotate an organization's TEK
+func (c *PIIServiceGRPCClient) RotateTEK(ctx context.Context, req *pb.RotateTEKRequest) (*pb.RotateTEKResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RotateTEK for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.RotateTEK(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RotateTEK failed: %v", err)
+		return nil, fmt.Errorf("gRPC rotate TEK failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RotateOrganizationKey calls the remote PII service to rotate an organization's key
+func (c *PIIServiceGRPCClient) RotateOrganizationKey(ctx context.Context, req *pb.RotateOrganizationKeyRequest) (*pb.RotateOrganizationKeyResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RotateOrganizationKey for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.RotateOrganizationKey(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RotateOrganizationKey failed: %v", err)
+		return nil, fmt.Errorf("gRPC rotate organization key failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetOrganizationKeyRotation calls the remote PII service for an organization's key rotation progress
+func (c *PIIServiceGRPCClient) GetOrganizationKeyRotation(ctx context.Context, req *pb.GetOrganizationKeyRotationRequest) (*pb.GetOrganizationKeyRotationResponse, error) {
+	log.Printf("[gRPC Client] Calling remote GetOrganizationKeyRotation for organization: %s", req.OrganizationId)
+
+	resp, err := c.client.GetOrganizationKeyRotation(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] GetOrganizationKeyRotation failed: %v", err)
+		return nil, fmt.Errorf("gRPC get organization key rotation failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RewrapTEKs calls the remote PII service to rewrap stored TEKs under the current KEK
+func (c *PIIServiceGRPCClient) RewrapTEKs(ctx context.Context, req *pb.RewrapTEKsRequest) (*pb.RewrapTEKsResponse, error) {
+	log.Printf("[gRPC Client] Calling remote RewrapTEKs")
+
+	resp, err := c.client.RewrapTEKs(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] RewrapTEKs failed: %v", err)
+		return nil, fmt.Errorf("gRPC rewrap TEKs failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetKEKStatus calls the remote PII service for the KEK references of stored TEKs
+func (c *PIIServiceGRPCClient) GetKEKStatus(ctx context.Context, req *pb.GetKEKStatusRequest) (*pb.GetKEKStatusResponse, error) {
+	log.Printf("[gRPC Client] Calling remote GetKEKStatus")
+
+	resp, err := c.client.GetKEKStatus(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC Client] GetKEKStatus failed: %v", err)
+		return nil, fmt.Errorf("gRPC get KEK status failed: %w", err)
+	}
+
+	return resp, nil
+}
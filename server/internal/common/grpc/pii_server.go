@@ -34,8 +34,122 @@ func (s *PIIServiceServer) Detokenize(ctx context.Context, req *pb.DetokenizeReq
 	return s.service.Detokenize(ctx, req)
 }
 
+// TokenizeBatch handles the gRPC TokenizeBatch request
+func (s *PIIServiceServer) TokenizeBatch(ctx context.Context, req *pb.TokenizeBatchRequest) (*pb.TokenizeBatchResponse, error) {
+	log.Printf("[gRPC Server] Received TokenizeBatch request with %d items", len(req.Items))
+	return s.service.TokenizeBatch(ctx, req)
+}
+
+// DetokenizeBatch handles the gRPC DetokenizeBatch request
+func (s *PIIServiceServer) DetokenizeBatch(ctx context.Context, req *pb.DetokenizeBatchRequest) (*pb.DetokenizeBatchResponse, error) {
+	log.Printf("[gRPC Server] Received DetokenizeBatch request with %d tokens", len(req.ReferenceHashes))
+	return s.service.DetokenizeBatch(ctx, req)
+}
+
+// TokenizeStream handles the gRPC TokenizeStream stream
+func (s *PIIServiceServer) TokenizeStream(stream pb.PIIService_TokenizeStreamServer) error {
+	log.Printf("[gRPC Server] Received TokenizeStream stream")
+	return s.service.TokenizeStream(stream)
+}
+
+// DetokenizeStream handles the gRPC DetokenizeStream stream
+func (s *PIIServiceServer) DetokenizeStream(stream pb.PIIService_DetokenizeStreamServer) error {
+	log.Printf("[gRPC Server] Received DetokenizeStream stream")
+	return s.service.DetokenizeStream(stream)
+}
+
+// LookupToken handles the gRPC LookupToken request
+func (s *PIIServiceServer) LookupToken(ctx context.Context, req *pb.LookupTokenRequest) (*pb.LookupTokenResponse, error) {
+	log.Printf("[gRPC Server] Received LookupToken request for data type: %s", req.DataType)
+	return s.service.LookupToken(ctx, req)
+}
+
 // HealthCheck handles the gRPC HealthCheck request - now directly passes through
 func (s *PIIServiceServer) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
 	log.Printf("[gRPC Server] Received HealthCheck request")
 	return s.service.HealthCheck(ctx, req)
 }
+
+// CreateOrganization handles the gRPC CreateOrganization request
+func (s *PIIServiceServer) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	log.Printf("[gRPC Server] Received CreateOrganization request for organization: %s", req.OrganizationId)
+	return s.service.CreateOrganization(ctx, req)
+}
+
+// GetOrganization handles the gRPC GetOrganization request
+func (s *PIIServiceServer) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	log.Printf("[gRPC Server] Received GetOrganization request for organization: %s", req.OrganizationId)
+	return s.service.GetOrganization(ctx, req)
+}
+
+// ListOrganizations handles the gRPC ListOrganizations request
+func (s *PIIServiceServer) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	log.Printf("[gRPC Server] Received ListOrganizations request")
+	return s.service.ListOrganizations(ctx, req)
+}
+
+// SuspendOrganization handles the gRPC SuspendOrganization request
+func (s *PIIServiceServer) SuspendOrganization(ctx context.Context, req *pb.SuspendOrganizationRequest) (*pb.SuspendOrganizationResponse, error) {
+	log.Printf("[gRPC Server] Received SuspendOrganization request for organization: %s", req.OrganizationId)
+	return s.service.SuspendOrganization(ctx, req)
+}
+
+// ReactivateOrganization handles the gRPC ReactivateOrganization request
+func (s *PIIServiceServer) ReactivateOrganization(ctx context.Context, req *pb.ReactivateOrganizationRequest) (*pb.ReactivateOrganizationResponse, error) {
+	log.Printf("[gRPC Server] Received ReactivateOrganization request for organization: %s", req.OrganizationId)
+	return s.service.ReactivateOrganization(ctx, req)
+}
+
+// UnlockOrganization handles the gRPC UnlockOrganization request
+func (s *PIIServiceServer) UnlockOrganization(ctx context.Context, req *pb.UnlockOrganizationRequest) (*pb.UnlockOrganizationResponse, error) {
+	log.Printf("[gRPC Server] Received UnlockOrganization request for organization: %s", req.OrganizationId)
+	return s.service.UnlockOrganization(ctx, req)
+}
+
+// SetOrganizationCipherSuite handles the gRPC SetOrganizationCipherSuite request
+func (s *PIIServiceServer) SetOrganizationCipherSuite(ctx context.Context, req *pb.SetOrganizationCipherSuiteRequest) (*pb.SetOrganizationCipherSuiteResponse, error) {
+	log.Printf("[gRPC Server] Received SetOrganizationCipherSuite request for organization: %s", req.OrganizationId)
+	return s.service.SetOrganizationCipherSuite(ctx, req)
+}
+
+// SetDeterministicDataTypes handles the gRPC SetDeterministicDataTypes request
+func (s *PIIServiceServer) SetDeterministicDataTypes(ctx context.Context, req *pb.SetDeterministicDataTypesRequest) (*pb.SetDeterministicDataTypesResponse, error) {
+	log.Printf("[gRPC Server] Received SetDeterministicDataTypes request for organization: %s", req.OrganizationId)
+	return s.service.SetDeterministicDataTypes(ctx, req)
+}
+
+// ShredOrganization handles the gRPC ShredOrganization request
+func (s *PIIServiceServer) ShredOrganization(ctx context.Context, req *pb.ShredOrganizationRequest) (*pb.ShredOrganizationResponse, error) {
+	log.Printf("[gRPC Server] Received ShredOrganization request for organization: %s", req.OrganizationId)
+	return s.service.ShredOrganization(ctx, req)
+}
+
+// RotateTEK handles the gRPC RotateTEK request
+func (s *PIIServiceServer) RotateTEK(ctx context.Context, req *pb.RotateTEKRequest) (*pb.RotateTEKResponse, error) {
+	log.Printf("[gRPC Server] Received RotateTEK request for organization: %s", req.OrganizationId)
+	return s.service.RotateTEK(ctx, req)
+}
+
+// RotateOrganizationKey handles the gRPC RotateOrganizationKey request
+func (s *PIIServiceServer) RotateOrganizationKey(ctx context.Context, req *pb.RotateOrganizationKeyRequest) (*pb.RotateOrganizationKeyResponse, error) {
+	log.Printf("[gRPC Server] Received RotateOrganizationKey request for organization: %s", req.OrganizationId)
+	return s.service.RotateOrganizationKey(ctx, req)
+}
+
+// GetOrganizationKeyRotation handles the gRPC GetOrganizationKeyRotation request
+func (s *PIIServiceServer) GetOrganizationKeyRotation(ctx context.Context, req *pb.GetOrganizationKeyRotationRequest) (*pb.GetOrganizationKeyRotationResponse, error) {
+	log.Printf("[gRPC Server] Received GetOrganizationKeyRotation request for organization: %s", req.OrganizationId)
+	return s.service.GetOrganizationKeyRotation(ctx, req)
+}
+
+// RewrapTEKs handles the gRPC RewrapTEKs request
+func (s *PIIServiceServer) RewrapTEKs(ctx context.Context, req *pb.RewrapTEKsRequest) (*pb.RewrapTEKsResponse, error) {
+	log.Printf("[gRPC Server] Received RewrapTEKs request")
+	return s.service.RewrapTEKs(ctx, req)
+}
+
+// GetKEKStatus handles the gRPC GetKEKStatus request
+func (s *PIIServiceServer) GetKEKStatus(ctx context.Context, req *pb.GetKEKStatusRequest) (*pb.GetKEKStatusResponse, error) {
+	log.Printf("[gRPC Server] Received GetKEKStatus request")
+	return s.service.GetKEKStatus(ctx, req)
+}
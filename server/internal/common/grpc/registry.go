@@ -8,6 +8,7 @@ import (
 	grpclib "google.golang.org/grpc"
 
 	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
 	"github.com/PlainFunction/mistokenly/internal/common/types"
 	"github.com/PlainFunction/mistokenly/internal/services"
 )
@@ -43,7 +44,19 @@ func (sr *ServiceRegistry) GetPIIServiceClient() (PIIServiceInterface, error) {
 
 	// Create local PII service (monolithic mode for development)
 	fmt.Printf("🏠 [Registry] Using LOCAL PII Service\n")
-	return services.NewPIIService(sr.config)
+	piiService, err := services.NewPIIService(sr.config)
+	if err != nil {
+		return nil, err
+	}
+	if sr.config.KEKProvider == kek.ProviderRemote {
+		kmsAddr := fmt.Sprintf("%s:%s", sr.config.KMSServiceHost, sr.config.KMSServicePort)
+		kmsClient, err := NewKMSServiceGRPCClient(kmsAddr, "pii-service")
+		if err != nil {
+			return nil, err
+		}
+		piiService.SetKEKProvider(kmsClient)
+	}
+	return piiService, nil
 }
 
 // GetPersistenceServiceClient returns a client connection to the Persistence service
@@ -89,6 +102,8 @@ func (sr *ServiceRegistry) GetServiceEndpoint(serviceName string) string {
 		return fmt.Sprintf("pii-audit-service:%s", sr.config.GetGRPCPort("audit"))
 	case "persistence":
 		return fmt.Sprintf("pii-persistence-service:%s", sr.config.GetGRPCPort("persistence"))
+	case "kms":
+		return fmt.Sprintf("pii-kms-service:%s", sr.config.GetGRPCPort("kms"))
 	default:
 		return fmt.Sprintf("localhost:%s", sr.config.GetGRPCPort(serviceName))
 	}
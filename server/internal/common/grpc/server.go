@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
 )
 
 // Server wraps the gRPC server with common functionality
@@ -30,8 +31,8 @@ func NewServer(cfg *config.Config, serviceName string) (*Server, error) {
 
 	// Create gRPC server with interceptors
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(loggingInterceptor),
-		grpc.StreamInterceptor(streamLoggingInterceptor),
+		grpc.ChainUnaryInterceptor(loggingInterceptor, OrgKeyInterceptor),
+		grpc.ChainStreamInterceptor(streamLoggingInterceptor, StreamOrgKeyInterceptor),
 	)
 
 	// Enable reflection for development
@@ -91,3 +92,38 @@ func streamLoggingInterceptor(
 	}
 	return err
 }
+
+// OrgKeyInterceptor fills organization keys sent as gRPC metadata into the request,
+// so callers can keep them out of request messages
+func OrgKeyInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	orgkey.Fill(ctx, req)
+	return handler(ctx, req)
+}
+
+// StreamOrgKeyInterceptor fills a stream's organization key metadata into every message
+func StreamOrgKeyInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &orgKeyServerStream{ServerStream: ss})
+}
+
+// orgKeyServerStream fills the stream's organization key metadata into every message
+type orgKeyServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *orgKeyServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	orgkey.Fill(s.Context(), m)
+	return nil
+}
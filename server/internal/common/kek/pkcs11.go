@@ -0,0 +1,305 @@
+//go:build pkcs11
+
+package kek
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	"github.com/miekg/pkcs11"
+)
+
+// ckmAESKeyWrapKWP is CKM_AES_KEY_WRAP_KWP (RFC 5649), which not every binding names
+const ckmAESKeyWrapKWP = 0x0000210B
+
+// Mechanism tags stored as the first byte of a wrapped TEK body
+const (
+	pkcs11TagGCM byte = 1
+	pkcs11TagKWP byte = 2
+)
+
+// gcmTagBits is the AES-GCM authentication tag length used inside the token
+const gcmTagBits = 128
+
+// PKCS11Provider implements types.KEKProvider with AES keys held in a PKCS#11 token
+// such as an HSM. TEKs are wrapped and unwrapped inside the token, so the KEK never
+// leaves it. Each key is identified by its CKA_LABEL, which is also its KEK ID.
+//
+// PKCS#11 sessions are not safe for concurrent use, so all operations share one
+// session under a mutex.
+type PKCS11Provider struct {
+	config    PKCS11Config
+	mechanism byte
+	legacy    *types.StaticKEKProvider // Optional; unwraps TEKs wrapped before the move to the HSM
+
+	mu      sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	keys    map[string]pkcs11.ObjectHandle
+}
+
+// NewPKCS11Provider loads the module named in the configuration file, logs in to the
+// token and looks up every configured key
+func NewPKCS11Provider(configPath string, legacy *types.StaticKEKProvider) (*PKCS11Provider, error) {
+	cfg, pin, err := loadPKCS11Config(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PKCS11Provider{
+		config: cfg,
+		legacy: legacy,
+		keys:   make(map[string]pkcs11.ObjectHandle),
+	}
+	switch cfg.Mechanism {
+	case PKCS11MechanismGCM:
+		p.mechanism = pkcs11TagGCM
+	case PKCS11MechanismKWP:
+		p.mechanism = pkcs11TagKWP
+	default:
+		return nil, fmt.Errorf("unknown PKCS#11 mechanism %q (expected %q or %q)", cfg.Mechanism, PKCS11MechanismGCM, PKCS11MechanismKWP)
+	}
+
+	p.ctx = pkcs11.New(cfg.Module)
+	if p.ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", cfg.Module)
+	}
+	if err := p.ctx.Initialize(); err != nil {
+		p.ctx.Destroy()
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+
+	if err := p.open(pin); err != nil {
+		p.ctx.Finalize()
+		p.ctx.Destroy()
+		return nil, err
+	}
+
+	log.Printf("✅ [KEKProvider] PKCS#11 key %s loaded from %s (%s, retired: %d)", cfg.KeyLabel, cfg.Module, cfg.Mechanism, len(cfg.RetiredKeyLabels))
+	if legacy != nil {
+		log.Printf("ℹ️ [KEKProvider] Static KEK ring kept for unwrapping TEKs wrapped before the HSM")
+	}
+
+	return p, nil
+}
+
+// open starts a session on the configured slot, logs in and finds the keys
+func (p *PKCS11Provider) open(pin string) error {
+	slot, err := p.findSlot()
+	if err != nil {
+		return err
+	}
+
+	p.session, err = p.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+
+	if err := p.ctx.Login(p.session, pkcs11.CKU_USER, pin); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		p.ctx.CloseSession(p.session)
+		return fmt.Errorf("failed to log in to PKCS#11 token: %w", err)
+	}
+
+	for _, label := range p.keyLabels() {
+		handle, err := p.findKey(label)
+		if err != nil {
+			p.ctx.Logout(p.session)
+			p.ctx.CloseSession(p.session)
+			return err
+		}
+		p.keys[label] = handle
+	}
+
+	return nil
+}
+
+// findSlot returns the configured slot, or the slot whose token carries the configured label
+func (p *PKCS11Provider) findSlot() (uint, error) {
+	if p.config.Slot != nil {
+		return *p.config.Slot, nil
+	}
+
+	slots, err := p.ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := p.ctx.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+		if trimPKCS11Label(info.Label) == p.config.TokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("no PKCS#11 token labelled %q", p.config.TokenLabel)
+}
+
+// findKey looks up the AES secret key with the given label
+func (p *PKCS11Provider) findKey(label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := p.ctx.FindObjectsInit(p.session, template); err != nil {
+		return 0, fmt.Errorf("failed to search PKCS#11 key %q: %w", label, err)
+	}
+	handles, _, err := p.ctx.FindObjects(p.session, 2)
+	finalErr := p.ctx.FindObjectsFinal(p.session)
+	if err != nil {
+		return 0, fmt.Errorf("failed to search PKCS#11 key %q: %w", label, err)
+	}
+	if finalErr != nil {
+		return 0, fmt.Errorf("failed to search PKCS#11 key %q: %w", label, finalErr)
+	}
+
+	switch len(handles) {
+	case 0:
+		return 0, fmt.Errorf("PKCS#11 key %q not found", label)
+	case 1:
+		return handles[0], nil
+	default:
+		return 0, fmt.Errorf("PKCS#11 key label %q is not unique", label)
+	}
+}
+
+// WrapTEK encrypts a TEK inside the token with the current key
+func (p *PKCS11Provider) WrapTEK(tek []byte) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := p.keys[p.config.KeyLabel]
+	body := []byte{p.mechanism}
+
+	switch p.mechanism {
+	case pkcs11TagGCM:
+		iv := make([]byte, envelope.NonceSize)
+		if _, err := rand.Read(iv); err != nil {
+			return nil, fmt.Errorf("failed to generate IV: %w", err)
+		}
+		params := pkcs11.NewGCMParams(iv, nil, gcmTagBits)
+		defer params.Free()
+
+		ciphertext, err := p.encrypt(pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params), key, tek)
+		if err != nil {
+			return nil, err
+		}
+		body = append(append(body, iv...), ciphertext...)
+
+	case pkcs11TagKWP:
+		ciphertext, err := p.encrypt(pkcs11.NewMechanism(ckmAESKeyWrapKWP, nil), key, tek)
+		if err != nil {
+			return nil, err
+		}
+		body = append(body, ciphertext...)
+	}
+
+	return envelope.AddKEKHeader(p.config.KeyLabel, body)
+}
+
+// UnwrapTEK decrypts a TEK inside the token with the key named in its header, or with
+// the static KEK ring for TEKs wrapped before the move to the HSM
+func (p *PKCS11Provider) UnwrapTEK(encryptedTEK []byte) ([]byte, error) {
+	label, body, _ := envelope.SplitKEKHeader(encryptedTEK)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, ok := p.keys[label]
+	if !ok {
+		if p.legacy == nil {
+			return nil, fmt.Errorf("TEK was not wrapped by a PKCS#11 key and no static KEK is configured")
+		}
+		return p.legacy.UnwrapTEK(encryptedTEK)
+	}
+	if len(body) < 2 {
+		return nil, fmt.Errorf("wrapped TEK too short")
+	}
+
+	switch body[0] {
+	case pkcs11TagGCM:
+		if len(body) < 1+envelope.NonceSize {
+			return nil, fmt.Errorf("wrapped TEK too short")
+		}
+		params := pkcs11.NewGCMParams(body[1:1+envelope.NonceSize], nil, gcmTagBits)
+		defer params.Free()
+		return p.decrypt(pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params), key, body[1+envelope.NonceSize:])
+
+	case pkcs11TagKWP:
+		return p.decrypt(pkcs11.NewMechanism(ckmAESKeyWrapKWP, nil), key, body[1:])
+
+	default:
+		return nil, fmt.Errorf("unknown PKCS#11 wrapping mechanism %d", body[0])
+	}
+}
+
+func (p *PKCS11Provider) encrypt(mechanism *pkcs11.Mechanism, key pkcs11.ObjectHandle, plaintext []byte) ([]byte, error) {
+	if err := p.ctx.EncryptInit(p.session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+		return nil, fmt.Errorf("failed to wrap TEK in PKCS#11 token: %w", err)
+	}
+	ciphertext, err := p.ctx.Encrypt(p.session, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap TEK in PKCS#11 token: %w", err)
+	}
+	return ciphertext, nil
+}
+
+func (p *PKCS11Provider) decrypt(mechanism *pkcs11.Mechanism, key pkcs11.ObjectHandle, ciphertext []byte) ([]byte, error) {
+	if err := p.ctx.DecryptInit(p.session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+		return nil, fmt.Errorf("failed to unwrap TEK in PKCS#11 token: %w", err)
+	}
+	plaintext, err := p.ctx.Decrypt(p.session, ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap TEK in PKCS#11 token: %w", err)
+	}
+	return plaintext, nil
+}
+
+// CurrentKEKID returns the label of the key that wraps new TEKs
+func (p *PKCS11Provider) CurrentKEKID() string {
+	return p.config.KeyLabel
+}
+
+// WrappedKEKID returns the label of the key, or the ID of the static KEK, that wrapped a TEK
+func (p *PKCS11Provider) WrappedKEKID(encryptedTEK []byte) string {
+	return envelope.WrappedKEKID(encryptedTEK)
+}
+
+// KEKIDs returns the labels of the current and retired keys, followed by the static
+// KEKs kept for TEKs wrapped before the HSM
+func (p *PKCS11Provider) KEKIDs() []string {
+	ids := p.keyLabels()
+	if p.legacy != nil {
+		ids = append(ids, p.legacy.KEKIDs()...)
+	}
+	return ids
+}
+
+// keyLabels returns the labels of the keys held in the token, current first
+func (p *PKCS11Provider) keyLabels() []string {
+	return append([]string{p.config.KeyLabel}, p.config.RetiredKeyLabels...)
+}
+
+// Close logs out of the token and unloads the module
+func (p *PKCS11Provider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ctx.Logout(p.session)
+	p.ctx.CloseSession(p.session)
+	err := p.ctx.Finalize()
+	p.ctx.Destroy()
+	return err
+}
+
+// trimPKCS11Label strips the space padding of fixed-width PKCS#11 labels
+func trimPKCS11Label(label string) string {
+	return strings.TrimRight(label, " \x00")
+}
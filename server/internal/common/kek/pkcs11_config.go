@@ -0,0 +1,59 @@
+package kek
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Wrapping mechanisms accepted in the PKCS#11 configuration file
+const (
+	PKCS11MechanismGCM = "aes-gcm"
+	PKCS11MechanismKWP = "aes-kwp"
+)
+
+// PKCS11Config is the JSON file named by PKCS11_CONFIG. The PIN is read from a
+// separate file so it can be mounted from a secret of its own.
+type PKCS11Config struct {
+	Module           string   `json:"module"`             // Path to the PKCS#11 shared library
+	Slot             *uint    `json:"slot"`               // Slot ID; takes precedence over token_label
+	TokenLabel       string   `json:"token_label"`        // Label of the token to use when no slot is set
+	PinFile          string   `json:"pin_file"`           // File holding the user PIN
+	KeyLabel         string   `json:"key_label"`          // AES key that wraps new TEKs
+	RetiredKeyLabels []string `json:"retired_key_labels"` // AES keys still needed for unwrapping
+	Mechanism        string   `json:"mechanism"`          // "aes-gcm" (default) or "aes-kwp"
+}
+
+// loadPKCS11Config reads the configuration file and the PIN it points to
+func loadPKCS11Config(path string) (PKCS11Config, string, error) {
+	var cfg PKCS11Config
+	if path == "" {
+		return cfg, "", fmt.Errorf("PKCS11_CONFIG is required")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, "", fmt.Errorf("failed to read PKCS#11 config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, "", fmt.Errorf("failed to parse PKCS#11 config: %w", err)
+	}
+
+	if cfg.Module == "" || cfg.PinFile == "" || cfg.KeyLabel == "" {
+		return cfg, "", fmt.Errorf("PKCS#11 config requires module, pin_file and key_label")
+	}
+	if cfg.Slot == nil && cfg.TokenLabel == "" {
+		return cfg, "", fmt.Errorf("PKCS#11 config requires slot or token_label")
+	}
+	if cfg.Mechanism == "" {
+		cfg.Mechanism = PKCS11MechanismGCM
+	}
+
+	pin, err := os.ReadFile(cfg.PinFile)
+	if err != nil {
+		return cfg, "", fmt.Errorf("failed to read PKCS#11 PIN: %w", err)
+	}
+
+	return cfg, strings.TrimSpace(string(pin)), nil
+}
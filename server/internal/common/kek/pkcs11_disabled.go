@@ -0,0 +1,15 @@
+//go:build !pkcs11
+
+package kek
+
+import (
+	"fmt"
+
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+// NewPKCS11Provider is unavailable in builds without the pkcs11 tag, which keeps cgo
+// and the PKCS#11 binding out of the default build
+func NewPKCS11Provider(configPath string, legacy *types.StaticKEKProvider) (types.KEKProvider, error) {
+	return nil, fmt.Errorf("built without PKCS#11 support; rebuild with -tags pkcs11")
+}
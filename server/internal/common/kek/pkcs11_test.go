@@ -0,0 +1,344 @@
+//go:build pkcs11
+
+package kek
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	"github.com/miekg/pkcs11"
+)
+
+// These tests run against SoftHSMv2:
+//
+//	SOFTHSM2_CONF=/path/to/softhsm2.conf go test -tags pkcs11 ./internal/common/kek/
+//
+// Each test initializes a token of its own in a free slot of the configured token
+// directory. SOFTHSM2_MODULE overrides the path of libsofthsm2.so.
+
+const (
+	softHSMUserPIN = "1234"
+	softHSMSOPIN   = "5678"
+)
+
+// softHSMModule returns the path of the SoftHSMv2 library, skipping the test when
+// SoftHSMv2 is not configured
+func softHSMModule(t *testing.T) string {
+	t.Helper()
+
+	if os.Getenv("SOFTHSM2_CONF") == "" {
+		t.Skip("SOFTHSM2_CONF is not set")
+	}
+	if module := os.Getenv("SOFTHSM2_MODULE"); module != "" {
+		return module
+	}
+	for _, module := range []string{
+		"/usr/lib/softhsm/libsofthsm2.so",
+		"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+		"/usr/lib/aarch64-linux-gnu/softhsm/libsofthsm2.so",
+		"/usr/local/lib/softhsm/libsofthsm2.so",
+		"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+	} {
+		if _, err := os.Stat(module); err == nil {
+			return module
+		}
+	}
+	t.Fatal("libsofthsm2.so not found; set SOFTHSM2_MODULE")
+	return ""
+}
+
+// newSoftHSMToken initializes a token in a free slot and generates an AES-256 key
+// for each label. It returns the token label.
+func newSoftHSMToken(t *testing.T, module string, keyLabels ...string) string {
+	t.Helper()
+
+	ctx := pkcs11.New(module)
+	if ctx == nil {
+		t.Fatalf("failed to load PKCS#11 module %s", module)
+	}
+	defer ctx.Destroy()
+	if err := ctx.Initialize(); err != nil {
+		t.Fatalf("Initialize: %v", err)
+	}
+	// The provider initializes the module itself
+	defer ctx.Finalize()
+
+	slots, err := ctx.GetSlotList(false)
+	if err != nil {
+		t.Fatalf("GetSlotList: %v", err)
+	}
+	var free *uint
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err == nil && info.Flags&pkcs11.CKF_TOKEN_INITIALIZED == 0 {
+			free = &slot
+			break
+		}
+	}
+	if free == nil {
+		t.Fatal("SoftHSMv2 has no free slot")
+	}
+
+	tokenLabel := fmt.Sprintf("mtk-test-%d", time.Now().UnixNano()%1e12)
+	if err := ctx.InitToken(*free, softHSMSOPIN, tokenLabel); err != nil {
+		t.Fatalf("InitToken: %v", err)
+	}
+
+	// SoftHSMv2 moves an initialized token to a new slot
+	slots, err = ctx.GetSlotList(true)
+	if err != nil {
+		t.Fatalf("GetSlotList: %v", err)
+	}
+	slot, found := uint(0), false
+	for _, s := range slots {
+		if info, err := ctx.GetTokenInfo(s); err == nil && trimPKCS11Label(info.Label) == tokenLabel {
+			slot, found = s, true
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("token %s not found after initialization", tokenLabel)
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		t.Fatalf("OpenSession: %v", err)
+	}
+	defer ctx.CloseSession(session)
+
+	if err := ctx.Login(session, pkcs11.CKU_SO, softHSMSOPIN); err != nil {
+		t.Fatalf("SO login: %v", err)
+	}
+	if err := ctx.InitPIN(session, softHSMUserPIN); err != nil {
+		t.Fatalf("InitPIN: %v", err)
+	}
+	ctx.Logout(session)
+	if err := ctx.Login(session, pkcs11.CKU_USER, softHSMUserPIN); err != nil {
+		t.Fatalf("user login: %v", err)
+	}
+	defer ctx.Logout(session)
+
+	for _, label := range keyLabels {
+		_, err := ctx.GenerateKey(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)}, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, 32),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+			pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+		})
+		if err != nil {
+			t.Fatalf("failed to generate key %s: %v", label, err)
+		}
+	}
+
+	return tokenLabel
+}
+
+// newSoftHSMProvider writes a PKCS#11 configuration file for the token and opens a
+// provider with it. The provider is closed when the test ends unless closed earlier.
+func newSoftHSMProvider(t *testing.T, cfg PKCS11Config, legacy *types.StaticKEKProvider) *PKCS11Provider {
+	t.Helper()
+
+	dir := t.TempDir()
+	cfg.PinFile = filepath.Join(dir, "pin")
+	if err := os.WriteFile(cfg.PinFile, []byte(softHSMUserPIN+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "pkcs11.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPKCS11Provider(path, legacy)
+	if err != nil {
+		t.Fatalf("NewPKCS11Provider: %v", err)
+	}
+	return p
+}
+
+func TestPKCS11WrapUnwrap(t *testing.T) {
+	module := softHSMModule(t)
+	token := newSoftHSMToken(t, module, "kek-1")
+	p := newSoftHSMProvider(t, PKCS11Config{Module: module, TokenLabel: token, KeyLabel: "kek-1"}, nil)
+	defer p.Close()
+
+	tek := randomTEK(t)
+	wrapped, err := p.WrapTEK(tek)
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+	if bytes.Contains(wrapped, tek) {
+		t.Fatal("wrapped TEK contains the plaintext TEK")
+	}
+	if got := p.WrappedKEKID(wrapped); got != "kek-1" {
+		t.Errorf("WrappedKEKID = %q, want %q", got, "kek-1")
+	}
+	if got := p.CurrentKEKID(); got != "kek-1" {
+		t.Errorf("CurrentKEKID = %q, want %q", got, "kek-1")
+	}
+
+	unwrapped, err := p.UnwrapTEK(wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapTEK: %v", err)
+	}
+	if !bytes.Equal(unwrapped, tek) {
+		t.Fatal("UnwrapTEK returned a different TEK")
+	}
+
+	// A fresh IV makes every wrapping of the same TEK differ
+	again, err := p.WrapTEK(tek)
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+	if bytes.Equal(again, wrapped) {
+		t.Error("wrapping the same TEK twice gave the same ciphertext")
+	}
+
+	tampered := bytes.Clone(wrapped)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := p.UnwrapTEK(tampered); err == nil {
+		t.Error("UnwrapTEK accepted a tampered ciphertext")
+	}
+}
+
+func TestPKCS11RetiredKey(t *testing.T) {
+	module := softHSMModule(t)
+	token := newSoftHSMToken(t, module, "kek-1", "kek-2")
+
+	before := newSoftHSMProvider(t, PKCS11Config{Module: module, TokenLabel: token, KeyLabel: "kek-1"}, nil)
+	tek1 := randomTEK(t)
+	wrapped1, err := before.WrapTEK(tek1)
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+	if err := before.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Rotate to kek-2 and keep kek-1 for unwrapping
+	p := newSoftHSMProvider(t, PKCS11Config{Module: module, TokenLabel: token, KeyLabel: "kek-2", RetiredKeyLabels: []string{"kek-1"}}, nil)
+	defer p.Close()
+
+	if got, want := p.KEKIDs(), []string{"kek-2", "kek-1"}; !slices.Equal(got, want) {
+		t.Errorf("KEKIDs = %q, want %q", got, want)
+	}
+
+	unwrapped, err := p.UnwrapTEK(wrapped1)
+	if err != nil {
+		t.Fatalf("UnwrapTEK with a retired key: %v", err)
+	}
+	if !bytes.Equal(unwrapped, tek1) {
+		t.Fatal("UnwrapTEK with a retired key returned a different TEK")
+	}
+
+	tek2 := randomTEK(t)
+	wrapped2, err := p.WrapTEK(tek2)
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+	if got := p.WrappedKEKID(wrapped2); got != "kek-2" {
+		t.Errorf("WrappedKEKID of a new TEK = %q, want %q", got, "kek-2")
+	}
+
+	// A TEK whose header names a key the token does not hold is refused
+	_, body, _ := envelope.SplitKEKHeader(wrapped2)
+	renamed, err := envelope.AddKEKHeader("kek-unknown", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.UnwrapTEK(renamed); err == nil {
+		t.Error("UnwrapTEK accepted a TEK wrapped with an unknown key")
+	}
+}
+
+func TestPKCS11MissingKey(t *testing.T) {
+	module := softHSMModule(t)
+	token := newSoftHSMToken(t, module, "kek-1")
+
+	dir := t.TempDir()
+	pinFile := filepath.Join(dir, "pin")
+	if err := os.WriteFile(pinFile, []byte(softHSMUserPIN), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(PKCS11Config{Module: module, TokenLabel: token, PinFile: pinFile, KeyLabel: "kek-1", RetiredKeyLabels: []string{"kek-0"}})
+	path := filepath.Join(dir, "pkcs11.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if p, err := NewPKCS11Provider(path, nil); err == nil {
+		p.Close()
+		t.Fatal("NewPKCS11Provider accepted a retired key that is not in the token")
+	}
+}
+
+func TestPKCS11LegacyStaticKEK(t *testing.T) {
+	module := softHSMModule(t)
+	token := newSoftHSMToken(t, module, "kek-1")
+
+	legacy, err := types.NewStaticKEKProvider("legacy-kek", base64.StdEncoding.EncodeToString(randomTEK(t)), "")
+	if err != nil {
+		t.Fatalf("NewStaticKEKProvider: %v", err)
+	}
+	p := newSoftHSMProvider(t, PKCS11Config{Module: module, TokenLabel: token, KeyLabel: "kek-1"}, legacy)
+	defer p.Close()
+
+	tek := randomTEK(t)
+	wrapped, err := legacy.WrapTEK(tek)
+	if err != nil {
+		t.Fatalf("WrapTEK with the static KEK: %v", err)
+	}
+	unwrapped, err := p.UnwrapTEK(wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapTEK of a statically wrapped TEK: %v", err)
+	}
+	if !bytes.Equal(unwrapped, tek) {
+		t.Fatal("UnwrapTEK returned a different TEK")
+	}
+	if ids := p.KEKIDs(); !slices.Contains(ids, "legacy-kek") {
+		t.Errorf("KEKIDs = %q, want the static KEK included", ids)
+	}
+}
+
+func TestPKCS11KWP(t *testing.T) {
+	module := softHSMModule(t)
+	token := newSoftHSMToken(t, module, "kek-1")
+	p := newSoftHSMProvider(t, PKCS11Config{Module: module, TokenLabel: token, KeyLabel: "kek-1", Mechanism: PKCS11MechanismKWP}, nil)
+	defer p.Close()
+
+	tek := randomTEK(t)
+	wrapped, err := p.WrapTEK(tek)
+	if errors.Is(err, pkcs11.Error(pkcs11.CKR_MECHANISM_INVALID)) {
+		t.Skip("the token does not support CKM_AES_KEY_WRAP_KWP with C_Encrypt")
+	}
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+
+	unwrapped, err := p.UnwrapTEK(wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapTEK: %v", err)
+	}
+	if !bytes.Equal(unwrapped, tek) {
+		t.Fatal("UnwrapTEK returned a different TEK")
+	}
+}
@@ -0,0 +1,92 @@
+// Package kek selects and implements the KEK providers that wrap and unwrap TEKs.
+package kek
+
+import (
+	"fmt"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+// Provider names accepted in KEK_PROVIDER
+const (
+	ProviderStatic = "static"
+	ProviderVault  = "vault"
+	ProviderPKCS11 = "pkcs11"
+
+	// ProviderRemote delegates wrapping to the key service. The gRPC client that
+	// implements it is injected by the caller, so NewProvider does not create it.
+	ProviderRemote = "remote"
+)
+
+// NewProvider creates the KEK provider selected by cfg.KEKProvider
+func NewProvider(cfg *config.Config) (types.KEKProvider, error) {
+	switch cfg.KEKProvider {
+	case "", ProviderStatic:
+		provider, err := types.NewStaticKEKProvider(cfg.KEKID, cfg.KEKBase64, cfg.KEKRing)
+		if err != nil {
+			return nil, err
+		}
+		return provider, nil
+
+	case ProviderVault:
+		legacy, err := legacyStaticProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		provider, err := NewVaultTransitProvider(VaultConfig{
+			Addr:         cfg.VaultAddr,
+			Namespace:    cfg.VaultNamespace,
+			Token:        cfg.VaultToken,
+			RoleID:       cfg.VaultRoleID,
+			SecretID:     cfg.VaultSecretID,
+			AppRoleMount: cfg.VaultAppRoleMount,
+			TransitMount: cfg.VaultTransitMount,
+			Key:          cfg.VaultTransitKey,
+			CACert:       cfg.VaultCACert,
+		}, legacy)
+		if err != nil {
+			return nil, err
+		}
+		return provider, nil
+
+	case ProviderPKCS11:
+		legacy, err := legacyStaticProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		provider, err := NewPKCS11Provider(cfg.PKCS11Config, legacy)
+		if err != nil {
+			return nil, err
+		}
+		return provider, nil
+
+	case ProviderRemote:
+		return nil, fmt.Errorf("KEK_PROVIDER %q is provided by the key service client, not created locally", ProviderRemote)
+
+	default:
+		return nil, fmt.Errorf("unknown KEK_PROVIDER %q (expected %q, %q, %q or %q)", cfg.KEKProvider, ProviderStatic, ProviderVault, ProviderPKCS11, ProviderRemote)
+	}
+}
+
+// legacyStaticProvider returns the static KEK ring kept next to an external provider,
+// if KEK_BASE64 is set. It only unwraps TEKs wrapped before the move.
+func legacyStaticProvider(cfg *config.Config) (*types.StaticKEKProvider, error) {
+	if cfg.KEKBase64 == "" {
+		return nil, nil
+	}
+	return types.NewStaticKEKProvider(cfg.KEKID, cfg.KEKBase64, cfg.KEKRing)
+}
+
+// Configured reports whether cfg selects a usable KEK provider. The static provider
+// needs KEK_BASE64; every other provider is configured through its own settings.
+func Configured(cfg *config.Config) bool {
+	switch cfg.KEKProvider {
+	case "", ProviderStatic:
+		return cfg.KEKBase64 != ""
+	default:
+		return true
+	}
+}
@@ -0,0 +1,225 @@
+package kek
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/shamir"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+// AdminKeyMetadata is the gRPC metadata key carrying the admin key that submitting
+// or resetting unseal shares requires
+const AdminKeyMetadata = "x-admin-key"
+
+// ErrSealed is returned for wrap and unwrap requests until the KEK has been unsealed
+var ErrSealed = errors.New("KEK is sealed")
+
+// fingerprintLabel is the message MACed with the KEK to produce its fingerprint
+const fingerprintLabel = "mistokenly KEK fingerprint"
+
+// Fingerprint identifies a KEK without revealing it. It is published by the key
+// ceremony and checked when the KEK is reconstructed from shares.
+func Fingerprint(kek []byte) string {
+	mac := hmac.New(sha256.New, kek)
+	mac.Write([]byte(fingerprintLabel))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// SealStatus reports the progress of unsealing
+type SealStatus struct {
+	Sealed    bool
+	Threshold int
+	Progress  int // Shares submitted so far
+}
+
+// SealedProvider is a static KEK provider whose KEK is never configured directly. It
+// starts sealed and is unsealed by submitting Shamir shares of the KEK, which are
+// combined once the threshold is reached. The KEK then lives in memory only.
+type SealedProvider struct {
+	kekID       string
+	kekRing     string
+	threshold   int
+	fingerprint string
+
+	mu       sync.RWMutex
+	shares   map[byte][]byte
+	provider *types.StaticKEKProvider // Nil while sealed
+}
+
+// NewSealedProvider creates a sealed provider from KEK_ID, KEK_RING,
+// KEK_UNSEAL_THRESHOLD and KEK_FINGERPRINT
+func NewSealedProvider(cfg *config.Config) (*SealedProvider, error) {
+	if cfg.KEKUnsealThreshold < 2 || cfg.KEKUnsealThreshold > shamir.MaxShares {
+		return nil, fmt.Errorf("KEK_UNSEAL_THRESHOLD must be between 2 and %d", shamir.MaxShares)
+	}
+	if cfg.KEKFingerprint == "" {
+		return nil, fmt.Errorf("KEK_FINGERPRINT is required to verify the unsealed KEK")
+	}
+
+	log.Printf("🔒 [KEKProvider] KEK sealed, %d shares required to unseal (fingerprint %s)", cfg.KEKUnsealThreshold, cfg.KEKFingerprint)
+
+	return &SealedProvider{
+		kekID:       cfg.KEKID,
+		kekRing:     cfg.KEKRing,
+		threshold:   cfg.KEKUnsealThreshold,
+		fingerprint: cfg.KEKFingerprint,
+		shares:      make(map[byte][]byte),
+	}, nil
+}
+
+// Unseal adds a share. Once the threshold is reached the shares are combined and, if
+// the result matches the fingerprint, the provider is unsealed. Shares that do not
+// reconstruct the KEK reset the progress so the custodians can start over.
+func (p *SealedProvider) Unseal(share []byte) (SealStatus, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != nil {
+		return p.status(), nil
+	}
+
+	if len(share) < 2 {
+		return p.status(), fmt.Errorf("share too short")
+	}
+	for _, existing := range p.shares {
+		if len(existing) != len(share) {
+			return p.status(), fmt.Errorf("share does not match the length of the shares already submitted")
+		}
+		break
+	}
+	if _, ok := p.shares[share[0]]; ok {
+		return p.status(), fmt.Errorf("share %d was already submitted", share[0])
+	}
+
+	p.shares[share[0]] = append([]byte(nil), share...)
+	if len(p.shares) < p.threshold {
+		return p.status(), nil
+	}
+
+	shares := make([][]byte, 0, len(p.shares))
+	for _, s := range p.shares {
+		shares = append(shares, s)
+	}
+	kek, err := shamir.Combine(shares)
+	p.resetShares()
+	if err != nil {
+		return p.status(), fmt.Errorf("failed to combine shares: %w", err)
+	}
+	defer clear(kek)
+
+	if !hmac.Equal([]byte(Fingerprint(kek)), []byte(p.fingerprint)) {
+		return p.status(), fmt.Errorf("shares do not reconstruct the KEK with fingerprint %s; unseal progress reset", p.fingerprint)
+	}
+
+	provider, err := types.NewStaticKEKProvider(p.kekID, base64.StdEncoding.EncodeToString(kek), p.kekRing)
+	if err != nil {
+		return p.status(), err
+	}
+	p.provider = provider
+
+	log.Printf("🔓 [KEKProvider] KEK unsealed, current KEK: %s", p.kekID)
+
+	return p.status(), nil
+}
+
+// ResetUnseal discards the shares submitted so far
+func (p *SealedProvider) ResetUnseal() SealStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.resetShares()
+	return p.status()
+}
+
+// Status reports whether the provider is sealed and how many shares were submitted
+func (p *SealedProvider) Status() SealStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.status()
+}
+
+func (p *SealedProvider) status() SealStatus {
+	return SealStatus{
+		Sealed:    p.provider == nil,
+		Threshold: p.threshold,
+		Progress:  len(p.shares),
+	}
+}
+
+func (p *SealedProvider) resetShares() {
+	for x, share := range p.shares {
+		clear(share)
+		delete(p.shares, x)
+	}
+}
+
+// unsealed returns the KEK ring, or ErrSealed
+func (p *SealedProvider) unsealed() (*types.StaticKEKProvider, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.provider == nil {
+		return nil, ErrSealed
+	}
+	return p.provider, nil
+}
+
+// WrapTEK wraps a TEK with the current KEK once unsealed
+func (p *SealedProvider) WrapTEK(tek []byte) ([]byte, error) {
+	provider, err := p.unsealed()
+	if err != nil {
+		return nil, err
+	}
+	return provider.WrapTEK(tek)
+}
+
+// UnwrapTEK unwraps a TEK once unsealed
+func (p *SealedProvider) UnwrapTEK(encryptedTEK []byte) ([]byte, error) {
+	provider, err := p.unsealed()
+	if err != nil {
+		return nil, err
+	}
+	return provider.UnwrapTEK(encryptedTEK)
+}
+
+// CurrentKEKID returns the configured KEK ID, which is known while sealed
+func (p *SealedProvider) CurrentKEKID() string {
+	return p.kekID
+}
+
+// WrappedKEKID returns the ID of the KEK that wrapped a TEK
+func (p *SealedProvider) WrappedKEKID(encryptedTEK []byte) string {
+	return envelope.WrappedKEKID(encryptedTEK)
+}
+
+// KEKIDs returns the KEK ring once unsealed, and only the current KEK ID before
+func (p *SealedProvider) KEKIDs() []string {
+	provider, err := p.unsealed()
+	if err != nil {
+		return []string{p.kekID}
+	}
+	return provider.KEKIDs()
+}
+
+// Close discards the KEK and any submitted shares, sealing the provider again
+func (p *SealedProvider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.resetShares()
+	var err error
+	if p.provider != nil {
+		err = p.provider.Close()
+		p.provider = nil
+	}
+	return err
+}
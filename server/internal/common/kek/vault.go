@@ -0,0 +1,379 @@
+package kek
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+const (
+	// vaultRequestTimeout bounds every call to Vault
+	vaultRequestTimeout = 10 * time.Second
+
+	// vaultKeyInfoTTL is how long the Transit key's version range is cached, so a
+	// rotation of the key in Vault is picked up without a restart
+	vaultKeyInfoTTL = time.Minute
+
+	// vaultTokenRenewMargin is how long before expiry an AppRole token is replaced
+	vaultTokenRenewMargin = 30 * time.Second
+
+	// vaultCiphertextPrefix starts every Transit ciphertext, followed by the key version
+	vaultCiphertextPrefix = "vault:v"
+)
+
+// VaultConfig configures a VaultTransitProvider. AppRole credentials take precedence
+// over a static token.
+type VaultConfig struct {
+	Addr         string
+	Namespace    string
+	Token        string
+	RoleID       string
+	SecretID     string
+	AppRoleMount string
+	TransitMount string
+	Key          string
+	CACert       string
+}
+
+// VaultTransitProvider implements types.KEKProvider with HashiCorp Vault's Transit
+// secrets engine. TEKs are sent to Vault to be wrapped and unwrapped, so the KEK never
+// leaves Vault. The versions of the Transit key form the KEK ring, with KEK IDs of the
+// form "<key>:v<version>"; rotating the key in Vault and running the TEK rewrap job
+// rotates the KEK.
+type VaultTransitProvider struct {
+	config     VaultConfig
+	httpClient *http.Client
+	legacy     *types.StaticKEKProvider // Optional; unwraps TEKs wrapped before the move to Vault
+
+	mu                   sync.Mutex
+	token                string
+	tokenExpiresAt       time.Time // Zero for tokens that do not expire
+	latestVersion        int
+	minDecryptionVersion int
+	keyInfoExpiresAt     time.Time
+}
+
+// NewVaultTransitProvider creates a Vault Transit KEK provider and checks that the
+// Transit key can be read
+func NewVaultTransitProvider(cfg VaultConfig, legacy *types.StaticKEKProvider) (*VaultTransitProvider, error) {
+	if cfg.Addr == "" || cfg.TransitMount == "" || cfg.Key == "" {
+		return nil, fmt.Errorf("VAULT_ADDR, VAULT_TRANSIT_MOUNT and VAULT_TRANSIT_KEY are required")
+	}
+	if cfg.RoleID == "" && cfg.Token == "" {
+		return nil, fmt.Errorf("either VAULT_TOKEN or VAULT_ROLE_ID and VAULT_SECRET_ID are required")
+	}
+	if cfg.RoleID != "" && cfg.SecretID == "" {
+		return nil, fmt.Errorf("VAULT_SECRET_ID is required with VAULT_ROLE_ID")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.CACert != "" {
+		pem, err := os.ReadFile(cfg.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read VAULT_CACERT: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("VAULT_CACERT contains no certificates")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	p := &VaultTransitProvider{
+		config:     cfg,
+		httpClient: &http.Client{Transport: transport, Timeout: vaultRequestTimeout},
+		legacy:     legacy,
+		token:      cfg.Token,
+	}
+	p.config.Addr = strings.TrimRight(cfg.Addr, "/")
+
+	if err := p.refreshKeyInfo(); err != nil {
+		return nil, fmt.Errorf("failed to read Transit key %q: %w", cfg.Key, err)
+	}
+
+	log.Printf("✅ [KEKProvider] Vault Transit key %s/%s at %s (current: %s)", cfg.TransitMount, cfg.Key, p.config.Addr, p.CurrentKEKID())
+	if legacy != nil {
+		log.Printf("ℹ️ [KEKProvider] Static KEK ring kept for unwrapping TEKs wrapped before Vault")
+	}
+
+	return p, nil
+}
+
+// WrapTEK encrypts a TEK with the latest version of the Transit key
+func (p *VaultTransitProvider) WrapTEK(tek []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	err := p.request(http.MethodPost, p.transitPath("encrypt"), map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(tek),
+	}, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap TEK with Vault: %w", err)
+	}
+	if !strings.HasPrefix(resp.Data.Ciphertext, vaultCiphertextPrefix) {
+		return nil, fmt.Errorf("unexpected Vault ciphertext format")
+	}
+
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+// UnwrapTEK decrypts a TEK with Vault, or with the static KEK ring for TEKs wrapped
+// before the move to Vault
+func (p *VaultTransitProvider) UnwrapTEK(encryptedTEK []byte) ([]byte, error) {
+	if !bytes.HasPrefix(encryptedTEK, []byte(vaultCiphertextPrefix)) {
+		if p.legacy == nil {
+			return nil, fmt.Errorf("TEK was not wrapped by Vault and no static KEK is configured")
+		}
+		return p.legacy.UnwrapTEK(encryptedTEK)
+	}
+
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	err := p.request(http.MethodPost, p.transitPath("decrypt"), map[string]string{
+		"ciphertext": string(encryptedTEK),
+	}, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap TEK with Vault: %w", err)
+	}
+
+	tek, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode TEK from Vault: %w", err)
+	}
+	return tek, nil
+}
+
+// CurrentKEKID returns the ID of the latest Transit key version
+func (p *VaultTransitProvider) CurrentKEKID() string {
+	latest, _ := p.keyVersions()
+	return p.kekID(latest)
+}
+
+// WrappedKEKID returns the ID of the Transit key version, or static KEK, that wrapped a TEK
+func (p *VaultTransitProvider) WrappedKEKID(encryptedTEK []byte) string {
+	if !bytes.HasPrefix(encryptedTEK, []byte(vaultCiphertextPrefix)) {
+		return envelope.WrappedKEKID(encryptedTEK)
+	}
+
+	rest := encryptedTEK[len(vaultCiphertextPrefix):]
+	end := bytes.IndexByte(rest, ':')
+	if end < 0 {
+		return ""
+	}
+	version, err := strconv.Atoi(string(rest[:end]))
+	if err != nil {
+		return ""
+	}
+	return p.kekID(version)
+}
+
+// KEKIDs returns the Transit key versions that can still decrypt, latest first,
+// followed by the static KEKs kept for TEKs wrapped before Vault
+func (p *VaultTransitProvider) KEKIDs() []string {
+	latest, minDecryption := p.keyVersions()
+
+	var ids []string
+	for version := latest; version >= max(minDecryption, 1); version-- {
+		ids = append(ids, p.kekID(version))
+	}
+	if p.legacy != nil {
+		ids = append(ids, p.legacy.KEKIDs()...)
+	}
+	return ids
+}
+
+// Close revokes the token obtained through AppRole login
+func (p *VaultTransitProvider) Close() error {
+	p.mu.Lock()
+	loggedIn := p.config.RoleID != "" && p.token != ""
+	p.mu.Unlock()
+
+	if !loggedIn {
+		return nil
+	}
+	return p.request(http.MethodPost, "auth/token/revoke-self", nil, nil)
+}
+
+// kekID formats the KEK ID of a Transit key version
+func (p *VaultTransitProvider) kekID(version int) string {
+	return fmt.Sprintf("%s:v%d", p.config.Key, version)
+}
+
+// transitPath returns the path of a Transit operation on the configured key
+func (p *VaultTransitProvider) transitPath(operation string) string {
+	return fmt.Sprintf("%s/%s/%s", p.config.TransitMount, operation, p.config.Key)
+}
+
+// keyVersions returns the latest and minimum decryption versions of the Transit key,
+// refreshing them once the cached values expire. If Vault cannot be reached the last
+// known values are kept.
+func (p *VaultTransitProvider) keyVersions() (int, int) {
+	p.mu.Lock()
+	stale := time.Now().After(p.keyInfoExpiresAt)
+	p.mu.Unlock()
+
+	if stale {
+		if err := p.refreshKeyInfo(); err != nil {
+			log.Printf("⚠️  [KEKProvider] Failed to refresh Vault Transit key info: %v", err)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.latestVersion, p.minDecryptionVersion
+}
+
+// refreshKeyInfo reads the version range of the Transit key
+func (p *VaultTransitProvider) refreshKeyInfo() error {
+	var resp struct {
+		Data struct {
+			LatestVersion        int `json:"latest_version"`
+			MinDecryptionVersion int `json:"min_decryption_version"`
+		} `json:"data"`
+	}
+	path := fmt.Sprintf("%s/keys/%s", p.config.TransitMount, p.config.Key)
+	if err := p.request(http.MethodGet, path, nil, &resp); err != nil {
+		return err
+	}
+	if resp.Data.LatestVersion < 1 {
+		return fmt.Errorf("transit key has no versions")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.latestVersion = resp.Data.LatestVersion
+	p.minDecryptionVersion = resp.Data.MinDecryptionVersion
+	p.keyInfoExpiresAt = time.Now().Add(vaultKeyInfoTTL)
+	return nil
+}
+
+// request calls the Vault HTTP API. With AppRole auth a rejected token is replaced by
+// logging in again and the call is retried once.
+func (p *VaultTransitProvider) request(method, path string, body, out any) error {
+	token, err := p.clientToken()
+	if err != nil {
+		return err
+	}
+
+	status, err := p.do(method, path, token, body, out)
+	if status == http.StatusForbidden && p.config.RoleID != "" {
+		p.mu.Lock()
+		p.token = ""
+		p.mu.Unlock()
+
+		if token, err = p.clientToken(); err != nil {
+			return err
+		}
+		_, err = p.do(method, path, token, body, out)
+	}
+	return err
+}
+
+// clientToken returns the Vault token, logging in with AppRole when there is no
+// token yet or the current one is about to expire
+func (p *VaultTransitProvider) clientToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.config.RoleID == "" {
+		return p.token, nil
+	}
+	if p.token != "" && (p.tokenExpiresAt.IsZero() || time.Until(p.tokenExpiresAt) > vaultTokenRenewMargin) {
+		return p.token, nil
+	}
+
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	_, err := p.do(http.MethodPost, fmt.Sprintf("auth/%s/login", p.config.AppRoleMount), "", map[string]string{
+		"role_id":   p.config.RoleID,
+		"secret_id": p.config.SecretID,
+	}, &resp)
+	if err != nil {
+		return "", fmt.Errorf("vault AppRole login failed: %w", err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault AppRole login returned no token")
+	}
+
+	p.token = resp.Auth.ClientToken
+	p.tokenExpiresAt = time.Time{}
+	if resp.Auth.LeaseDuration > 0 {
+		p.tokenExpiresAt = time.Now().Add(time.Duration(resp.Auth.LeaseDuration) * time.Second)
+	}
+
+	log.Printf("✅ [KEKProvider] Logged in to Vault with AppRole")
+	return p.token, nil
+}
+
+// do performs one Vault API call and decodes the JSON response into out
+func (p *VaultTransitProvider) do(method, path, token string, body, out any) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), vaultRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, p.config.Addr+"/v1/"+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if p.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.config.Namespace)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&vaultErr)
+		return resp.StatusCode, fmt.Errorf("vault %s %s returned %d: %s", method, path, resp.StatusCode, strings.Join(vaultErr.Errors, "; "))
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode Vault response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
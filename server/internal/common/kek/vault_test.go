@@ -0,0 +1,237 @@
+package kek
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+// These tests run against a real Vault server, such as one started with
+//
+//	vault server -dev -dev-root-token-id=root
+//	VAULT_ADDR=http://127.0.0.1:8200 VAULT_TOKEN=root go test ./internal/common/kek/
+//
+// They enable the Transit engine at VAULT_TRANSIT_MOUNT (default "transit") if needed
+// and create a Transit key of their own, which is deleted afterwards.
+
+// newVaultTestProvider creates a Transit key and a provider for it, skipping the test
+// when no Vault server is configured
+func newVaultTestProvider(t *testing.T, legacy *types.StaticKEKProvider) *VaultTransitProvider {
+	t.Helper()
+
+	addr, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		t.Skip("VAULT_ADDR and VAULT_TOKEN are not set")
+	}
+	mount := os.Getenv("VAULT_TRANSIT_MOUNT")
+	if mount == "" {
+		mount = "transit"
+	}
+	cfg := VaultConfig{
+		Addr:         addr,
+		Namespace:    os.Getenv("VAULT_NAMESPACE"),
+		Token:        token,
+		TransitMount: mount,
+		Key:          fmt.Sprintf("mistokenly-test-%d", time.Now().UnixNano()),
+		CACert:       os.Getenv("VAULT_CACERT"),
+	}
+
+	// An unconfigured provider is enough to call the Vault API
+	admin := &VaultTransitProvider{
+		config:     cfg,
+		httpClient: &http.Client{Timeout: vaultRequestTimeout},
+		token:      token,
+	}
+	admin.config.Addr = strings.TrimRight(addr, "/")
+
+	if err := admin.request(http.MethodPost, "sys/mounts/"+mount, map[string]string{"type": "transit"}, nil); err != nil && !strings.Contains(err.Error(), "path is already in use") {
+		t.Fatalf("failed to enable Transit at %s: %v", mount, err)
+	}
+	if err := admin.request(http.MethodPost, admin.transitPath("keys"), map[string]string{"type": "aes256-gcm96"}, nil); err != nil {
+		t.Fatalf("failed to create Transit key: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := admin.request(http.MethodPost, admin.transitPath("keys")+"/config", map[string]any{"deletion_allowed": true}, nil); err != nil {
+			t.Logf("failed to allow deletion of Transit key %s: %v", cfg.Key, err)
+			return
+		}
+		if err := admin.request(http.MethodDelete, admin.transitPath("keys"), nil, nil); err != nil {
+			t.Logf("failed to delete Transit key %s: %v", cfg.Key, err)
+		}
+	})
+
+	provider, err := NewVaultTransitProvider(cfg, legacy)
+	if err != nil {
+		t.Fatalf("NewVaultTransitProvider: %v", err)
+	}
+	return provider
+}
+
+// vaultKeyRequest calls the Transit API for the provider's key
+func vaultKeyRequest(t *testing.T, p *VaultTransitProvider, operation string, body any) {
+	t.Helper()
+	if err := p.request(http.MethodPost, p.transitPath("keys")+operation, body, nil); err != nil {
+		t.Fatalf("Transit key %s failed: %v", operation, err)
+	}
+}
+
+// expireVaultKeyInfo makes the provider read the key's versions again on next use
+func expireVaultKeyInfo(p *VaultTransitProvider) {
+	p.mu.Lock()
+	p.keyInfoExpiresAt = time.Time{}
+	p.mu.Unlock()
+}
+
+func randomTEK(t *testing.T) []byte {
+	t.Helper()
+	tek := make([]byte, 32)
+	if _, err := rand.Read(tek); err != nil {
+		t.Fatal(err)
+	}
+	return tek
+}
+
+func TestVaultTransitWrapUnwrap(t *testing.T) {
+	p := newVaultTestProvider(t, nil)
+	tek := randomTEK(t)
+
+	wrapped, err := p.WrapTEK(tek)
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+	if !bytes.HasPrefix(wrapped, []byte("vault:v1:")) {
+		t.Fatalf("wrapped TEK %q is not a version 1 Transit ciphertext", wrapped)
+	}
+	if bytes.Contains(wrapped, []byte(base64.StdEncoding.EncodeToString(tek))) {
+		t.Fatal("wrapped TEK contains the plaintext TEK")
+	}
+
+	want := p.config.Key + ":v1"
+	if got := p.CurrentKEKID(); got != want {
+		t.Errorf("CurrentKEKID = %q, want %q", got, want)
+	}
+	if got := p.WrappedKEKID(wrapped); got != want {
+		t.Errorf("WrappedKEKID = %q, want %q", got, want)
+	}
+
+	unwrapped, err := p.UnwrapTEK(wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapTEK: %v", err)
+	}
+	if !bytes.Equal(unwrapped, tek) {
+		t.Fatal("UnwrapTEK returned a different TEK")
+	}
+
+	// Flip a bit of the ciphertext behind the "vault:v1:" prefix
+	prefix := len("vault:v1:")
+	body, err := base64.StdEncoding.DecodeString(string(wrapped[prefix:]))
+	if err != nil {
+		t.Fatalf("Transit ciphertext is not base64: %v", err)
+	}
+	body[len(body)-1] ^= 1
+	tampered := append(bytes.Clone(wrapped[:prefix]), base64.StdEncoding.EncodeToString(body)...)
+	if _, err := p.UnwrapTEK(tampered); err == nil {
+		t.Error("UnwrapTEK accepted a tampered ciphertext")
+	}
+	if _, err := p.UnwrapTEK([]byte("not a vault ciphertext")); err == nil {
+		t.Error("UnwrapTEK accepted a non-Vault ciphertext without a static KEK")
+	}
+}
+
+func TestVaultTransitKeyRotation(t *testing.T) {
+	p := newVaultTestProvider(t, nil)
+	key := p.config.Key
+
+	tek1 := randomTEK(t)
+	wrapped1, err := p.WrapTEK(tek1)
+	if err != nil {
+		t.Fatalf("WrapTEK: %v", err)
+	}
+
+	vaultKeyRequest(t, p, "/rotate", nil)
+
+	// The cached version range hides the rotation until it expires
+	if got := p.CurrentKEKID(); got != key+":v1" {
+		t.Errorf("CurrentKEKID before the cache expired = %q, want %q", got, key+":v1")
+	}
+	expireVaultKeyInfo(p)
+
+	if got := p.CurrentKEKID(); got != key+":v2" {
+		t.Fatalf("CurrentKEKID after rotation = %q, want %q", got, key+":v2")
+	}
+	if got, want := p.KEKIDs(), []string{key + ":v2", key + ":v1"}; !slices.Equal(got, want) {
+		t.Errorf("KEKIDs = %q, want %q", got, want)
+	}
+
+	tek2 := randomTEK(t)
+	wrapped2, err := p.WrapTEK(tek2)
+	if err != nil {
+		t.Fatalf("WrapTEK after rotation: %v", err)
+	}
+	if got := p.WrappedKEKID(wrapped2); got != key+":v2" {
+		t.Errorf("WrappedKEKID of a new TEK = %q, want %q", got, key+":v2")
+	}
+	if got := p.WrappedKEKID(wrapped1); got != key+":v1" {
+		t.Errorf("WrappedKEKID of an old TEK = %q, want %q", got, key+":v1")
+	}
+
+	// TEKs wrapped before the rotation stay readable until the old version is retired
+	for i, c := range []struct {
+		wrapped, tek []byte
+	}{{wrapped1, tek1}, {wrapped2, tek2}} {
+		unwrapped, err := p.UnwrapTEK(c.wrapped)
+		if err != nil {
+			t.Fatalf("UnwrapTEK of TEK %d: %v", i+1, err)
+		}
+		if !bytes.Equal(unwrapped, c.tek) {
+			t.Fatalf("UnwrapTEK of TEK %d returned a different TEK", i+1)
+		}
+	}
+
+	vaultKeyRequest(t, p, "/config", map[string]int{"min_decryption_version": 2})
+	expireVaultKeyInfo(p)
+
+	if got, want := p.KEKIDs(), []string{key + ":v2"}; !slices.Equal(got, want) {
+		t.Errorf("KEKIDs after retiring version 1 = %q, want %q", got, want)
+	}
+	if _, err := p.UnwrapTEK(wrapped1); err == nil {
+		t.Error("UnwrapTEK accepted a TEK wrapped with a retired key version")
+	}
+}
+
+func TestVaultTransitLegacyStaticKEK(t *testing.T) {
+	legacy, err := types.NewStaticKEKProvider("legacy-kek", base64.StdEncoding.EncodeToString(randomTEK(t)), "")
+	if err != nil {
+		t.Fatalf("NewStaticKEKProvider: %v", err)
+	}
+	p := newVaultTestProvider(t, legacy)
+
+	tek := randomTEK(t)
+	wrapped, err := legacy.WrapTEK(tek)
+	if err != nil {
+		t.Fatalf("WrapTEK with the static KEK: %v", err)
+	}
+
+	unwrapped, err := p.UnwrapTEK(wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapTEK of a statically wrapped TEK: %v", err)
+	}
+	if !bytes.Equal(unwrapped, tek) {
+		t.Fatal("UnwrapTEK returned a different TEK")
+	}
+	if got := p.WrappedKEKID(wrapped); got != "legacy-kek" {
+		t.Errorf("WrappedKEKID = %q, want %q", got, "legacy-kek")
+	}
+	if ids := p.KEKIDs(); !slices.Contains(ids, "legacy-kek") {
+		t.Errorf("KEKIDs = %q, want the static KEK included", ids)
+	}
+}
@@ -0,0 +1,170 @@
+// Package lockout implements brute-force protection for organization key
+// verification. Failed attempts are counted per organization and per source
+// address; once a counter passes its threshold further verification attempts
+// are refused for an exponentially growing period.
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Scope identifies which counter triggered a lockout
+type Scope string
+
+const (
+	ScopeOrganization Scope = "organization"
+	ScopeSource       Scope = "source"
+)
+
+// Store persists failure counters and lock expiry times
+type Store interface {
+	// RecordFailure increments the counter for key and returns the new count.
+	// The counter is forgotten after window passes without another failure.
+	RecordFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	// Lock blocks key for the given duration
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	// LockedFor returns how much longer key is locked, or 0 if it is not locked
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// Reset clears the counter and any lock for key
+	Reset(ctx context.Context, key string) error
+}
+
+// Policy configures the thresholds for one counter scope
+type Policy struct {
+	MaxAttempts int64         // Failures allowed within Window before the first lockout
+	Window      time.Duration // Counters reset after this long without a failure
+	BaseLockout time.Duration // Duration of the first lockout; doubles with every further failure
+	MaxLockout  time.Duration // Upper bound on a single lockout
+}
+
+// lockoutFor returns how long to lock after the given number of failures, or 0
+func (p Policy) lockoutFor(failures int64) time.Duration {
+	if p.MaxAttempts <= 0 || failures < p.MaxAttempts {
+		return 0
+	}
+
+	duration := p.BaseLockout
+	for i := p.MaxAttempts; i < failures && duration < p.MaxLockout; i++ {
+		duration *= 2
+	}
+	if duration > p.MaxLockout {
+		duration = p.MaxLockout
+	}
+	return duration
+}
+
+// Lockout describes a lock triggered by a failed attempt
+type Lockout struct {
+	Scope    Scope
+	Subject  string // Organization ID or source address
+	Failures int64
+	Duration time.Duration
+}
+
+// Limiter tracks failed organization key attempts
+type Limiter struct {
+	store        Store
+	orgPolicy    Policy
+	sourcePolicy Policy
+}
+
+// NewLimiter creates a limiter backed by store
+func NewLimiter(store Store, orgPolicy, sourcePolicy Policy) *Limiter {
+	return &Limiter{
+		store:        store,
+		orgPolicy:    orgPolicy,
+		sourcePolicy: sourcePolicy,
+	}
+}
+
+// Check returns how long verification attempts for the organization or source remain
+// locked, or 0 if neither is locked. An empty source is not checked.
+func (l *Limiter) Check(ctx context.Context, organizationID, source string) (time.Duration, error) {
+	remaining, err := l.store.LockedFor(ctx, orgKey(organizationID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to check organization lockout: %w", err)
+	}
+
+	if source != "" {
+		sourceRemaining, err := l.store.LockedFor(ctx, sourceKey(source))
+		if err != nil {
+			return 0, fmt.Errorf("failed to check source lockout: %w", err)
+		}
+		if sourceRemaining > remaining {
+			remaining = sourceRemaining
+		}
+	}
+
+	return remaining, nil
+}
+
+// RecordFailure counts a failed attempt and returns any lockouts it triggered
+func (l *Limiter) RecordFailure(ctx context.Context, organizationID, source string) ([]Lockout, error) {
+	var lockouts []Lockout
+
+	lockout, err := l.recordFailure(ctx, ScopeOrganization, organizationID, orgKey(organizationID), l.orgPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if lockout != nil {
+		lockouts = append(lockouts, *lockout)
+	}
+
+	if source != "" {
+		lockout, err := l.recordFailure(ctx, ScopeSource, source, sourceKey(source), l.sourcePolicy)
+		if err != nil {
+			return lockouts, err
+		}
+		if lockout != nil {
+			lockouts = append(lockouts, *lockout)
+		}
+	}
+
+	return lockouts, nil
+}
+
+// Unlock clears the counters for an organization and, if given, a source
+func (l *Limiter) Unlock(ctx context.Context, organizationID, source string) error {
+	if err := l.store.Reset(ctx, orgKey(organizationID)); err != nil {
+		return fmt.Errorf("failed to reset organization lockout: %w", err)
+	}
+	if source != "" {
+		if err := l.store.Reset(ctx, sourceKey(source)); err != nil {
+			return fmt.Errorf("failed to reset source lockout: %w", err)
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) recordFailure(ctx context.Context, scope Scope, subject, key string, policy Policy) (*Lockout, error) {
+	failures, err := l.store.RecordFailure(ctx, key, policy.Window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record %s failure: %w", scope, err)
+	}
+
+	duration := policy.lockoutFor(failures)
+	if duration == 0 {
+		return nil, nil
+	}
+
+	if err := l.store.Lock(ctx, key, duration); err != nil {
+		return nil, fmt.Errorf("failed to lock %s: %w", scope, err)
+	}
+
+	return &Lockout{
+		Scope:    scope,
+		Subject:  subject,
+		Failures: failures,
+		Duration: duration,
+	}, nil
+}
+
+func orgKey(organizationID string) string {
+	return "org:" + organizationID
+}
+
+func sourceKey(source string) string {
+	return "src:" + source
+}
@@ -0,0 +1,105 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps counters in process memory. It is used when no shared cache
+// is available; counters are then per replica.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]*memoryEntry
+	lastSweep time.Time
+}
+
+// memorySweepInterval is how often expired entries are evicted
+const memorySweepInterval = time.Minute
+
+type memoryEntry struct {
+	failures    int64
+	resetAt     time.Time
+	lockedUntil time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]*memoryEntry),
+	}
+}
+
+// RecordFailure increments the counter for key
+func (m *MemoryStore) RecordFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.evictExpired(now)
+
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &memoryEntry{}
+		m.entries[key] = entry
+	}
+	if now.After(entry.resetAt) {
+		entry.failures = 0
+	}
+
+	entry.failures++
+	entry.resetAt = now.Add(window)
+	return entry.failures, nil
+}
+
+// Lock blocks key for the given duration
+func (m *MemoryStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &memoryEntry{}
+		m.entries[key] = entry
+	}
+	entry.lockedUntil = time.Now().Add(duration)
+	return nil
+}
+
+// LockedFor returns how much longer key is locked
+func (m *MemoryStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return 0, nil
+	}
+	if remaining := time.Until(entry.lockedUntil); remaining > 0 {
+		return remaining, nil
+	}
+	return 0, nil
+}
+
+// Reset clears the counter and lock for key
+func (m *MemoryStore) Reset(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+// evictExpired periodically drops entries whose counter window and lock have both
+// passed so the map does not grow with every source address ever seen
+func (m *MemoryStore) evictExpired(now time.Time) {
+	if now.Sub(m.lastSweep) < memorySweepInterval {
+		return
+	}
+	m.lastSweep = now
+
+	for key, entry := range m.entries {
+		if now.After(entry.resetAt) && now.After(entry.lockedUntil) {
+			delete(m.entries, key)
+		}
+	}
+}
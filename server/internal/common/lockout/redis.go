@@ -0,0 +1,58 @@
+package lockout
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisKeyPrefix namespaces lockout keys in the shared cache
+const redisKeyPrefix = "pii:lockout:"
+
+// RedisStore keeps counters in Redis so they are shared by every replica
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a store backed by an existing Redis client
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+// RecordFailure increments the counter for key and extends its window
+func (r *RedisStore) RecordFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	counterKey := redisKeyPrefix + key + ":failures"
+
+	pipe := r.client.TxPipeline()
+	incr := pipe.Incr(ctx, counterKey)
+	pipe.PExpire(ctx, counterKey, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// Lock blocks key for the given duration
+func (r *RedisStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	return r.client.Set(ctx, redisKeyPrefix+key+":locked", 1, duration).Err()
+}
+
+// LockedFor returns how much longer key is locked
+func (r *RedisStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.PTTL(ctx, redisKeyPrefix+key+":locked").Result()
+	if err != nil {
+		return 0, err
+	}
+	// Negative values mean the key does not exist or has no expiry
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Reset clears the counter and lock for key
+func (r *RedisStore) Reset(ctx context.Context, key string) error {
+	return r.client.Del(ctx, redisKeyPrefix+key+":failures", redisKeyPrefix+key+":locked").Err()
+}
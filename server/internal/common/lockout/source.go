@@ -0,0 +1,70 @@
+package lockout
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// SourceMetadataKey is the gRPC metadata key carrying the originating client address
+// from the API gateway to the PII service
+const SourceMetadataKey = "x-client-source"
+
+// GatewayKeyMetadataKey is the gRPC metadata key carrying the gateway's source key,
+// which vouches for the address in SourceMetadataKey
+const GatewayKeyMetadataKey = "x-gateway-source-key"
+
+// WithSource attaches the originating client address to outgoing gRPC calls, along
+// with the gateway's source key so the PII service trusts it
+func WithSource(ctx context.Context, source, gatewayKey string) context.Context {
+	if source == "" {
+		return ctx
+	}
+	if gatewayKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, GatewayKeyMetadataKey, gatewayKey)
+	}
+	return metadata.AppendToOutgoingContext(ctx, SourceMetadataKey, source)
+}
+
+// SourceFromContext returns the address failed key verifications are counted against.
+// For a gRPC call that is the forwarded client address when the call carries
+// gatewayKey, and the address of the calling peer otherwise, so a direct caller
+// cannot pick its own source. A service called in-process trusts the address
+// recorded by WithSource.
+func SourceFromContext(ctx context.Context, gatewayKey string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if gatewayKey != "" && firstValue(md, SourceMetadataKey) != "" &&
+			subtle.ConstantTimeCompare([]byte(firstValue(md, GatewayKeyMetadataKey)), []byte(gatewayKey)) == 1 {
+			return firstValue(md, SourceMetadataKey)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return SourceFromRemoteAddr(p.Addr.String())
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if values := md.Get(SourceMetadataKey); len(values) > 0 {
+			return values[len(values)-1]
+		}
+	}
+	return ""
+}
+
+// firstValue returns the first value of a metadata key, or ""
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// SourceFromRemoteAddr strips the port from an HTTP remote address
+func SourceFromRemoteAddr(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
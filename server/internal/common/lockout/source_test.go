@@ -0,0 +1,57 @@
+package lockout
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// incoming returns the context of a gRPC call from addr carrying the given metadata pairs
+func incoming(addr string, pairs ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 51234}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+}
+
+func TestSourceFromContext(t *testing.T) {
+	const key = "gateway-secret"
+
+	for _, c := range []struct {
+		name string
+		ctx  context.Context
+		key  string
+		want string
+	}{
+		{"forwarded by the gateway", incoming("10.0.0.5", SourceMetadataKey, "203.0.113.7", GatewayKeyMetadataKey, key), key, "203.0.113.7"},
+		{"forwarded without the key", incoming("10.0.0.9", SourceMetadataKey, "203.0.113.7"), key, "10.0.0.9"},
+		{"forwarded with a wrong key", incoming("10.0.0.9", SourceMetadataKey, "203.0.113.7", GatewayKeyMetadataKey, "guess"), key, "10.0.0.9"},
+		{"forwarded with no key configured", incoming("10.0.0.9", SourceMetadataKey, "203.0.113.7", GatewayKeyMetadataKey, ""), "", "10.0.0.9"},
+		{"gateway key without a source", incoming("10.0.0.5", GatewayKeyMetadataKey, key), key, "10.0.0.5"},
+		{"direct call", incoming("198.51.100.2"), key, "198.51.100.2"},
+		{"in-process call", WithSource(context.Background(), "203.0.113.7", ""), key, "203.0.113.7"},
+		{"no source", context.Background(), key, ""},
+	} {
+		if got := SourceFromContext(c.ctx, c.key); got != c.want {
+			t.Errorf("%s: SourceFromContext = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestWithSource(t *testing.T) {
+	ctx := WithSource(context.Background(), "203.0.113.7", "gateway-secret")
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if got := md.Get(SourceMetadataKey); len(got) != 1 || got[0] != "203.0.113.7" {
+		t.Errorf("source metadata = %q", got)
+	}
+	if got := md.Get(GatewayKeyMetadataKey); len(got) != 1 || got[0] != "gateway-secret" {
+		t.Errorf("gateway key metadata = %q", got)
+	}
+
+	if ctx := WithSource(context.Background(), "", "gateway-secret"); ctx != context.Background() {
+		if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md) > 0 {
+			t.Errorf("WithSource without a source attached %v", md)
+		}
+	}
+}
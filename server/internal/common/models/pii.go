@@ -8,7 +8,7 @@ type TokenizeRequest struct {
 	RetentionPolicy string `json:"retentionPolicy"`
 	ClientID        string `json:"clientId"`
 	OrganizationID  string `json:"organizationId"`
-	OrganizationKey string `json:"organizationKey"`
+	OrganizationKey string `json:"-"` // Sent in the X-Org-Key header, never in the body
 }
 
 type TokenizeResponse struct {
@@ -24,7 +24,7 @@ type DetokenizeRequest struct {
 	RequestingService string `json:"requestingService"`
 	RequestingUser    string `json:"requestingUser"`
 	OrganizationID    string `json:"organizationId"`
-	OrganizationKey   string `json:"organizationKey"`
+	OrganizationKey   string `json:"-"` // Sent in the X-Org-Key header, never in the body
 }
 
 type DetokenizeResponse struct {
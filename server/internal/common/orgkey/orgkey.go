@@ -0,0 +1,136 @@
+// Package orgkey hashes and verifies organization keys. It is shared by the PII
+// and persistence services so both apply the same hash format and comparison.
+//
+// Hashes are Argon2id, encoded PHC-style with a random per-organization salt:
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<base64 salt>$<base64 hash>
+//
+// Legacy hashes (unsalted SHA-256, 64 hex characters) are still accepted and are
+// reported as needing a rehash so callers can upgrade them transparently.
+//
+// Keys travel outside of request bodies, where they would end up in request logs
+// and body captures: in the X-Org-Key HTTP header at the API gateway, and in gRPC
+// metadata for callers of the services.
+package orgkey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id parameters for new hashes (OWASP minimum recommendation)
+const (
+	argon2Memory  = 19 * 1024 // KiB
+	argon2Time    = 2
+	argon2Threads = 1
+	saltLength    = 16
+	keyLength     = 32
+
+	// maxArgon2Memory bounds the memory cost accepted from a stored hash
+	maxArgon2Memory = 1024 * 1024 // KiB
+)
+
+// ErrInvalidHash indicates a stored hash is in an unrecognized or malformed format
+var ErrInvalidHash = errors.New("invalid organization key hash")
+
+// Hash returns an Argon2id PHC string for the organization key using a fresh random salt
+func Hash(orgKey string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	hash := argon2.IDKey([]byte(orgKey), salt, argon2Time, argon2Memory, argon2Threads, keyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	), nil
+}
+
+// Verify reports whether orgKey matches the stored hash using a constant-time
+// comparison. needsRehash is true when the key matched but the stored hash uses a
+// legacy format or outdated parameters and should be replaced with Hash(orgKey).
+func Verify(orgKey string, storedHash string) (ok bool, needsRehash bool, err error) {
+	if isLegacyHash(storedHash) {
+		expected, err := hex.DecodeString(storedHash)
+		if err != nil {
+			return false, false, ErrInvalidHash
+		}
+		provided := sha256.Sum256([]byte(orgKey))
+		if subtle.ConstantTimeCompare(provided[:], expected) != 1 {
+			return false, false, nil
+		}
+		return true, true, nil
+	}
+
+	params, salt, expected, err := decodeArgon2id(storedHash)
+	if err != nil {
+		return false, false, err
+	}
+
+	provided := argon2.IDKey([]byte(orgKey), salt, params.time, params.memory, params.threads, uint32(len(expected)))
+	if subtle.ConstantTimeCompare(provided, expected) != 1 {
+		return false, false, nil
+	}
+
+	current := params.memory == argon2Memory && params.time == argon2Time &&
+		params.threads == argon2Threads && len(salt) == saltLength && len(expected) == keyLength
+	return true, !current, nil
+}
+
+// argon2Params holds the cost parameters encoded in a PHC string
+type argon2Params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+// isLegacyHash reports whether the hash is a bare SHA-256 hex digest
+func isLegacyHash(storedHash string) bool {
+	return len(storedHash) == sha256.Size*2 && !strings.HasPrefix(storedHash, "$")
+}
+
+// decodeArgon2id parses an Argon2id PHC string
+func decodeArgon2id(encoded string) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	if params.memory == 0 || params.memory > maxArgon2Memory || params.time == 0 || params.threads == 0 {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(hash) == 0 {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	return params, salt, hash, nil
+}
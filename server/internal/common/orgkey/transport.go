@@ -0,0 +1,80 @@
+package orgkey
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// Header is the HTTP header carrying the organization key
+	Header = "X-Org-Key"
+	// NewHeader is the HTTP header carrying the replacement key of a key rotation
+	NewHeader = "X-New-Org-Key"
+
+	// MetadataKey is the gRPC metadata key carrying the organization key
+	MetadataKey = "x-org-key"
+	// NewMetadataKey is the gRPC metadata key carrying the replacement key of a key rotation
+	NewMetadataKey = "x-new-org-key"
+)
+
+// The request fields that metadata keys fill in
+const (
+	keyField    protoreflect.Name = "organization_key"
+	newKeyField protoreflect.Name = "new_organization_key"
+)
+
+// Fill sets the organization key fields of a request that were left empty from the
+// incoming gRPC metadata. A message without the fields, such as a stream message
+// wrapping a request, has the request it wraps filled instead.
+func Fill(ctx context.Context, msg any) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	key, newKey := first(md.Get(MetadataKey)), first(md.Get(NewMetadataKey))
+	if key == "" && newKey == "" {
+		return
+	}
+
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	fill(m.ProtoReflect(), key, newKey, true)
+}
+
+func fill(m protoreflect.Message, key, newKey string, descend bool) {
+	fields := m.Descriptor().Fields()
+	if fd := fields.ByName(keyField); fd != nil {
+		setIfEmpty(m, fd, key)
+		setIfEmpty(m, fields.ByName(newKeyField), newKey)
+		return
+	}
+	if !descend {
+		return
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && fd.Cardinality() != protoreflect.Repeated && m.Has(fd) {
+			fill(m.Mutable(fd).Message(), key, newKey, false)
+		}
+	}
+}
+
+func setIfEmpty(m protoreflect.Message, fd protoreflect.FieldDescriptor, value string) {
+	if fd == nil || fd.Kind() != protoreflect.StringKind || value == "" || m.Get(fd).String() != "" {
+		return
+	}
+	m.Set(fd, protoreflect.ValueOfString(value))
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
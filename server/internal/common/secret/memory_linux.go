@@ -0,0 +1,23 @@
+//go:build linux
+
+package secret
+
+import "golang.org/x/sys/unix"
+
+// alloc maps anonymous memory outside the Go heap, so the garbage collector never
+// copies it, locks it into RAM and keeps it out of core dumps. A failed lock is
+// reported rather than returned; the memory is still wiped when released.
+func alloc(size int) ([]byte, bool, error) {
+	mem, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return nil, false, err
+	}
+	_ = unix.Madvise(mem, unix.MADV_DONTDUMP)
+	return mem, unix.Mlock(mem) == nil, nil
+}
+
+// free unlocks and unmaps memory returned by alloc
+func free(mem []byte) {
+	_ = unix.Munlock(mem)
+	_ = unix.Munmap(mem)
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package secret
+
+// alloc falls back to the Go heap on platforms without mlock support in this package.
+// The memory is still wiped when released, but it is not locked.
+func alloc(size int) ([]byte, bool, error) {
+	return make([]byte, size), false, nil
+}
+
+// free leaves heap memory to the garbage collector
+func free(mem []byte) {}
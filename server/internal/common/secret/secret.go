@@ -0,0 +1,125 @@
+// Package secret holds key material and plaintext PII in memory the garbage collector
+// never sees. A Buffer's bytes are allocated outside the Go heap, locked into RAM so
+// they are not swapped out, excluded from core dumps where the platform allows it, and
+// overwritten with zeros when the buffer is destroyed.
+//
+// Buffers redact themselves when formatted, so a Buffer passed to a logger or an
+// error prints as [REDACTED] instead of its contents.
+package secret
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"runtime"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+// Buffer is a fixed-size region of locked memory. The zero value and nil are empty
+// buffers; Destroy must be called once the contents are no longer needed.
+type Buffer struct {
+	mem     []byte // The whole allocation, which may be larger than the buffer
+	size    int
+	cleanup runtime.Cleanup
+}
+
+var lockWarning sync.Once
+
+// New returns a zeroed buffer of size bytes
+func New(size int) (*Buffer, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("invalid secret buffer size: %d", size)
+	}
+
+	mem, locked, err := alloc(max(size, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate secret buffer: %w", err)
+	}
+	if !locked {
+		lockWarning.Do(func() {
+			log.Printf("⚠️  [secret] Could not lock secret buffers into memory; raise RLIMIT_MEMLOCK to keep them out of swap")
+		})
+	}
+
+	b := &Buffer{mem: mem, size: size}
+	// A buffer that is dropped without Destroy is still wiped once it is collected
+	b.cleanup = runtime.AddCleanup(b, release, mem)
+	return b, nil
+}
+
+// FromBytes moves src into a new buffer, overwriting src with zeros
+func FromBytes(src []byte) (*Buffer, error) {
+	defer clear(src)
+
+	b, err := New(len(src))
+	if err != nil {
+		return nil, err
+	}
+	copy(b.Bytes(), src)
+	return b, nil
+}
+
+// FromString copies s into a new buffer. Go strings are immutable, so s itself
+// cannot be wiped; callers should drop it as soon as possible.
+func FromString(s string) (*Buffer, error) {
+	b, err := New(len(s))
+	if err != nil {
+		return nil, err
+	}
+	copy(b.Bytes(), s)
+	return b, nil
+}
+
+// Bytes returns the buffer's contents. The slice aliases the locked memory and must
+// not be used after Destroy.
+func (b *Buffer) Bytes() []byte {
+	if b == nil || b.mem == nil {
+		return nil
+	}
+	return b.mem[:b.size:b.size]
+}
+
+// Len returns the size of the buffer in bytes
+func (b *Buffer) Len() int {
+	if b == nil || b.mem == nil {
+		return 0
+	}
+	return b.size
+}
+
+// Destroy overwrites the buffer with zeros and releases its memory. It is safe to call
+// on a nil buffer and more than once.
+func (b *Buffer) Destroy() {
+	if b == nil || b.mem == nil {
+		return
+	}
+	b.cleanup.Stop()
+	release(b.mem)
+	b.mem = nil
+}
+
+// String returns a placeholder instead of the buffer's contents
+func (b Buffer) String() string {
+	return redacted
+}
+
+// GoString returns a placeholder instead of the buffer's contents, for %#v
+func (b Buffer) GoString() string {
+	return redacted
+}
+
+// Format prints a placeholder for every verb, so %x, %s and %v never reveal the contents
+func (b Buffer) Format(f fmt.State, verb rune) {
+	io.WriteString(f, redacted)
+}
+
+// freeMemory frees a wiped allocation; tests replace it to inspect the wiped bytes
+var freeMemory = free
+
+// release wipes and frees an allocation
+func release(mem []byte) {
+	clear(mem)
+	freeMemory(mem)
+}
@@ -0,0 +1,136 @@
+package secret
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// freedMemory replaces freeMemory for the test, returning copies of every allocation
+// taken at the moment it was freed, after the wipe
+func freedMemory(t *testing.T) *[][]byte {
+	t.Helper()
+	var freed [][]byte
+	freeMemory = func(mem []byte) {
+		freed = append(freed, bytes.Clone(mem))
+		free(mem)
+	}
+	t.Cleanup(func() { freeMemory = free })
+	return &freed
+}
+
+func TestDestroyZeroesMemory(t *testing.T) {
+	freed := freedMemory(t)
+
+	b, err := FromString("4111 1111 1111 1111")
+	if err != nil {
+		t.Fatalf("FromString: %v", err)
+	}
+	if got := string(b.Bytes()); got != "4111 1111 1111 1111" {
+		t.Fatalf("Bytes = %q, want the copied string", got)
+	}
+
+	b.Destroy()
+
+	if len(*freed) != 1 {
+		t.Fatalf("Destroy freed %d allocations, want 1", len(*freed))
+	}
+	mem := (*freed)[0]
+	if len(mem) < len("4111 1111 1111 1111") {
+		t.Fatalf("freed allocation has %d bytes, want at least the buffer size", len(mem))
+	}
+	if !bytes.Equal(mem, make([]byte, len(mem))) {
+		t.Errorf("memory was not zeroed before it was freed: %x", mem)
+	}
+	if b.Bytes() != nil || b.Len() != 0 {
+		t.Errorf("destroyed buffer still has contents: Len = %d", b.Len())
+	}
+
+	// Destroying again, or a nil buffer, does nothing
+	b.Destroy()
+	var nilBuffer *Buffer
+	nilBuffer.Destroy()
+	if len(*freed) != 1 {
+		t.Errorf("repeated Destroy freed %d allocations, want 1", len(*freed))
+	}
+}
+
+func TestFromBytesWipesSource(t *testing.T) {
+	src := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	b, err := FromBytes(src)
+	if err != nil {
+		t.Fatalf("FromBytes: %v", err)
+	}
+	defer b.Destroy()
+
+	if !bytes.Equal(b.Bytes(), []byte{0xde, 0xad, 0xbe, 0xef}) {
+		t.Errorf("Bytes = %x, want deadbeef", b.Bytes())
+	}
+	if !bytes.Equal(src, make([]byte, len(src))) {
+		t.Errorf("source was not zeroed: %x", src)
+	}
+}
+
+func TestEmptyBuffers(t *testing.T) {
+	b, err := New(0)
+	if err != nil {
+		t.Fatalf("New(0): %v", err)
+	}
+	if b.Len() != 0 || len(b.Bytes()) != 0 {
+		t.Errorf("empty buffer has Len %d and %d bytes", b.Len(), len(b.Bytes()))
+	}
+	b.Destroy()
+
+	var nilBuffer *Buffer
+	if nilBuffer.Len() != 0 || nilBuffer.Bytes() != nil {
+		t.Error("nil buffer is not empty")
+	}
+
+	if _, err := New(-1); err == nil {
+		t.Error("New accepted a negative size")
+	}
+}
+
+func TestFormatRedacts(t *testing.T) {
+	const plaintext = "hunter2-secret"
+
+	b, err := FromString(plaintext)
+	if err != nil {
+		t.Fatalf("FromString: %v", err)
+	}
+	defer b.Destroy()
+
+	// The contents in every form a format verb could print them
+	forms := []string{plaintext, fmt.Sprintf("%x", plaintext), fmt.Sprintf("%X", plaintext), fmt.Sprintf("% x", plaintext), fmt.Sprint([]byte(plaintext))}
+
+	wrapped := struct{ Key Buffer }{Key: *b}
+	formats := []string{"%v", "%+v", "%s", "%q", "%x", "%X", "% x", "%d", "%#v"}
+	for _, format := range formats {
+		for name, arg := range map[string]any{"*Buffer": b, "Buffer": *b, "struct field": wrapped} {
+			got := fmt.Sprintf(format, arg)
+			if !strings.Contains(got, redacted) {
+				t.Errorf("Sprintf(%q) of a %s = %q, want %s", format, name, got, redacted)
+			}
+			for _, form := range forms {
+				if strings.Contains(got, form) {
+					t.Errorf("Sprintf(%q) of a %s = %q reveals the contents", format, name, got)
+				}
+			}
+		}
+	}
+
+	for name, got := range map[string]string{
+		"String":   b.String(),
+		"GoString": b.GoString(),
+		"Sprint":   fmt.Sprint(b),
+		"Sprintln": fmt.Sprintln(b),
+		"error":    fmt.Errorf("bad key %v: %w", b, errors.New("wrapped")).Error(),
+	} {
+		if !strings.Contains(got, redacted) || strings.Contains(got, plaintext) {
+			t.Errorf("%s = %q, want %s", name, got, redacted)
+		}
+	}
+}
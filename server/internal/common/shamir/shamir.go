@@ -0,0 +1,125 @@
+// Package shamir implements Shamir's secret sharing over GF(2^8), used to split the
+// KEK between custodians so that no single person can reconstruct it. A share is its
+// x coordinate in the first byte followed by one y coordinate per byte of the secret.
+package shamir
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// MaxShares is the largest number of shares a secret can be split into
+const MaxShares = 255
+
+// expTable and logTable hold powers and logarithms of the generator 3 in GF(2^8)
+// with the AES polynomial x^8 + x^4 + x^3 + x + 1
+var expTable, logTable = func() ([255]byte, [256]byte) {
+	var exp [255]byte
+	var log [256]byte
+	x := byte(1)
+	for i := range exp {
+		exp[i] = x
+		log[x] = byte(i)
+		// Multiply by 3: x*2 (reduced by the polynomial) + x
+		doubled := x << 1
+		if x&0x80 != 0 {
+			doubled ^= 0x1b
+		}
+		x ^= doubled
+	}
+	return exp, log
+}()
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+func div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])-int(logTable[b])+255)%255]
+}
+
+// Split divides secret into parts shares, any threshold of which reconstruct it.
+// Fewer than threshold shares reveal nothing about the secret.
+func Split(secret []byte, parts, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret is empty")
+	}
+	if threshold < 2 || threshold > parts || parts > MaxShares {
+		return nil, fmt.Errorf("need 2 <= threshold <= shares <= %d, got threshold %d of %d shares", MaxShares, threshold, parts)
+	}
+
+	shares := make([][]byte, parts)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][0] = byte(i + 1)
+	}
+
+	// One random polynomial of degree threshold-1 per secret byte, with the byte as
+	// its constant term
+	coefficients := make([]byte, threshold)
+	defer clear(coefficients)
+	for b, value := range secret {
+		coefficients[0] = value
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, fmt.Errorf("failed to generate coefficients: %w", err)
+		}
+
+		for _, share := range shares {
+			x := share[0]
+			// Horner's method, highest coefficient first
+			var y byte
+			for i := threshold - 1; i >= 0; i-- {
+				y = mul(y, x) ^ coefficients[i]
+			}
+			share[b+1] = y
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine reconstructs a secret from shares. It cannot tell whether enough shares
+// were given; with fewer than the threshold the result is simply wrong, so callers
+// must check it.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("at least 2 shares are required")
+	}
+
+	size := len(shares[0])
+	if size < 2 {
+		return nil, fmt.Errorf("share too short")
+	}
+	seen := make(map[byte]bool, len(shares))
+	for _, share := range shares {
+		if len(share) != size {
+			return nil, fmt.Errorf("shares have different lengths")
+		}
+		if share[0] == 0 || seen[share[0]] {
+			return nil, fmt.Errorf("shares must have distinct, non-zero indexes")
+		}
+		seen[share[0]] = true
+	}
+
+	// Lagrange interpolation at x = 0; subtraction in GF(2^8) is XOR
+	secret := make([]byte, size-1)
+	for i, share := range shares {
+		basis := byte(1)
+		for j, other := range shares {
+			if i != j {
+				basis = mul(basis, div(other[0], other[0]^share[0]))
+			}
+		}
+		for b := range secret {
+			secret[b] ^= mul(share[b+1], basis)
+		}
+	}
+
+	return secret, nil
+}
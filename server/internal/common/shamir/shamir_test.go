@@ -0,0 +1,142 @@
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestFieldArithmetic(t *testing.T) {
+	// Multiplications worked through in FIPS 197, section 4.2
+	if got := mul(0x57, 0x83); got != 0xc1 {
+		t.Errorf("0x57 * 0x83 = %#x, want 0xc1", got)
+	}
+	if got := mul(0x57, 0x13); got != 0xfe {
+		t.Errorf("0x57 * 0x13 = %#x, want 0xfe", got)
+	}
+
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			if got := div(mul(byte(a), byte(b)), byte(b)); got != byte(a) {
+				t.Fatalf("(%#x * %#x) / %#x = %#x", a, b, b, got)
+			}
+		}
+	}
+}
+
+func TestCombineKnownShares(t *testing.T) {
+	// f(x) = 0x42 + x + x^2 over GF(2^8): f(1) = 0x42, f(2) = 0x44, f(3) = 0x44
+	shares := [][]byte{{1, 0x42}, {2, 0x44}, {3, 0x44}}
+
+	secret, err := Combine(shares)
+	if err != nil {
+		t.Fatalf("Combine: %v", err)
+	}
+	if !bytes.Equal(secret, []byte{0x42}) {
+		t.Errorf("Combine = %x, want 42", secret)
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct{ parts, threshold int }{{2, 2}, {3, 2}, {5, 3}, {7, 7}, {MaxShares, 4}} {
+		shares, err := Split(secret, c.parts, c.threshold)
+		if err != nil {
+			t.Fatalf("Split(%d of %d): %v", c.threshold, c.parts, err)
+		}
+		if len(shares) != c.parts {
+			t.Fatalf("Split(%d of %d) returned %d shares", c.threshold, c.parts, len(shares))
+		}
+
+		// Every window of threshold consecutive shares, in both orders, reconstructs it
+		for start := 0; start+c.threshold <= c.parts; start++ {
+			subset := shares[start : start+c.threshold]
+			reversed := make([][]byte, len(subset))
+			for i := range subset {
+				reversed[len(subset)-1-i] = subset[i]
+			}
+
+			for _, s := range [][][]byte{subset, reversed} {
+				got, err := Combine(s)
+				if err != nil {
+					t.Fatalf("Combine(%d of %d): %v", c.threshold, c.parts, err)
+				}
+				if !bytes.Equal(got, secret) {
+					t.Fatalf("Combine of shares %d-%d (%d of %d) did not reconstruct the secret", start+1, start+c.threshold, c.threshold, c.parts)
+				}
+			}
+		}
+
+		// More shares than the threshold work too
+		if got, err := Combine(shares); err != nil || !bytes.Equal(got, secret) {
+			t.Fatalf("Combine of all %d shares did not reconstruct the secret: %v", c.parts, err)
+		}
+
+		// One share short of the threshold gives a wrong secret
+		if c.threshold > 2 {
+			got, err := Combine(shares[:c.threshold-1])
+			if err != nil {
+				t.Fatalf("Combine below the threshold: %v", err)
+			}
+			if bytes.Equal(got, secret) {
+				t.Fatalf("Combine of %d shares reconstructed a %d-of-%d secret", c.threshold-1, c.threshold, c.parts)
+			}
+		}
+	}
+}
+
+func TestSplitIsRandomized(t *testing.T) {
+	secret := []byte("the same secret")
+	first, err := Split(secret, 3, 2)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	second, err := Split(secret, 3, 2)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if bytes.Equal(first[0], second[0]) {
+		t.Error("splitting the same secret twice gave the same shares")
+	}
+	for _, share := range first {
+		if bytes.Contains(share, secret) {
+			t.Error("a share contains the secret")
+		}
+	}
+}
+
+func TestInvalidArguments(t *testing.T) {
+	secret := []byte("secret")
+
+	for _, c := range []struct{ parts, threshold int }{{3, 1}, {2, 3}, {MaxShares + 1, 2}} {
+		if _, err := Split(secret, c.parts, c.threshold); err == nil {
+			t.Errorf("Split accepted %d of %d shares", c.threshold, c.parts)
+		}
+	}
+	if _, err := Split(nil, 3, 2); err == nil {
+		t.Error("Split accepted an empty secret")
+	}
+
+	shares, err := Split(secret, 3, 2)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if _, err := Combine(shares[:1]); err == nil {
+		t.Error("Combine accepted a single share")
+	}
+	if _, err := Combine([][]byte{shares[0], shares[0]}); err == nil {
+		t.Error("Combine accepted a repeated share")
+	}
+	if _, err := Combine([][]byte{shares[0], shares[1][:len(shares[1])-1]}); err == nil {
+		t.Error("Combine accepted shares of different lengths")
+	}
+	zero := bytes.Clone(shares[1])
+	zero[0] = 0
+	if _, err := Combine([][]byte{shares[0], zero}); err == nil {
+		t.Error("Combine accepted a share with index 0")
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Errors returned by TEK lookups. They are carried between services as gRPC
+// status codes and translated back with TEKError.
+var (
+	// ErrTEKNotFound indicates the organization has no TEK provisioned yet
+	ErrTEKNotFound = errors.New("TEK not found for organization")
+	// ErrOrganizationKeyMismatch indicates the organization exists but the supplied key is wrong
+	ErrOrganizationKeyMismatch = errors.New("organization key verification failed")
+	// ErrOrganizationSuspended indicates the organization exists but has been suspended
+	ErrOrganizationSuspended = errors.New("organization is suspended")
+	// ErrOrganizationLocked indicates key verification is temporarily blocked after too many failures
+	ErrOrganizationLocked = errors.New("too many failed organization key attempts")
+)
+
+// LockedError reports a brute-force lockout and how long it has left to run.
+// It matches ErrOrganizationLocked with errors.Is.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrOrganizationLocked, e.RetryAfter.Round(time.Second))
+}
+
+// Is reports whether target is ErrOrganizationLocked
+func (e *LockedError) Is(target error) bool {
+	return target == ErrOrganizationLocked
+}
+
+// LockedStatus converts a lockout into a ResourceExhausted status carrying the retry delay
+func LockedStatus(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, ErrOrganizationLocked.Error())
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// RetryAfter returns the retry delay carried by a ResourceExhausted status, if any
+func RetryAfter(err error) time.Duration {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return info.RetryDelay.AsDuration()
+		}
+	}
+	return 0
+}
+
+// TEKErrorStatus converts a TEK lookup error into the gRPC status returned to callers
+func TEKErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrTEKNotFound):
+		return status.Error(codes.NotFound, ErrTEKNotFound.Error())
+	case errors.Is(err, ErrOrganizationKeyMismatch):
+		return status.Error(codes.Unauthenticated, ErrOrganizationKeyMismatch.Error())
+	case errors.Is(err, ErrOrganizationSuspended):
+		return status.Error(codes.FailedPrecondition, ErrOrganizationSuspended.Error())
+	case errors.Is(err, ErrOrganizationLocked):
+		var locked *LockedError
+		if errors.As(err, &locked) {
+			return LockedStatus(locked.RetryAfter)
+		}
+		return LockedStatus(0)
+	default:
+		return status.Errorf(codes.Internal, "failed to load TEK: %v", err)
+	}
+}
+
+// TEKError converts a gRPC status returned by a TEK lookup back into the typed errors above.
+// Errors that are not TEK lookup failures are returned unchanged.
+func TEKError(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return ErrTEKNotFound
+	case codes.Unauthenticated:
+		return ErrOrganizationKeyMismatch
+	case codes.FailedPrecondition:
+		return ErrOrganizationSuspended
+	case codes.ResourceExhausted:
+		return &LockedError{RetryAfter: RetryAfter(err)}
+	default:
+		return err
+	}
+}
@@ -12,16 +12,59 @@ import (
 type PIIServiceInterface interface {
 	Tokenize(ctx context.Context, req *pbPII.TokenizeRequest) (*pbPII.TokenizeResponse, error)
 	Detokenize(ctx context.Context, req *pbPII.DetokenizeRequest) (*pbPII.DetokenizeResponse, error)
+	TokenizeBatch(ctx context.Context, req *pbPII.TokenizeBatchRequest) (*pbPII.TokenizeBatchResponse, error)
+	DetokenizeBatch(ctx context.Context, req *pbPII.DetokenizeBatchRequest) (*pbPII.DetokenizeBatchResponse, error)
+	LookupToken(ctx context.Context, req *pbPII.LookupTokenRequest) (*pbPII.LookupTokenResponse, error)
 	HealthCheck(ctx context.Context, req *pbPII.HealthCheckRequest) (*pbPII.HealthCheckResponse, error)
+	CreateOrganization(ctx context.Context, req *pbPII.CreateOrganizationRequest) (*pbPII.CreateOrganizationResponse, error)
+	GetOrganization(ctx context.Context, req *pbPII.GetOrganizationRequest) (*pbPII.GetOrganizationResponse, error)
+	ListOrganizations(ctx context.Context, req *pbPII.ListOrganizationsRequest) (*pbPII.ListOrganizationsResponse, error)
+	SuspendOrganization(ctx context.Context, req *pbPII.SuspendOrganizationRequest) (*pbPII.SuspendOrganizationResponse, error)
+	ReactivateOrganization(ctx context.Context, req *pbPII.ReactivateOrganizationRequest) (*pbPII.ReactivateOrganizationResponse, error)
+	UnlockOrganization(ctx context.Context, req *pbPII.UnlockOrganizationRequest) (*pbPII.UnlockOrganizationResponse, error)
+	SetOrganizationCipherSuite(ctx context.Context, req *pbPII.SetOrganizationCipherSuiteRequest) (*pbPII.SetOrganizationCipherSuiteResponse, error)
+	SetDeterministicDataTypes(ctx context.Context, req *pbPII.SetDeterministicDataTypesRequest) (*pbPII.SetDeterministicDataTypesResponse, error)
+	ShredOrganization(ctx context.Context, req *pbPII.ShredOrganizationRequest) (*pbPII.ShredOrganizationResponse, error)
+	RotateTEK(ctx context.Context, req *pbPII.RotateTEKRequest) (*pbPII.RotateTEKResponse, error)
+	RotateOrganizationKey(ctx context.Context, req *pbPII.RotateOrganizationKeyRequest) (*pbPII.RotateOrganizationKeyResponse, error)
+	GetOrganizationKeyRotation(ctx context.Context, req *pbPII.GetOrganizationKeyRotationRequest) (*pbPII.GetOrganizationKeyRotationResponse, error)
+	RewrapTEKs(ctx context.Context, req *pbPII.RewrapTEKsRequest) (*pbPII.RewrapTEKsResponse, error)
+	GetKEKStatus(ctx context.Context, req *pbPII.GetKEKStatusRequest) (*pbPII.GetKEKStatusResponse, error)
+}
+
+// PIIStreamingInterface opens PII streams. Only the gRPC client implements it: a local
+// PII service in monolithic mode has no streams to open.
+type PIIStreamingInterface interface {
+	TokenizeStream(ctx context.Context) (pbPII.PIIService_TokenizeStreamClient, error)
+	DetokenizeStream(ctx context.Context) (pbPII.PIIService_DetokenizeStreamClient, error)
 }
 
 // PersistenceServiceInterface defines the contract for persistence operations
 type PersistenceServiceInterface interface {
 	StorePIIToken(ctx context.Context, req *pbPersistence.StorePIITokenRequest) (*pbPersistence.StorePIITokenResponse, error)
 	RetrievePIIToken(ctx context.Context, req *pbPersistence.RetrievePIITokenRequest) (*pbPersistence.RetrievePIITokenResponse, error)
+	LookupPIITokens(ctx context.Context, req *pbPersistence.LookupPIITokensRequest) (*pbPersistence.LookupPIITokensResponse, error)
 	StoreTEK(ctx context.Context, req *pbPersistence.StoreTEKRequest) (*pbPersistence.StoreTEKResponse, error)
 	RetrieveTEK(ctx context.Context, req *pbPersistence.RetrieveTEKRequest) (*pbPersistence.RetrieveTEKResponse, error)
 	HealthCheck(ctx context.Context, req *pbPersistence.HealthCheckRequest) (*pbPersistence.HealthCheckResponse, error)
+	CreateOrganization(ctx context.Context, req *pbPersistence.CreateOrganizationRequest) (*pbPersistence.CreateOrganizationResponse, error)
+	GetOrganization(ctx context.Context, req *pbPersistence.GetOrganizationRequest) (*pbPersistence.GetOrganizationResponse, error)
+	ListOrganizations(ctx context.Context, req *pbPersistence.ListOrganizationsRequest) (*pbPersistence.ListOrganizationsResponse, error)
+	SuspendOrganization(ctx context.Context, req *pbPersistence.SuspendOrganizationRequest) (*pbPersistence.SuspendOrganizationResponse, error)
+	ReactivateOrganization(ctx context.Context, req *pbPersistence.ReactivateOrganizationRequest) (*pbPersistence.ReactivateOrganizationResponse, error)
+	UnlockOrganization(ctx context.Context, req *pbPersistence.UnlockOrganizationRequest) (*pbPersistence.UnlockOrganizationResponse, error)
+	SetOrganizationCipherSuite(ctx context.Context, req *pbPersistence.SetOrganizationCipherSuiteRequest) (*pbPersistence.SetOrganizationCipherSuiteResponse, error)
+	SetDeterministicDataTypes(ctx context.Context, req *pbPersistence.SetDeterministicDataTypesRequest) (*pbPersistence.SetDeterministicDataTypesResponse, error)
+	ShredOrganization(ctx context.Context, req *pbPersistence.ShredOrganizationRequest) (*pbPersistence.ShredOrganizationResponse, error)
+	RotateTEK(ctx context.Context, req *pbPersistence.RotateTEKRequest) (*pbPersistence.RotateTEKResponse, error)
+	RotateOrganizationKey(ctx context.Context, req *pbPersistence.RotateOrganizationKeyRequest) (*pbPersistence.RotateOrganizationKeyResponse, error)
+	GetOrganizationKeyRotation(ctx context.Context, req *pbPersistence.GetOrganizationKeyRotationRequest) (*pbPersistence.GetOrganizationKeyRotationResponse, error)
+	RewrapTEKs(ctx context.Context, req *pbPersistence.RewrapTEKsRequest) (*pbPersistence.RewrapTEKsResponse, error)
+	GetKEKStatus(ctx context.Context, req *pbPersistence.GetKEKStatusRequest) (*pbPersistence.GetKEKStatusResponse, error)
+	CreateAPIKey(ctx context.Context, req *pbPersistence.CreateAPIKeyRequest) (*pbPersistence.CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, req *pbPersistence.ListAPIKeysRequest) (*pbPersistence.ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, req *pbPersistence.RevokeAPIKeyRequest) (*pbPersistence.RevokeAPIKeyResponse, error)
+	VerifyAPIKey(ctx context.Context, req *pbPersistence.VerifyAPIKeyRequest) (*pbPersistence.VerifyAPIKeyResponse, error)
 }
 
 // AuditServiceInterface defines the contract for audit operations
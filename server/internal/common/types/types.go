@@ -4,50 +4,139 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"strings"
 	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
 )
 
-// KEKProvider defines the interface for KEK management
+// KEKProvider defines the interface for KEK management. Providers wrap and unwrap TEKs
+// themselves so that a KEK held by an external KMS never has to leave it. A provider
+// may know several KEKs: the current one wraps new TEKs and retired ones are kept to
+// unwrap TEKs that have not been rewrapped yet.
 type KEKProvider interface {
-	GetKEK() ([]byte, error)
+	WrapTEK(tek []byte) ([]byte, error)            // Wraps with the current KEK
+	UnwrapTEK(encryptedTEK []byte) ([]byte, error) // Unwraps with whichever KEK wrapped the TEK
+	CurrentKEKID() string                          // ID of the current KEK
+	WrappedKEKID(encryptedTEK []byte) string       // ID of the KEK that wrapped a TEK, "" if it is not recorded
+	KEKIDs() []string                              // All KEK IDs the provider can unwrap with, current first
 	Close() error
 }
 
-// StaticKEKProvider implements KEKProvider using a static base64-encoded KEK
+// DefaultKEKID is the ID of KEK_BASE64 when KEK_ID is not set
+const DefaultKEKID = "default"
+
+// StaticKEKProvider implements KEKProvider using static base64-encoded KEKs
 type StaticKEKProvider struct {
-	kek []byte
+	currentID string
+	keks      map[string][]byte
+	ids       []string
 }
 
-// NewStaticKEKProvider creates a new static KEK provider from base64-encoded KEK
-func NewStaticKEKProvider(kekBase64 string) (*StaticKEKProvider, error) {
+// NewStaticKEKProvider creates a new static KEK provider. kekBase64 is the current KEK
+// and kekID its ID. retiredKEKs lists KEKs that are only used for unwrapping as
+// comma-separated id=base64 pairs.
+func NewStaticKEKProvider(kekID, kekBase64, retiredKEKs string) (*StaticKEKProvider, error) {
 	if kekBase64 == "" {
 		return nil, fmt.Errorf("KEK_BASE64 environment variable is required")
 	}
+	if kekID == "" {
+		kekID = DefaultKEKID
+	}
+
+	p := &StaticKEKProvider{
+		currentID: kekID,
+		keks:      make(map[string][]byte),
+	}
+	if err := p.add(kekID, kekBase64); err != nil {
+		return nil, fmt.Errorf("invalid KEK_BASE64: %w", err)
+	}
+
+	for _, entry := range strings.Split(retiredKEKs, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		// Only the first '=' separates the ID, base64 padding stays in the value
+		id, kekBase64, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid KEK_RING entry %q: expected id=base64", entry)
+		}
+		if err := p.add(strings.TrimSpace(id), strings.TrimSpace(kekBase64)); err != nil {
+			return nil, fmt.Errorf("invalid KEK_RING entry %q: %w", id, err)
+		}
+	}
+
+	log.Printf("✅ [KEKProvider] Static KEK ring loaded from environment (current: %s, retired: %d)", kekID, len(p.ids)-1)
+
+	return p, nil
+}
+
+// add decodes a KEK and adds it to the ring
+func (p *StaticKEKProvider) add(id, kekBase64 string) error {
+	if id == "" || len(id) > 255 {
+		return fmt.Errorf("KEK ID must be 1 to 255 bytes")
+	}
+	if _, exists := p.keks[id]; exists {
+		return fmt.Errorf("duplicate KEK ID %q", id)
+	}
 
 	kek, err := base64.StdEncoding.DecodeString(kekBase64)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode KEK_BASE64: %w", err)
+		return fmt.Errorf("failed to decode KEK: %w", err)
 	}
-
 	if len(kek) != 32 {
-		return nil, fmt.Errorf("KEK must be 32 bytes (256 bits), got %d bytes", len(kek))
+		return fmt.Errorf("KEK must be 32 bytes (256 bits), got %d bytes", len(kek))
 	}
 
-	log.Printf("✅ [KEKProvider] Static KEK loaded from environment (length: %d bytes)", len(kek))
-
-	return &StaticKEKProvider{
-		kek: kek,
-	}, nil
+	p.keks[id] = kek
+	p.ids = append(p.ids, id)
+	return nil
 }
 
-// GetKEK returns the static KEK
+// GetKEK returns the current KEK
 func (p *StaticKEKProvider) GetKEK() ([]byte, error) {
+	return p.GetKEKByID(p.currentID)
+}
+
+// CurrentKEKID returns the ID of the current KEK
+func (p *StaticKEKProvider) CurrentKEKID() string {
+	return p.currentID
+}
+
+// GetKEKByID returns a KEK from the ring
+func (p *StaticKEKProvider) GetKEKByID(id string) ([]byte, error) {
+	kek, ok := p.keks[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown KEK ID %q", id)
+	}
+
 	// Return a copy to prevent external modification
-	kekCopy := make([]byte, len(p.kek))
-	copy(kekCopy, p.kek)
+	kekCopy := make([]byte, len(kek))
+	copy(kekCopy, kek)
 	return kekCopy, nil
 }
 
+// KEKIDs returns the IDs of all KEKs in the ring, current first
+func (p *StaticKEKProvider) KEKIDs() []string {
+	return append([]string(nil), p.ids...)
+}
+
+// WrapTEK wraps a TEK with the current KEK
+func (p *StaticKEKProvider) WrapTEK(tek []byte) ([]byte, error) {
+	return envelope.WrapTEKWithRing(p, tek)
+}
+
+// UnwrapTEK unwraps a TEK with the KEK named in its header
+func (p *StaticKEKProvider) UnwrapTEK(encryptedTEK []byte) ([]byte, error) {
+	return envelope.UnwrapTEKWithRing(p, encryptedTEK)
+}
+
+// WrappedKEKID returns the KEK ID recorded in a wrapped TEK
+func (p *StaticKEKProvider) WrappedKEKID(encryptedTEK []byte) string {
+	return envelope.WrappedKEKID(encryptedTEK)
+}
+
 // Close is a no-op for static provider
 func (p *StaticKEKProvider) Close() error {
 	return nil
@@ -57,12 +146,30 @@ func (p *StaticKEKProvider) Close() error {
 type OrganizationTEK struct {
 	OrganizationID string
 	EncryptedTEK   []byte // TEK encrypted by KEK
-	OrgKeyHash     string // SHA-256 hash of organization key for verification
+	OrgKeyHash     string // Argon2id PHC hash of organization key (legacy: SHA-256 hex)
 	CreatedAt      time.Time
 	RotatedAt      *time.Time // Nullable - only set when key is rotated
 	Version        int
+	OrgKeyVersion  int // Version of the organization key that OrgKeyHash belongs to
+	// PreviousOrgKeyHash is the hash of the replaced organization key while a key
+	// rotation is in progress, empty otherwise
+	PreviousOrgKeyHash string
+	// RetiringOrgKey is set when the verified key is the one being rotated out. It
+	// may still decrypt existing tokens but must not encrypt new ones.
+	RetiringOrgKey bool
+	// CipherSuite is the organization's cipher suite for new tokens
+	CipherSuite string
+	// DeterministicDataTypes are the data types the organization tokenizes deterministically
+	DeterministicDataTypes []string
 }
 
+// Organization lifecycle statuses
+const (
+	OrganizationStatusActive    = "active"
+	OrganizationStatusSuspended = "suspended"
+	OrganizationStatusShredded  = "shredded" // TEKs destroyed; kept so the ID is never reused
+)
+
 // Message structs (simplified versions of protobuf messages)
 
 type TokenizeRequest struct {
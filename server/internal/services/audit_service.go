@@ -97,7 +97,7 @@ func (s *AuditService) LogAccess(ctx context.Context, req *pb.LogAccessRequest)
 	// Insert audit log into database
 	query := `
 		INSERT INTO audit_logs (audit_id, reference_hash, operation, requesting_service, requesting_user, purpose, timestamp, client_ip, metadata)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, '')::inet, $9)
 	`
 
 	_, err = s.db.ExecContext(ctx, query,
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pb "github.com/PlainFunction/mistokenly/proto/kms"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxKMSKeySize bounds the keys accepted for wrapping; TEKs are 32 bytes
+const maxKMSKeySize = 64
+
+// KMSService owns the KEK and wraps and unwraps TEKs for the other services, so that
+// a compromised PII or persistence pod can ask for unwraps but never read the KEK.
+// Unwraps are rate-limited per calling address and every call is audited.
+type KMSService struct {
+	pb.UnimplementedKMSServiceServer
+	config      *config.Config
+	kekProvider types.KEKProvider
+	sealed      *kek.SealedProvider         // Set in sealed mode; the same provider as kekProvider
+	auditClient types.AuditServiceInterface // Optional; receives wrap and unwrap events
+
+	sealListener func(sealed bool) // Optional; told when the KEK is unsealed
+
+	unwrapMu      sync.Mutex
+	unwrapWindows map[string]*kmsUnwrapWindow
+}
+
+// kmsUnwrapWindow counts the unwraps of one caller in the current window
+type kmsUnwrapWindow struct {
+	startedAt time.Time
+	count     int
+}
+
+// NewKMSService creates a key service backed by the KEK provider selected by KEK_PROVIDER
+func NewKMSService(cfg *config.Config) (*KMSService, error) {
+	if cfg.KEKProvider == kek.ProviderRemote {
+		return nil, fmt.Errorf("the key service cannot use KEK_PROVIDER %q", kek.ProviderRemote)
+	}
+
+	service := &KMSService{
+		config:        cfg,
+		unwrapWindows: make(map[string]*kmsUnwrapWindow),
+	}
+
+	// In sealed mode the KEK is reconstructed from custodians' shares through Unseal
+	if cfg.KEKSealed {
+		if cfg.KEKProvider != "" && cfg.KEKProvider != kek.ProviderStatic {
+			return nil, fmt.Errorf("KEK_SEALED requires KEK_PROVIDER %q", kek.ProviderStatic)
+		}
+		// Anyone who can reach the port could otherwise reset progress or try shares
+		if cfg.AdminAPIKey == "" {
+			return nil, fmt.Errorf("KEK_SEALED requires ADMIN_API_KEY to authenticate unseal requests")
+		}
+		sealed, err := kek.NewSealedProvider(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize sealed KEK provider: %w", err)
+		}
+		service.sealed = sealed
+		service.kekProvider = sealed
+	} else {
+		log.Printf("🔑 [KMS] Initializing %s KEK provider", cfg.KEKProvider)
+		kekProvider, err := kek.NewProvider(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize %s KEK provider: %w", cfg.KEKProvider, err)
+		}
+		log.Printf("✅ [KMS] KEK provider initialized, current KEK: %s", kekProvider.CurrentKEKID())
+		service.kekProvider = kekProvider
+	}
+
+	if cfg.KMSUnwrapLimit > 0 {
+		log.Printf("🛡️ [KMS] Unwraps limited to %d per %s per caller", cfg.KMSUnwrapLimit, cfg.KMSUnwrapWindow)
+	}
+
+	return service, nil
+}
+
+// SetAuditClient sets the audit service client used to record key operations
+func (s *KMSService) SetAuditClient(client types.AuditServiceInterface) {
+	s.auditClient = client
+	if client != nil {
+		log.Printf("✅ [KMS] Audit service client configured")
+	}
+}
+
+// SetSealListener registers a function that is called with the current seal state
+// and again when the KEK is unsealed
+func (s *KMSService) SetSealListener(listener func(sealed bool)) {
+	s.sealListener = listener
+	listener(s.sealed != nil && s.sealed.Status().Sealed)
+}
+
+// Close releases the KEK provider
+func (s *KMSService) Close() error {
+	log.Println("[KMS] Closing KEK provider")
+	return s.kekProvider.Close()
+}
+
+// WrapKey encrypts a TEK with the current KEK
+func (s *KMSService) WrapKey(ctx context.Context, req *pb.WrapKeyRequest) (*pb.WrapKeyResponse, error) {
+	caller := callerAddress(ctx)
+
+	if len(req.Key) == 0 || len(req.Key) > maxKMSKeySize {
+		return nil, status.Errorf(codes.InvalidArgument, "key must be between 1 and %d bytes", maxKMSKeySize)
+	}
+
+	wrapped, err := s.kekProvider.WrapTEK(req.Key)
+	if errors.Is(err, kek.ErrSealed) {
+		return nil, status.Error(codes.Unavailable, "key service is sealed")
+	}
+	if err != nil {
+		log.Printf("❌ [KMS] Failed to wrap key for %s (%s): %v", req.RequestingService, caller, err)
+		s.logKeyEvent("kek_wrap", req.RequestingService, caller, "", "error")
+		return nil, status.Errorf(codes.Internal, "failed to wrap key: %v", err)
+	}
+
+	kekID := s.kekProvider.WrappedKEKID(wrapped)
+	s.logKeyEvent("kek_wrap", req.RequestingService, caller, kekID, "success")
+
+	return &pb.WrapKeyResponse{
+		WrappedKey: wrapped,
+		KekId:      kekID,
+		Status:     "success",
+	}, nil
+}
+
+// UnwrapKey decrypts a TEK with the KEK that wrapped it
+func (s *KMSService) UnwrapKey(ctx context.Context, req *pb.UnwrapKeyRequest) (*pb.UnwrapKeyResponse, error) {
+	caller := callerAddress(ctx)
+
+	if len(req.WrappedKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "wrapped_key is required")
+	}
+
+	if !s.allowUnwrap(caller) {
+		log.Printf("🚫 [KMS] Unwrap rate limit exceeded by %s (%s)", req.RequestingService, caller)
+		s.logKeyEvent("kek_unwrap", req.RequestingService, caller, "", "rate_limited")
+		return nil, status.Errorf(codes.ResourceExhausted, "unwrap rate limit of %d per %s exceeded", s.config.KMSUnwrapLimit, s.config.KMSUnwrapWindow)
+	}
+
+	kekID := s.kekProvider.WrappedKEKID(req.WrappedKey)
+	key, err := s.kekProvider.UnwrapTEK(req.WrappedKey)
+	if errors.Is(err, kek.ErrSealed) {
+		return nil, status.Error(codes.Unavailable, "key service is sealed")
+	}
+	if err != nil {
+		log.Printf("⚠️  [KMS] Failed to unwrap key for %s (%s): %v", req.RequestingService, caller, err)
+		s.logKeyEvent("kek_unwrap", req.RequestingService, caller, kekID, "error")
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to unwrap key: %v", err)
+	}
+
+	s.logKeyEvent("kek_unwrap", req.RequestingService, caller, kekID, "success")
+
+	return &pb.UnwrapKeyResponse{
+		Key:    key,
+		KekId:  kekID,
+		Status: "success",
+	}, nil
+}
+
+// GetKeyInfo reports the current KEK, the KEK ring and the KEKs that wrapped the given TEKs
+func (s *KMSService) GetKeyInfo(ctx context.Context, req *pb.GetKeyInfoRequest) (*pb.GetKeyInfoResponse, error) {
+	provider := s.config.KEKProvider
+	if provider == "" {
+		provider = kek.ProviderStatic
+	}
+
+	response := &pb.GetKeyInfoResponse{
+		Provider:     provider,
+		CurrentKekId: s.kekProvider.CurrentKEKID(),
+		KekIds:       s.kekProvider.KEKIDs(),
+		Status:       "success",
+	}
+	for _, wrapped := range req.WrappedKeys {
+		response.WrappedKekIds = append(response.WrappedKekIds, s.kekProvider.WrappedKEKID(wrapped))
+	}
+
+	return response, nil
+}
+
+// Unseal adds a custodian's share of the KEK, or discards the shares submitted so far
+func (s *KMSService) Unseal(ctx context.Context, req *pb.UnsealRequest) (*pb.UnsealResponse, error) {
+	caller := callerAddress(ctx)
+	log.Printf("[gRPC] Unseal called by %s (%s)", req.Custodian, caller)
+
+	if s.sealed == nil {
+		return nil, status.Error(codes.FailedPrecondition, "key service is not running in sealed mode")
+	}
+	if req.Custodian == "" {
+		return nil, status.Error(codes.InvalidArgument, "custodian is required")
+	}
+	if !s.isAdmin(ctx) {
+		log.Printf("🚫 [KMS] Unseal request from %s (%s) refused: invalid admin key", req.Custodian, caller)
+		s.logUnsealEvent(req.Custodian, caller, "unauthenticated")
+		return nil, status.Error(codes.Unauthenticated, "a valid admin key is required to unseal")
+	}
+
+	if req.Reset_ {
+		sealStatus := s.sealed.ResetUnseal()
+		log.Printf("🔒 [KMS] Unseal progress reset by %s (%s)", req.Custodian, caller)
+		s.logUnsealEvent(req.Custodian, caller, "reset")
+		return &pb.UnsealResponse{SealStatus: toPBSealStatus(sealStatus), Status: "success"}, nil
+	}
+
+	if len(req.Share) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "share is required")
+	}
+
+	sealStatus, err := s.sealed.Unseal(req.Share)
+	if err != nil {
+		log.Printf("⚠️  [KMS] Share from %s (%s) rejected: %v", req.Custodian, caller, err)
+		s.logUnsealEvent(req.Custodian, caller, "rejected")
+		return nil, status.Errorf(codes.InvalidArgument, "share rejected: %v", err)
+	}
+
+	if sealStatus.Sealed {
+		log.Printf("🔑 [KMS] Share from %s accepted (%d/%d)", req.Custodian, sealStatus.Progress, sealStatus.Threshold)
+		s.logUnsealEvent(req.Custodian, caller, "accepted")
+	} else {
+		log.Printf("🔓 [KMS] Unsealed with the share from %s", req.Custodian)
+		s.logUnsealEvent(req.Custodian, caller, "unsealed")
+		if s.sealListener != nil {
+			s.sealListener(false)
+		}
+	}
+
+	return &pb.UnsealResponse{SealStatus: toPBSealStatus(sealStatus), Status: "success"}, nil
+}
+
+// isAdmin reports whether the call carries the admin key in its metadata
+func (s *KMSService) isAdmin(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(kek.AdminKeyMetadata)
+	return len(keys) == 1 && s.config.AdminAPIKey != "" &&
+		subtle.ConstantTimeCompare([]byte(keys[0]), []byte(s.config.AdminAPIKey)) == 1
+}
+
+// GetSealStatus reports whether the KEK is sealed. Services that are not in sealed
+// mode always report unsealed.
+func (s *KMSService) GetSealStatus(ctx context.Context, req *pb.GetSealStatusRequest) (*pb.GetSealStatusResponse, error) {
+	sealStatus := kek.SealStatus{}
+	if s.sealed != nil {
+		sealStatus = s.sealed.Status()
+	}
+	return &pb.GetSealStatusResponse{SealStatus: toPBSealStatus(sealStatus), Status: "success"}, nil
+}
+
+// HealthCheck returns the health status of the key service
+func (s *KMSService) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
+	response := &pb.HealthCheckResponse{
+		Status:      "healthy",
+		ServiceName: "kms-service",
+		Version:     "1.0.0",
+		Timestamp:   timestamppb.Now(),
+		Details: map[string]string{
+			"current_kek_id": s.kekProvider.CurrentKEKID(),
+		},
+	}
+
+	if s.sealed != nil {
+		if sealStatus := s.sealed.Status(); sealStatus.Sealed {
+			response.Status = "sealed"
+			response.Details["unseal_progress"] = fmt.Sprintf("%d/%d", sealStatus.Progress, sealStatus.Threshold)
+		}
+	}
+
+	return response, nil
+}
+
+func toPBSealStatus(sealStatus kek.SealStatus) *pb.SealStatus {
+	return &pb.SealStatus{
+		Sealed:    sealStatus.Sealed,
+		Threshold: int32(sealStatus.Threshold),
+		Progress:  int32(sealStatus.Progress),
+	}
+}
+
+// allowUnwrap counts an unwrap against the caller's fixed window and reports whether
+// it is within the limit
+func (s *KMSService) allowUnwrap(caller string) bool {
+	if s.config.KMSUnwrapLimit <= 0 {
+		return true
+	}
+
+	s.unwrapMu.Lock()
+	defer s.unwrapMu.Unlock()
+
+	now := time.Now()
+	window, ok := s.unwrapWindows[caller]
+	if !ok || now.Sub(window.startedAt) >= s.config.KMSUnwrapWindow {
+		// Drop the windows of callers that have gone quiet while starting a new one
+		for address, w := range s.unwrapWindows {
+			if now.Sub(w.startedAt) >= s.config.KMSUnwrapWindow {
+				delete(s.unwrapWindows, address)
+			}
+		}
+		window = &kmsUnwrapWindow{startedAt: now}
+		s.unwrapWindows[caller] = window
+	}
+
+	window.count++
+	return window.count <= s.config.KMSUnwrapLimit
+}
+
+// logKeyEvent sends a key operation audit event without blocking the request
+func (s *KMSService) logKeyEvent(operation, requestingService, caller, kekID, outcome string) {
+	if s.auditClient == nil {
+		return
+	}
+
+	s.sendAuditEvent(&pbAudit.LogAccessRequest{
+		Operation:         operation,
+		RequestingService: requestingService,
+		Purpose:           "TEK envelope encryption",
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          caller,
+		Metadata: map[string]string{
+			"kek_id":  kekID,
+			"outcome": outcome,
+		},
+	})
+}
+
+// logUnsealEvent records a custodian's unseal request; the share itself is never logged
+func (s *KMSService) logUnsealEvent(custodian, caller, outcome string) {
+	if s.auditClient == nil {
+		return
+	}
+
+	s.sendAuditEvent(&pbAudit.LogAccessRequest{
+		Operation:         "kek_unseal",
+		RequestingService: "kms-service",
+		RequestingUser:    custodian,
+		Purpose:           "KEK split-knowledge unsealing",
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          caller,
+		Metadata: map[string]string{
+			"outcome": outcome,
+		},
+	})
+}
+
+// sendAuditEvent sends an audit event without blocking the request
+func (s *KMSService) sendAuditEvent(req *pbAudit.LogAccessRequest) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := s.auditClient.LogAccess(ctx, req)
+		if err != nil {
+			log.Printf("⚠️  [KMS] Failed to audit %s for %s: %v", req.Operation, req.ClientIp, err)
+			return
+		}
+		if resp.Status != "success" {
+			log.Printf("⚠️  [KMS] Failed to audit %s for %s: %s", req.Operation, req.ClientIp, resp.ErrorMessage)
+		}
+	}()
+}
+
+// callerAddress returns the IP address of the gRPC peer that made the call. Peers
+// without one, such as Unix socket clients, get "", which the audit log stores as NULL.
+func callerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host := lockout.SourceFromRemoteAddr(p.Addr.String())
+	if net.ParseIP(host) == nil {
+		return ""
+	}
+	return host
+}
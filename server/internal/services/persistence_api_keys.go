@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"log"
+	"time"
+
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// apiKeyColumns lists the columns scanned by scanAPIKey
+const apiKeyColumns = `key_id, client_id, description, created_at, expires_at, revoked_at, last_used_at`
+
+// errInvalidAPIKey is returned for every API key that fails verification, whatever the reason
+var errInvalidAPIKey = status.Error(codes.Unauthenticated, "invalid API key")
+
+// CreateAPIKey stores a new API key
+func (s *PersistenceService) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	log.Printf("[gRPC] CreateAPIKey called for client: %s", req.ClientId)
+
+	if req.KeyId == "" || req.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "key_id and client_id are required")
+	}
+	if len(req.KeyHash) != 64 {
+		return nil, status.Error(codes.InvalidArgument, "key_hash must be a hex SHA-256 digest")
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	query := `
+		INSERT INTO api_keys (key_id, client_id, description, key_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (key_id) DO NOTHING
+		RETURNING ` + apiKeyColumns
+
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, query, req.KeyId, req.ClientId, req.Description, req.KeyHash, expiresAt))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.AlreadyExists, "API key %s already exists", req.KeyId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store API key: %v", err)
+	}
+
+	log.Printf("[Persistence] API key %s created for client %s", req.KeyId, req.ClientId)
+
+	return &pb.CreateAPIKeyResponse{
+		ApiKey: key,
+		Status: "success",
+	}, nil
+}
+
+// ListAPIKeys returns API keys ordered by creation time
+func (s *PersistenceService) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	log.Printf("[gRPC] ListAPIKeys called (client filter: %q)", req.ClientId)
+
+	query := `
+		SELECT ` + apiKeyColumns + ` FROM api_keys
+		WHERE ($1 = '' OR client_id = $1) AND ($2 OR revoked_at IS NULL)
+		ORDER BY created_at ASC, key_id ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, req.ClientId, req.IncludeRevoked)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list API keys: %v", err)
+	}
+	defer rows.Close()
+
+	var keys []*pb.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan API key: %v", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list API keys: %v", err)
+	}
+
+	return &pb.ListAPIKeysResponse{
+		ApiKeys: keys,
+		Status:  "success",
+	}, nil
+}
+
+// RevokeAPIKey disables an API key. Revoking a revoked key keeps its original revocation time.
+func (s *PersistenceService) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	log.Printf("[gRPC] RevokeAPIKey called for key: %s", req.KeyId)
+
+	query := `
+		UPDATE api_keys SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE key_id = $1
+		RETURNING ` + apiKeyColumns
+
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, query, req.KeyId))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "API key %s not found", req.KeyId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key: %v", err)
+	}
+
+	log.Printf("[Persistence] API key %s revoked", req.KeyId)
+
+	return &pb.RevokeAPIKeyResponse{
+		ApiKey: key,
+		Status: "success",
+	}, nil
+}
+
+// VerifyAPIKey checks a key hash in constant time and returns the key it belongs to.
+// Unknown, revoked, expired and mismatched keys all fail with the same error.
+func (s *PersistenceService) VerifyAPIKey(ctx context.Context, req *pb.VerifyAPIKeyRequest) (*pb.VerifyAPIKeyResponse, error) {
+	var keyHash string
+	var expiresAt, revokedAt *time.Time
+	err := s.db.QueryRowContext(ctx, `
+		SELECT key_hash, expires_at, revoked_at FROM api_keys WHERE key_id = $1
+	`, req.KeyId).Scan(&keyHash, &expiresAt, &revokedAt)
+	if err == sql.ErrNoRows {
+		return nil, errInvalidAPIKey
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load API key: %v", err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(keyHash), []byte(req.KeyHash)) != 1 {
+		return nil, errInvalidAPIKey
+	}
+	if revokedAt != nil || (expiresAt != nil && !time.Now().Before(*expiresAt)) {
+		return nil, errInvalidAPIKey
+	}
+
+	// Recording every use would write on every request
+	query := `
+		UPDATE api_keys SET last_used_at = NOW()
+		WHERE key_id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+		RETURNING ` + apiKeyColumns
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, query, req.KeyId))
+	if err == sql.ErrNoRows {
+		key, err = scanAPIKey(s.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_id = $1`, req.KeyId))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load API key: %v", err)
+	}
+
+	return &pb.VerifyAPIKeyResponse{
+		ApiKey: key,
+		Status: "success",
+	}, nil
+}
+
+// scanAPIKey scans a row of apiKeyColumns
+func scanAPIKey(row rowScanner) (*pb.APIKey, error) {
+	var key pb.APIKey
+	var createdAt time.Time
+	var expiresAt, revokedAt, lastUsedAt *time.Time
+
+	if err := row.Scan(
+		&key.KeyId,
+		&key.ClientId,
+		&key.Description,
+		&createdAt,
+		&expiresAt,
+		&revokedAt,
+		&lastUsedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	key.CreatedAt = timestamppb.New(createdAt)
+	if expiresAt != nil {
+		key.ExpiresAt = timestamppb.New(*expiresAt)
+	}
+	if revokedAt != nil {
+		key.RevokedAt = timestamppb.New(*revokedAt)
+	}
+	if lastUsedAt != nil {
+		key.LastUsedAt = timestamppb.New(*lastUsedAt)
+	}
+
+	return &key, nil
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// KEK rewrap job statuses
+const (
+	tekRewrapRunning   = "running"
+	tekRewrapCompleted = "completed"
+	tekRewrapFailed    = "failed"
+)
+
+// tekRewrapBatchSize is the number of TEK rows loaded per query by the rewrap job
+const tekRewrapBatchSize = 100
+
+// RewrapTEKs starts rewrapping every stored TEK that is not wrapped with the current KEK.
+// Only the wrapped TEKs change; PII ciphertexts stay as they are because the TEKs
+// themselves do not. The job is idempotent, so running it again after an interruption
+// simply picks up the TEKs that are left.
+func (s *PersistenceService) RewrapTEKs(ctx context.Context, req *pb.RewrapTEKsRequest) (*pb.RewrapTEKsResponse, error) {
+	log.Printf("[gRPC] RewrapTEKs called")
+
+	if s.kekProvider == nil {
+		return nil, status.Error(codes.Unavailable, "rewrapping TEKs requires a KEK provider on the persistence service")
+	}
+
+	references, err := s.countKEKReferences(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count TEKs: %v", err)
+	}
+
+	s.tekRewrapMu.Lock()
+	defer s.tekRewrapMu.Unlock()
+
+	// A job already running on this replica is reported instead of starting another
+	if s.tekRewrapJob != nil && s.tekRewrapJob.Status == tekRewrapRunning {
+		return &pb.RewrapTEKsResponse{
+			Job:    proto.Clone(s.tekRewrapJob).(*pb.KEKRewrapJob),
+			Status: "success",
+		}, nil
+	}
+
+	currentID := s.kekProvider.CurrentKEKID()
+	var remaining int64
+	for kekID, count := range references {
+		if kekID != currentID {
+			remaining += count
+		}
+	}
+
+	s.tekRewrapJob = &pb.KEKRewrapJob{
+		Status:    tekRewrapRunning,
+		TotalTeks: remaining,
+		StartedAt: timestamppb.Now(),
+	}
+
+	log.Printf("🔑 [Persistence] TEK rewrap started: %d TEKs to rewrap under KEK %s", remaining, currentID)
+
+	go s.runTEKRewrap(currentID)
+
+	return &pb.RewrapTEKsResponse{
+		Job:    proto.Clone(s.tekRewrapJob).(*pb.KEKRewrapJob),
+		Status: "success",
+	}, nil
+}
+
+// runTEKRewrap walks organization_teks in key order and rewraps every TEK that is not
+// wrapped with the current KEK
+func (s *PersistenceService) runTEKRewrap(currentID string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	cursorOrg, cursorVersion := "", 0
+	for {
+		batch, err := s.loadTEKRewrapBatch(ctx, cursorOrg, cursorVersion)
+		if err != nil {
+			s.finishTEKRewrap(fmt.Errorf("failed to load TEKs: %w", err))
+			return
+		}
+		if len(batch) == 0 {
+			s.finishTEKRewrap(nil)
+			return
+		}
+
+		var rewrapped, failed int64
+		for _, row := range batch {
+			cursorOrg, cursorVersion = row.organizationID, row.version
+
+			if s.kekProvider.WrappedKEKID(row.encryptedTEK) == currentID {
+				continue
+			}
+
+			ok, err := s.rewrapTEK(ctx, row)
+			if err != nil {
+				if errors.Is(err, context.Canceled) {
+					s.finishTEKRewrap(err)
+					return
+				}
+				log.Printf("⚠️  [Persistence] Failed to rewrap TEK version %d of organization %s: %v", row.version, row.organizationID, err)
+				failed++
+				continue
+			}
+			if ok {
+				rewrapped++
+			}
+		}
+
+		s.tekRewrapMu.Lock()
+		s.tekRewrapJob.RewrappedTeks += rewrapped
+		s.tekRewrapJob.FailedTeks += failed
+		s.tekRewrapMu.Unlock()
+	}
+}
+
+// tekRewrapRow is an organization_teks row loaded by the rewrap job
+type tekRewrapRow struct {
+	organizationID string
+	version        int
+	encryptedTEK   []byte
+}
+
+// loadTEKRewrapBatch returns the next TEK rows after the cursor
+func (s *PersistenceService) loadTEKRewrapBatch(ctx context.Context, cursorOrg string, cursorVersion int) ([]tekRewrapRow, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT organization_id, version, encrypted_tek
+		FROM organization_teks
+		WHERE (organization_id, version) > ($1, $2)
+		ORDER BY organization_id, version
+		LIMIT $3
+	`, cursorOrg, cursorVersion, tekRewrapBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []tekRewrapRow
+	for rows.Next() {
+		var row tekRewrapRow
+		if err := rows.Scan(&row.organizationID, &row.version, &row.encryptedTEK); err != nil {
+			return nil, err
+		}
+		batch = append(batch, row)
+	}
+	return batch, rows.Err()
+}
+
+// rewrapTEK rewraps one TEK under the current KEK. The update only applies if the row
+// still holds the TEK that was read, so concurrent jobs on other replicas are harmless.
+func (s *PersistenceService) rewrapTEK(ctx context.Context, row tekRewrapRow) (bool, error) {
+	tek, err := s.kekProvider.UnwrapTEK(row.encryptedTEK)
+	if err != nil {
+		return false, err
+	}
+	defer clear(tek)
+
+	rewrapped, err := s.kekProvider.WrapTEK(tek)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE organization_teks SET encrypted_tek = $4
+		WHERE organization_id = $1 AND version = $2 AND encrypted_tek = $3
+	`, row.organizationID, row.version, row.encryptedTEK, rewrapped)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+// finishTEKRewrap records the outcome of the rewrap job
+func (s *PersistenceService) finishTEKRewrap(err error) {
+	s.tekRewrapMu.Lock()
+	defer s.tekRewrapMu.Unlock()
+
+	job := s.tekRewrapJob
+	job.CompletedAt = timestamppb.Now()
+
+	switch {
+	case err != nil:
+		job.Status = tekRewrapFailed
+		job.ErrorMessage = err.Error()
+		log.Printf("❌ [Persistence] TEK rewrap stopped: %v", err)
+	case job.FailedTeks > 0:
+		job.Status = tekRewrapFailed
+		job.ErrorMessage = fmt.Sprintf("%d TEKs could not be rewrapped", job.FailedTeks)
+		log.Printf("⚠️  [Persistence] TEK rewrap finished with %d failures", job.FailedTeks)
+	default:
+		job.Status = tekRewrapCompleted
+		log.Printf("✅ [Persistence] TEK rewrap completed: %d TEKs rewrapped", job.RewrappedTeks)
+	}
+}
+
+// GetKEKStatus reports how many stored TEKs each KEK wraps. A retired KEK may only be
+// removed from the ring once it no longer wraps any TEK.
+func (s *PersistenceService) GetKEKStatus(ctx context.Context, req *pb.GetKEKStatusRequest) (*pb.GetKEKStatusResponse, error) {
+	if s.kekProvider == nil {
+		return nil, status.Error(codes.Unavailable, "KEK status requires a KEK provider on the persistence service")
+	}
+
+	counts, err := s.countKEKReferences(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count TEKs: %v", err)
+	}
+
+	currentID := s.kekProvider.CurrentKEKID()
+	response := &pb.GetKEKStatusResponse{
+		CurrentKekId: currentID,
+		Status:       "success",
+	}
+
+	// KEKs in the ring come first in ring order, followed by anything else still referenced
+	inRing := make(map[string]bool)
+	for _, kekID := range s.kekProvider.KEKIDs() {
+		inRing[kekID] = true
+		response.References = append(response.References, &pb.KEKReference{
+			KekId:  kekID,
+			Teks:   counts[kekID],
+			InRing: true,
+		})
+	}
+
+	var others []string
+	for kekID := range counts {
+		if !inRing[kekID] {
+			others = append(others, kekID)
+		}
+	}
+	sort.Strings(others)
+	for _, kekID := range others {
+		response.References = append(response.References, &pb.KEKReference{
+			KekId: kekID,
+			Teks:  counts[kekID],
+		})
+	}
+
+	for kekID, count := range counts {
+		if kekID != currentID {
+			response.RemainingTeks += count
+		}
+	}
+
+	s.tekRewrapMu.Lock()
+	if s.tekRewrapJob != nil {
+		response.Job = proto.Clone(s.tekRewrapJob).(*pb.KEKRewrapJob)
+	}
+	s.tekRewrapMu.Unlock()
+
+	return response, nil
+}
+
+// countKEKReferences counts stored TEKs by the ID of the KEK that wrapped them. TEKs
+// wrapped before KEK IDs were introduced are counted under "".
+func (s *PersistenceService) countKEKReferences(ctx context.Context) (map[string]int64, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT encrypted_tek FROM organization_teks`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	for rows.Next() {
+		var encryptedTEK []byte
+		if err := rows.Scan(&encryptedTEK); err != nil {
+			return nil, err
+		}
+		counts[s.kekProvider.WrappedKEKID(encryptedTEK)]++
+	}
+	return counts, rows.Err()
+}
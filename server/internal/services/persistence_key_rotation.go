@@ -0,0 +1,711 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	"github.com/PlainFunction/mistokenly/internal/common/secret"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Key rotation job statuses. A running job whose heartbeat is older than
+// keyRotationStaleAfter is reported as interrupted and can be resumed.
+const (
+	keyRotationRunning     = "running"
+	keyRotationCompleted   = "completed"
+	keyRotationInterrupted = "interrupted"
+)
+
+// keyRotationStaleAfter is how long a running job may go without saving progress
+// before it is considered interrupted
+const keyRotationStaleAfter = 2 * time.Minute
+
+// keyRotationColumns lists the columns scanned by scanKeyRotation
+const keyRotationColumns = `id, organization_id, status, from_version, to_version, total_tokens, processed_tokens,
+	failed_tokens, last_reference_hash, started_at, updated_at, completed_at, COALESCE(error_message, '')`
+
+// keyRotation is a key rotation job row
+type keyRotation struct {
+	*pb.OrganizationKeyRotation
+	lastReferenceHash string
+	updatedAt         time.Time
+}
+
+// rotationKeyPair holds the final encryption keys for one TEK version under the old
+// and the new organization key
+type rotationKeyPair struct {
+	oldKey *secret.Buffer
+	newKey *secret.Buffer
+}
+
+// destroy wipes both keys
+func (p rotationKeyPair) destroy() {
+	p.oldKey.Destroy()
+	p.newKey.Destroy()
+}
+
+// RotateOrganizationKey replaces an organization's key and re-encrypts its tokens in the
+// background. The previous key stays valid until the job completes. Both raw keys are
+// only held in memory, so an interrupted job is resumed by calling this again with the
+// same pair of keys.
+func (s *PersistenceService) RotateOrganizationKey(ctx context.Context, req *pb.RotateOrganizationKeyRequest) (*pb.RotateOrganizationKeyResponse, error) {
+	log.Printf("[gRPC] RotateOrganizationKey called for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if req.OrganizationKey == "" || req.NewOrganizationKey == "" || req.NewOrgKeyHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_key, new_organization_key and new_org_key_hash are required")
+	}
+	if req.OrganizationKey == req.NewOrganizationKey {
+		return nil, status.Error(codes.InvalidArgument, "the new organization key must differ from the current one")
+	}
+	if s.kekProvider == nil {
+		return nil, status.Error(codes.Unavailable, "organization key rotation requires a KEK provider on the persistence service")
+	}
+
+	// The stored hash must belong to the new key or nobody could use the organization afterwards
+	if ok, _, err := orgkey.Verify(req.NewOrganizationKey, req.NewOrgKeyHash); err != nil || !ok {
+		return nil, status.Error(codes.InvalidArgument, "new_org_key_hash does not match new_organization_key")
+	}
+
+	if err := s.checkLockout(ctx, req.OrganizationId, req.Source); err != nil {
+		return nil, types.TEKErrorStatus(err)
+	}
+
+	if _, err := s.getOrganizationStatus(ctx, req.OrganizationId); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+	}
+
+	tek, err := s.loadStoredTEK(ctx, req.OrganizationId)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s has no TEK", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load TEK: %v", err)
+	}
+
+	current, err := s.getRunningKeyRotation(ctx, req.OrganizationId)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to load key rotation: %v", err)
+	}
+	if err == nil {
+		return s.resumeKeyRotation(ctx, req, tek, current)
+	}
+
+	ok, _, err := orgkey.Verify(req.OrganizationKey, tek.OrgKeyHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify organization key: %v", err)
+	}
+	if !ok {
+		log.Printf("⚠️  [Persistence] Key rotation refused for organization %s: invalid organization key", req.OrganizationId)
+		s.recordKeyFailure(ctx, req.OrganizationId, req.Source)
+		return nil, types.TEKErrorStatus(types.ErrOrganizationKeyMismatch)
+	}
+
+	rotation, err := s.startKeyRotation(ctx, tek, req.NewOrgKeyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("🔑 [Persistence] Organization key rotation started for %s: version %d -> %d, %d tokens to re-encrypt",
+		req.OrganizationId, rotation.FromVersion, rotation.ToVersion, rotation.TotalTokens)
+
+	go s.runKeyRotation(rotation, req.OrganizationKey, req.NewOrganizationKey)
+
+	return &pb.RotateOrganizationKeyResponse{
+		Rotation: rotation.OrganizationKeyRotation,
+		Status:   "success",
+	}, nil
+}
+
+// resumeKeyRotation restarts an interrupted job. The caller must present both keys
+// of the rotation in progress.
+func (s *PersistenceService) resumeKeyRotation(ctx context.Context, req *pb.RotateOrganizationKeyRequest, tek *types.OrganizationTEK, rotation *keyRotation) (*pb.RotateOrganizationKeyResponse, error) {
+	// The current key already being the new one means a second rotation was requested
+	if ok, _, err := orgkey.Verify(req.OrganizationKey, tek.OrgKeyHash); err == nil && ok {
+		return nil, status.Errorf(codes.Aborted, "a key rotation is already in progress for organization %s", req.OrganizationId)
+	}
+
+	oldOK, _, err := orgkey.Verify(req.OrganizationKey, tek.PreviousOrgKeyHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify organization key: %v", err)
+	}
+	newOK, _, err := orgkey.Verify(req.NewOrganizationKey, tek.OrgKeyHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify organization key: %v", err)
+	}
+	if !oldOK || !newOK {
+		log.Printf("⚠️  [Persistence] Key rotation resume refused for organization %s: keys do not match the rotation in progress", req.OrganizationId)
+		s.recordKeyFailure(ctx, req.OrganizationId, req.Source)
+		return nil, types.TEKErrorStatus(types.ErrOrganizationKeyMismatch)
+	}
+
+	// A job that is still making progress, here or on another replica, is left alone
+	if s.keyRotationStatus(rotation) == keyRotationRunning {
+		return &pb.RotateOrganizationKeyResponse{
+			Rotation: rotation.OrganizationKeyRotation,
+			Status:   "success",
+		}, nil
+	}
+
+	claimed, err := s.claimKeyRotation(ctx, rotation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resume key rotation: %v", err)
+	}
+	if claimed {
+		log.Printf("🔑 [Persistence] Organization key rotation resumed for %s from %q", req.OrganizationId, rotation.lastReferenceHash)
+		go s.runKeyRotation(rotation, req.OrganizationKey, req.NewOrganizationKey)
+	}
+
+	rotation.Status = keyRotationRunning
+	return &pb.RotateOrganizationKeyResponse{
+		Rotation: rotation.OrganizationKeyRotation,
+		Status:   "success",
+	}, nil
+}
+
+// startKeyRotation switches the organization to the new key hash, keeping the current
+// one as the previous key, and records the job in one transaction
+func (s *PersistenceService) startKeyRotation(ctx context.Context, tek *types.OrganizationTEK, newOrgKeyHash string) (*keyRotation, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the active TEK and make sure the key was not changed since it was verified
+	var orgKeyHash string
+	var orgKeyVersion int
+	err = tx.QueryRowContext(ctx, `
+		SELECT org_key_hash, org_key_version FROM organization_teks
+		WHERE organization_id = $1 AND is_active = true
+		FOR UPDATE
+	`, tek.OrganizationID).Scan(&orgKeyHash, &orgKeyVersion)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to lock TEK: %v", err)
+	}
+	if orgKeyHash != tek.OrgKeyHash || orgKeyVersion != tek.OrgKeyVersion {
+		return nil, status.Errorf(codes.Aborted, "the organization key of %s changed concurrently", tek.OrganizationID)
+	}
+
+	// Key state is kept identical on every TEK version
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE organization_teks
+		SET previous_org_key_hash = $2, org_key_hash = $3, org_key_version = $4
+		WHERE organization_id = $1
+	`, tek.OrganizationID, orgKeyHash, newOrgKeyHash, orgKeyVersion+1); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store new organization key hash: %v", err)
+	}
+
+	var total int64
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM pii_tokens WHERE organization_id = $1 AND org_key_version = $2
+	`, tek.OrganizationID, orgKeyVersion).Scan(&total); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count tokens: %v", err)
+	}
+
+	rotation, err := scanKeyRotation(tx.QueryRowContext(ctx, `
+		INSERT INTO organization_key_rotations (organization_id, from_version, to_version, total_tokens)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+keyRotationColumns,
+		tek.OrganizationID, orgKeyVersion, orgKeyVersion+1, total))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record key rotation: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit key rotation: %v", err)
+	}
+
+	return rotation, nil
+}
+
+// claimKeyRotation takes over an interrupted job. Only one caller can claim it because
+// the heartbeat is refreshed in the same statement.
+func (s *PersistenceService) claimKeyRotation(ctx context.Context, rotation *keyRotation) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE organization_key_rotations SET updated_at = NOW()
+		WHERE id = $1 AND status = $2 AND updated_at = $3
+	`, rotation.RotationId, keyRotationRunning, rotation.updatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return claimed == 1, nil
+}
+
+// runKeyRotation re-encrypts an organization's tokens from the old to the new key.
+// After the first pass it waits for the grace period so tokens encrypted with the old
+// key that were still queued or served from a PII service cache are persisted, sweeps
+// once more and then retires the previous key.
+func (s *PersistenceService) runKeyRotation(rotation *keyRotation, oldKey, newKey string) {
+	organizationID := rotation.OrganizationId
+
+	if !s.beginLocalKeyRotation(organizationID) {
+		log.Printf("[Persistence] Key rotation for %s is already running in this process", organizationID)
+		return
+	}
+	defer s.endLocalKeyRotation(organizationID)
+
+	// Stop between batches on shutdown; the saved cursor lets the job resume
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	keys := make(map[int]rotationKeyPair)
+	defer func() {
+		for _, pair := range keys {
+			pair.destroy()
+		}
+	}()
+
+	if err := s.reencryptTokens(ctx, rotation, rotation.lastReferenceHash, oldKey, newKey, keys, false); err != nil {
+		s.failKeyRotation(rotation, err)
+		return
+	}
+
+	if !s.waitKeyRotationGrace(ctx, rotation) {
+		return
+	}
+
+	if err := s.reencryptTokens(ctx, rotation, "", oldKey, newKey, keys, true); err != nil {
+		s.failKeyRotation(rotation, err)
+		return
+	}
+
+	if err := s.completeKeyRotation(ctx, rotation); err != nil {
+		s.failKeyRotation(rotation, err)
+		return
+	}
+
+	log.Printf("✅ [Persistence] Organization key rotation completed for %s", organizationID)
+}
+
+// reencryptTokens walks the organization's tokens that are still under the old key in
+// reference order starting after cursor, re-encrypting them in batches and saving
+// progress after each batch. Tokens that cannot be decrypted are skipped and, unless
+// sweep is set, counted as failed.
+func (s *PersistenceService) reencryptTokens(ctx context.Context, rotation *keyRotation, cursor, oldKey, newKey string, keys map[int]rotationKeyPair, sweep bool) error {
+	batchSize := s.config.KeyRotationBatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch, err := s.loadRotationBatch(ctx, rotation, cursor, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load tokens: %w", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		var processed, failed int64
+		cacheKeys := make([]string, 0, len(batch))
+		for _, token := range batch {
+			cursor = token.referenceHash
+
+			pair, err := s.rotationKeysFor(ctx, rotation.OrganizationId, token.tekVersion, oldKey, newKey, keys)
+			if err != nil {
+				return err
+			}
+
+			// The token keeps its format, cipher suite and key salt: the previous ciphertext
+			// stays readable under the same additional authenticated data
+			aad := envelope.TokenAAD(token.formatVersion, token.referenceHash, rotation.OrganizationId, token.dataType, token.tekVersion)
+			oldTokenKey, err := tokenKey(token.formatVersion, pair.oldKey, token.dataType, token.keySalt)
+			if err != nil {
+				log.Printf("⚠️  [Persistence] Key rotation could not derive the key of token %s: %v", token.referenceHash, err)
+				if !sweep {
+					failed++
+				}
+				continue
+			}
+			newTokenKey, err := tokenKey(token.formatVersion, pair.newKey, token.dataType, token.keySalt)
+			if err != nil {
+				oldTokenKey.Destroy()
+				return fmt.Errorf("failed to derive key for token %s: %w", token.referenceHash, err)
+			}
+
+			plaintext, err := envelope.OpenToken(token.formatVersion, oldTokenKey.Bytes(), token.encryptedData, token.iv, aad)
+			oldTokenKey.Destroy()
+			if err != nil {
+				newTokenKey.Destroy()
+				log.Printf("⚠️  [Persistence] Key rotation could not decrypt token %s: %v", token.referenceHash, err)
+				if !sweep {
+					failed++
+				}
+				continue
+			}
+
+			ciphertext, iv, err := envelope.ResealToken(token.formatVersion, token.encryptedData, newTokenKey.Bytes(), plaintext, aad)
+			newTokenKey.Destroy()
+			if err != nil {
+				clear(plaintext)
+				return fmt.Errorf("failed to re-encrypt token %s: %w", token.referenceHash, err)
+			}
+
+			// The blind index is keyed like the token, so it moves to the new key too
+			var blindIndex string
+			if token.indexed {
+				blindIndexKey, err := envelope.DeriveBlindIndexKey(pair.newKey.Bytes(), token.dataType)
+				if err != nil {
+					clear(plaintext)
+					return fmt.Errorf("failed to derive blind index key for token %s: %w", token.referenceHash, err)
+				}
+				blindIndex = envelope.BlindIndex(blindIndexKey, normalizePII(token.dataType, string(plaintext)))
+				clear(blindIndexKey)
+			}
+			clear(plaintext)
+
+			// The old ciphertext is kept so the previous key works until the rotation completes
+			if _, err := s.db.ExecContext(ctx, `
+				UPDATE pii_tokens
+				SET encrypted_data = $3, iv = $4, org_key_version = $5, blind_index = NULLIF($7, ''),
+					previous_encrypted_data = encrypted_data, previous_iv = iv, updated_at = NOW()
+				WHERE organization_id = $1 AND reference_hash = $2 AND org_key_version = $6
+			`, rotation.OrganizationId, token.referenceHash, ciphertext, iv, rotation.ToVersion, rotation.FromVersion, blindIndex); err != nil {
+				return fmt.Errorf("failed to store re-encrypted token %s: %w", token.referenceHash, err)
+			}
+
+			processed++
+			cacheKeys = append(cacheKeys, fmt.Sprintf("pii:token:%s", token.referenceHash))
+		}
+
+		// Cached copies still hold the old ciphertext
+		if s.redisClient != nil && len(cacheKeys) > 0 {
+			if err := s.redisClient.Del(ctx, cacheKeys...).Err(); err != nil {
+				log.Printf("⚠️  [Persistence] Failed to evict re-encrypted tokens from cache: %v", err)
+			}
+		}
+
+		if err := s.saveKeyRotationProgress(ctx, rotation, cursor, processed, failed); err != nil {
+			return fmt.Errorf("failed to save progress: %w", err)
+		}
+	}
+}
+
+// rotationToken is a token row loaded for re-encryption
+type rotationToken struct {
+	referenceHash string
+	encryptedData []byte
+	iv            []byte
+	dataType      string
+	tekVersion    int
+	formatVersion int
+	keySalt       []byte
+	indexed       bool // Whether the token has a blind index to recompute
+}
+
+// loadRotationBatch returns the next tokens after cursor that are still under the old key
+func (s *PersistenceService) loadRotationBatch(ctx context.Context, rotation *keyRotation, cursor string, limit int) ([]rotationToken, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT reference_hash, encrypted_data, iv, data_type, tek_version, format_version, key_salt,
+			blind_index IS NOT NULL
+		FROM pii_tokens
+		WHERE organization_id = $1 AND org_key_version = $2 AND reference_hash > $3
+		ORDER BY reference_hash
+		LIMIT $4
+	`, rotation.OrganizationId, rotation.FromVersion, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []rotationToken
+	for rows.Next() {
+		var token rotationToken
+		if err := rows.Scan(&token.referenceHash, &token.encryptedData, &token.iv, &token.dataType, &token.tekVersion, &token.formatVersion, &token.keySalt, &token.indexed); err != nil {
+			return nil, err
+		}
+		batch = append(batch, token)
+	}
+	return batch, rows.Err()
+}
+
+// rotationKeysFor derives the old and new final encryption keys for a TEK version,
+// caching them in secret buffers for the rest of the job, which destroys them
+func (s *PersistenceService) rotationKeysFor(ctx context.Context, organizationID string, tekVersion int, oldKey, newKey string, keys map[int]rotationKeyPair) (rotationKeyPair, error) {
+	if pair, ok := keys[tekVersion]; ok {
+		return pair, nil
+	}
+
+	tekRecord, err := s.loadTEKVersion(ctx, organizationID, tekVersion)
+	if err != nil {
+		return rotationKeyPair{}, fmt.Errorf("failed to load TEK version %d: %w", tekVersion, err)
+	}
+
+	plaintextTEK, err := s.kekProvider.UnwrapTEK(tekRecord.EncryptedTEK)
+	if err != nil {
+		return rotationKeyPair{}, fmt.Errorf("failed to unwrap TEK version %d: %w", tekVersion, err)
+	}
+	tek, err := secret.FromBytes(plaintextTEK)
+	if err != nil {
+		return rotationKeyPair{}, err
+	}
+	defer tek.Destroy()
+
+	var pair rotationKeyPair
+	if pair.oldKey, err = deriveSecretKey(oldKey, tek); err != nil {
+		return rotationKeyPair{}, err
+	}
+	if pair.newKey, err = deriveSecretKey(newKey, tek); err != nil {
+		pair.oldKey.Destroy()
+		return rotationKeyPair{}, err
+	}
+
+	keys[tekVersion] = pair
+	return pair, nil
+}
+
+// saveKeyRotationProgress records a finished batch and refreshes the job heartbeat.
+// Tokens created under the old key after the job started raise the total.
+func (s *PersistenceService) saveKeyRotationProgress(ctx context.Context, rotation *keyRotation, cursor string, processed, failed int64) error {
+	return s.db.QueryRowContext(ctx, `
+		UPDATE organization_key_rotations
+		SET processed_tokens = processed_tokens + $2,
+			failed_tokens = failed_tokens + $3,
+			total_tokens = GREATEST(total_tokens, processed_tokens + failed_tokens + $2 + $3),
+			last_reference_hash = $4,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING total_tokens, processed_tokens, failed_tokens, updated_at
+	`, rotation.RotationId, processed, failed, cursor).Scan(
+		&rotation.TotalTokens, &rotation.ProcessedTokens, &rotation.FailedTokens, &rotation.updatedAt,
+	)
+}
+
+// waitKeyRotationGrace waits for the configured grace period while keeping the job
+// heartbeat fresh. It returns false if the service is shutting down.
+func (s *PersistenceService) waitKeyRotationGrace(ctx context.Context, rotation *keyRotation) bool {
+	deadline := time.NewTimer(s.config.KeyRotationGrace)
+	defer deadline.Stop()
+	heartbeat := time.NewTicker(keyRotationStaleAfter / 4)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-deadline.C:
+			return true
+		case <-heartbeat.C:
+			if _, err := s.db.ExecContext(ctx, `
+				UPDATE organization_key_rotations SET updated_at = NOW() WHERE id = $1
+			`, rotation.RotationId); err != nil {
+				log.Printf("⚠️  [Persistence] Failed to refresh key rotation heartbeat for %s: %v", rotation.OrganizationId, err)
+			}
+		}
+	}
+}
+
+// completeKeyRotation retires the previous organization key and drops the ciphertexts
+// kept for it. Tokens still under the old key at this point could not be decrypted;
+// while any remain the previous key is kept so they stay readable, and the job fails
+// with them counted as failed so it can be resumed once they are repaired.
+func (s *PersistenceService) completeKeyRotation(ctx context.Context, rotation *keyRotation) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		SELECT 1 FROM organization_teks WHERE organization_id = $1 AND is_active = true FOR UPDATE
+	`, rotation.OrganizationId); err != nil {
+		return fmt.Errorf("failed to lock TEK: %w", err)
+	}
+
+	var remaining int64
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM pii_tokens WHERE organization_id = $1 AND org_key_version = $2
+	`, rotation.OrganizationId, rotation.FromVersion).Scan(&remaining); err != nil {
+		return fmt.Errorf("failed to count remaining tokens: %w", err)
+	}
+
+	if remaining > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE organization_key_rotations SET failed_tokens = $2, updated_at = NOW() WHERE id = $1
+		`, rotation.RotationId, remaining); err != nil {
+			return fmt.Errorf("failed to record failed tokens: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit key rotation: %w", err)
+		}
+		rotation.FailedTokens = remaining
+		return fmt.Errorf("%d tokens could not be re-encrypted and still need organization key version %d, which is kept", remaining, rotation.FromVersion)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE pii_tokens SET previous_encrypted_data = NULL, previous_iv = NULL
+		WHERE organization_id = $1 AND previous_encrypted_data IS NOT NULL
+	`, rotation.OrganizationId); err != nil {
+		return fmt.Errorf("failed to drop previous ciphertexts: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE organization_teks SET previous_org_key_hash = NULL WHERE organization_id = $1
+	`, rotation.OrganizationId); err != nil {
+		return fmt.Errorf("failed to retire previous organization key: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE organization_key_rotations
+		SET status = $2, failed_tokens = 0, completed_at = NOW(), updated_at = NOW(), error_message = NULL
+		WHERE id = $1
+	`, rotation.RotationId, keyRotationCompleted); err != nil {
+		return fmt.Errorf("failed to mark key rotation completed: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// failKeyRotation records why a job stopped. The job stays running so that it is
+// reported as interrupted once its heartbeat goes stale and can be resumed.
+func (s *PersistenceService) failKeyRotation(rotation *keyRotation, err error) {
+	if errors.Is(err, context.Canceled) {
+		log.Printf("[Persistence] Key rotation for %s paused by shutdown", rotation.OrganizationId)
+		return
+	}
+
+	log.Printf("❌ [Persistence] Key rotation for %s stopped: %v", rotation.OrganizationId, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, dbErr := s.db.ExecContext(ctx, `
+		UPDATE organization_key_rotations SET error_message = $2 WHERE id = $1
+	`, rotation.RotationId, err.Error()); dbErr != nil {
+		log.Printf("⚠️  [Persistence] Failed to record key rotation error for %s: %v", rotation.OrganizationId, dbErr)
+	}
+}
+
+// GetOrganizationKeyRotation reports the latest key rotation of an organization
+func (s *PersistenceService) GetOrganizationKeyRotation(ctx context.Context, req *pb.GetOrganizationKeyRotationRequest) (*pb.GetOrganizationKeyRotationResponse, error) {
+	log.Printf("[gRPC] GetOrganizationKeyRotation called for organization: %s", req.OrganizationId)
+
+	rotation, err := scanKeyRotation(s.db.QueryRowContext(ctx, `
+		SELECT `+keyRotationColumns+`
+		FROM organization_key_rotations
+		WHERE organization_id = $1
+		ORDER BY started_at DESC
+		LIMIT 1
+	`, req.OrganizationId))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "no key rotation found for organization %s", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load key rotation: %v", err)
+	}
+
+	rotation.Status = s.keyRotationStatus(rotation)
+
+	return &pb.GetOrganizationKeyRotationResponse{
+		Rotation: rotation.OrganizationKeyRotation,
+		Status:   "success",
+	}, nil
+}
+
+// getRunningKeyRotation returns the organization's unfinished key rotation
+func (s *PersistenceService) getRunningKeyRotation(ctx context.Context, organizationID string) (*keyRotation, error) {
+	return scanKeyRotation(s.db.QueryRowContext(ctx, `
+		SELECT `+keyRotationColumns+`
+		FROM organization_key_rotations
+		WHERE organization_id = $1 AND status = $2
+	`, organizationID, keyRotationRunning))
+}
+
+// keyRotationStatus reports a running job whose heartbeat went stale as interrupted
+func (s *PersistenceService) keyRotationStatus(rotation *keyRotation) string {
+	if rotation.Status != keyRotationRunning || s.isLocalKeyRotation(rotation.OrganizationId) {
+		return rotation.Status
+	}
+	if time.Since(rotation.updatedAt) > keyRotationStaleAfter {
+		return keyRotationInterrupted
+	}
+	return rotation.Status
+}
+
+// beginLocalKeyRotation marks a job as running in this process; it returns false if one already is
+func (s *PersistenceService) beginLocalKeyRotation(organizationID string) bool {
+	s.keyRotationsMu.Lock()
+	defer s.keyRotationsMu.Unlock()
+	if s.keyRotations[organizationID] {
+		return false
+	}
+	s.keyRotations[organizationID] = true
+	return true
+}
+
+func (s *PersistenceService) endLocalKeyRotation(organizationID string) {
+	s.keyRotationsMu.Lock()
+	defer s.keyRotationsMu.Unlock()
+	delete(s.keyRotations, organizationID)
+}
+
+func (s *PersistenceService) isLocalKeyRotation(organizationID string) bool {
+	s.keyRotationsMu.Lock()
+	defer s.keyRotationsMu.Unlock()
+	return s.keyRotations[organizationID]
+}
+
+// scanKeyRotation scans a row selected with keyRotationColumns
+func scanKeyRotation(row rowScanner) (*keyRotation, error) {
+	rotation := &keyRotation{OrganizationKeyRotation: &pb.OrganizationKeyRotation{}}
+	var startedAt time.Time
+	var completedAt sql.NullTime
+
+	err := row.Scan(
+		&rotation.RotationId,
+		&rotation.OrganizationId,
+		&rotation.Status,
+		&rotation.FromVersion,
+		&rotation.ToVersion,
+		&rotation.TotalTokens,
+		&rotation.ProcessedTokens,
+		&rotation.FailedTokens,
+		&rotation.lastReferenceHash,
+		&startedAt,
+		&rotation.updatedAt,
+		&completedAt,
+		&rotation.ErrorMessage,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation.StartedAt = timestamppb.New(startedAt)
+	rotation.UpdatedAt = timestamppb.New(rotation.updatedAt)
+	if completedAt.Valid {
+		rotation.CompletedAt = timestamppb.New(completedAt.Time)
+	}
+
+	return rotation, nil
+}
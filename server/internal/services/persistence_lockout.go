@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newLimiter builds the organization key brute-force limiter from configuration
+func newLimiter(cfg *config.Config, store lockout.Store) *lockout.Limiter {
+	return lockout.NewLimiter(store,
+		lockout.Policy{
+			MaxAttempts: int64(cfg.LockoutOrgMaxAttempts),
+			Window:      cfg.LockoutWindow,
+			BaseLockout: cfg.LockoutBaseDuration,
+			MaxLockout:  cfg.LockoutMaxDuration,
+		},
+		lockout.Policy{
+			MaxAttempts: int64(cfg.LockoutSourceMaxAttempts),
+			Window:      cfg.LockoutWindow,
+			BaseLockout: cfg.LockoutBaseDuration,
+			MaxLockout:  cfg.LockoutMaxDuration,
+		},
+	)
+}
+
+// checkLockout returns a LockedError if key verification is currently blocked for
+// the organization or source. Counter store failures are logged and do not block.
+func (s *PersistenceService) checkLockout(ctx context.Context, organizationID, source string) error {
+	remaining, err := s.limiter.Check(ctx, organizationID, source)
+	if err != nil {
+		log.Printf("⚠️  [Persistence] Lockout check failed for organization %s: %v", organizationID, err)
+		return nil
+	}
+	if remaining > 0 {
+		log.Printf("⛔ [Persistence] Key verification refused for organization %s (source %q): locked for %s", organizationID, source, remaining.Round(time.Second))
+		return &types.LockedError{RetryAfter: remaining}
+	}
+	return nil
+}
+
+// recordKeyFailure counts a failed organization key verification and reports any
+// lockout it triggers to the audit service
+func (s *PersistenceService) recordKeyFailure(ctx context.Context, organizationID, source string) {
+	lockouts, err := s.limiter.RecordFailure(ctx, organizationID, source)
+	if err != nil {
+		log.Printf("⚠️  [Persistence] Failed to record key failure for organization %s: %v", organizationID, err)
+	}
+
+	for _, l := range lockouts {
+		log.Printf("🔒 [Persistence] Lockout triggered: %s %s after %d failures, locked for %s", l.Scope, l.Subject, l.Failures, l.Duration)
+		s.logLockoutEvent(organizationID, source, l)
+	}
+}
+
+// logLockoutEvent sends a lockout audit event without blocking the request
+func (s *PersistenceService) logLockoutEvent(organizationID, source string, l lockout.Lockout) {
+	if s.auditClient == nil {
+		return
+	}
+
+	req := &pbAudit.LogAccessRequest{
+		Operation:         "lockout",
+		RequestingService: "persistence-service",
+		Purpose:           "organization key brute-force protection",
+		Timestamp:         timestamppb.New(time.Now()),
+		ClientIp:          source,
+		Metadata: map[string]string{
+			"organization_id":  organizationID,
+			"scope":            string(l.Scope),
+			"subject":          l.Subject,
+			"failures":         strconv.FormatInt(l.Failures, 10),
+			"lockout_seconds":  strconv.Itoa(int(l.Duration.Seconds())),
+			"locked_until_utc": time.Now().Add(l.Duration).UTC().Format(time.RFC3339),
+		},
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := s.auditClient.LogAccess(ctx, req)
+		if err != nil {
+			log.Printf("⚠️  [Persistence] Failed to audit lockout for organization %s: %v", organizationID, err)
+			return
+		}
+		if resp.Status != "success" {
+			log.Printf("⚠️  [Persistence] Failed to audit lockout for organization %s: %s", organizationID, resp.ErrorMessage)
+		}
+	}()
+}
+
+// UnlockOrganization clears the brute-force counters for an organization and, optionally, a source
+func (s *PersistenceService) UnlockOrganization(ctx context.Context, req *pb.UnlockOrganizationRequest) (*pb.UnlockOrganizationResponse, error) {
+	log.Printf("[gRPC] UnlockOrganization called for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	if _, err := s.getOrganizationStatus(ctx, req.OrganizationId); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+	}
+
+	if err := s.limiter.Unlock(ctx, req.OrganizationId, req.Source); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock organization: %v", err)
+	}
+
+	log.Printf("🔓 [Persistence] Lockout cleared for organization %s%s", req.OrganizationId, sourceSuffix(req.Source))
+
+	return &pb.UnlockOrganizationResponse{
+		Status: "success",
+	}, nil
+}
+
+// sourceSuffix formats an optional source for log messages
+func sourceSuffix(source string) string {
+	if source == "" {
+		return ""
+	}
+	return fmt.Sprintf(" and source %s", source)
+}
@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// organizationColumns lists the columns scanned by scanOrganization
+const organizationColumns = `organization_id, display_name, status, created_at, updated_at, suspended_at, suspended_reason, cipher_suite, deterministic_data_types, shredded_at`
+
+// CreateOrganization registers a new organization and stores its initial TEK in one transaction
+func (s *PersistenceService) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	log.Printf("[gRPC] CreateOrganization called for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if len(req.EncryptedTek) == 0 || req.OrgKeyHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "encrypted_tek and org_key_hash are required")
+	}
+	suite, err := envelope.ParseSuite(req.CipherSuite)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	createdAt := time.Now()
+	if req.CreatedAt != nil {
+		createdAt = req.CreatedAt.AsTime()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO organizations (organization_id, display_name, status, created_at, updated_at, cipher_suite)
+		VALUES ($1, $2, $3, $4, $4, $5)
+		ON CONFLICT (organization_id) DO NOTHING
+	`, req.OrganizationId, req.DisplayName, types.OrganizationStatusActive, createdAt, suite.String())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert organization: %v", err)
+	}
+	if inserted, err := result.RowsAffected(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert organization: %v", err)
+	} else if inserted == 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "organization %s already exists", req.OrganizationId)
+	}
+
+	result, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_teks (organization_id, encrypted_tek, org_key_hash, created_at, version, is_active)
+		VALUES ($1, $2, $3, $4, 1, true)
+		ON CONFLICT DO NOTHING
+	`, req.OrganizationId, req.EncryptedTek, req.OrgKeyHash, createdAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store TEK: %v", err)
+	}
+	if inserted, err := result.RowsAffected(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store TEK: %v", err)
+	} else if inserted == 0 {
+		// A TEK without a registered organization predates onboarding; never replace it
+		return nil, status.Errorf(codes.AlreadyExists, "a TEK already exists for organization %s", req.OrganizationId)
+	}
+
+	org, err := s.getOrganization(ctx, tx, req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit organization: %v", err)
+	}
+
+	log.Printf("[Persistence] Organization created: %s", req.OrganizationId)
+
+	return &pb.CreateOrganizationResponse{
+		Organization: org,
+		Status:       "success",
+	}, nil
+}
+
+// GetOrganization returns a single organization
+func (s *PersistenceService) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	log.Printf("[gRPC] GetOrganization called for organization: %s", req.OrganizationId)
+
+	org, err := s.getOrganization(ctx, s.db, req.OrganizationId)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+	}
+
+	return &pb.GetOrganizationResponse{
+		Organization: org,
+		Status:       "success",
+	}, nil
+}
+
+// ListOrganizations returns organizations ordered by creation time, optionally filtered by status
+func (s *PersistenceService) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	log.Printf("[gRPC] ListOrganizations called (status filter: %q)", req.Status)
+
+	query := `SELECT ` + organizationColumns + ` FROM organizations WHERE 1=1`
+	args := []interface{}{}
+	argCount := 0
+
+	if req.Status != "" {
+		argCount++
+		query += fmt.Sprintf(" AND status = $%d", argCount)
+		args = append(args, req.Status)
+	}
+
+	// Get total count
+	countQuery := "SELECT COUNT(*) FROM (" + query + ") AS subquery"
+	var totalCount int32
+	if err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count organizations: %v", err)
+	}
+
+	// Add ordering and pagination
+	query += " ORDER BY created_at ASC, organization_id ASC"
+
+	if req.Limit > 0 {
+		argCount++
+		query += fmt.Sprintf(" LIMIT $%d", argCount)
+		args = append(args, req.Limit)
+	}
+
+	if req.Offset > 0 {
+		argCount++
+		query += fmt.Sprintf(" OFFSET $%d", argCount)
+		args = append(args, req.Offset)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+	}
+	defer rows.Close()
+
+	var organizations []*pb.Organization
+	for rows.Next() {
+		org, err := scanOrganization(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan organization: %v", err)
+		}
+		organizations = append(organizations, org)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+	}
+
+	return &pb.ListOrganizationsResponse{
+		Organizations: organizations,
+		TotalCount:    totalCount,
+		Status:        "success",
+	}, nil
+}
+
+// SuspendOrganization marks an organization as suspended
+func (s *PersistenceService) SuspendOrganization(ctx context.Context, req *pb.SuspendOrganizationRequest) (*pb.SuspendOrganizationResponse, error) {
+	log.Printf("[gRPC] SuspendOrganization called for organization: %s", req.OrganizationId)
+
+	org, err := s.setOrganizationStatus(ctx, req.OrganizationId, types.OrganizationStatusSuspended, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Persistence] Organization suspended: %s", req.OrganizationId)
+
+	return &pb.SuspendOrganizationResponse{
+		Organization: org,
+		Status:       "success",
+	}, nil
+}
+
+// ReactivateOrganization marks a suspended organization as active again
+func (s *PersistenceService) ReactivateOrganization(ctx context.Context, req *pb.ReactivateOrganizationRequest) (*pb.ReactivateOrganizationResponse, error) {
+	log.Printf("[gRPC] ReactivateOrganization called for organization: %s", req.OrganizationId)
+
+	org, err := s.setOrganizationStatus(ctx, req.OrganizationId, types.OrganizationStatusActive, "")
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Persistence] Organization reactivated: %s", req.OrganizationId)
+
+	return &pb.ReactivateOrganizationResponse{
+		Organization: org,
+		Status:       "success",
+	}, nil
+}
+
+// SetOrganizationCipherSuite changes the cipher suite new tokens of an organization are sealed with
+func (s *PersistenceService) SetOrganizationCipherSuite(ctx context.Context, req *pb.SetOrganizationCipherSuiteRequest) (*pb.SetOrganizationCipherSuiteResponse, error) {
+	log.Printf("[gRPC] SetOrganizationCipherSuite called for organization: %s", req.OrganizationId)
+
+	if req.CipherSuite == "" {
+		return nil, status.Error(codes.InvalidArgument, "cipher_suite is required")
+	}
+	suite, err := envelope.ParseSuite(req.CipherSuite)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query := `
+		UPDATE organizations SET cipher_suite = $2, updated_at = NOW()
+		WHERE organization_id = $1
+		RETURNING ` + organizationColumns
+
+	org, err := scanOrganization(s.db.QueryRowContext(ctx, query, req.OrganizationId, suite.String()))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+	}
+
+	log.Printf("[Persistence] Organization %s now seals new tokens with %s", req.OrganizationId, suite)
+
+	return &pb.SetOrganizationCipherSuiteResponse{
+		Organization: org,
+		Status:       "success",
+	}, nil
+}
+
+// SetDeterministicDataTypes replaces the data types an organization tokenizes deterministically
+func (s *PersistenceService) SetDeterministicDataTypes(ctx context.Context, req *pb.SetDeterministicDataTypesRequest) (*pb.SetDeterministicDataTypesResponse, error) {
+	log.Printf("[gRPC] SetDeterministicDataTypes called for organization: %s", req.OrganizationId)
+
+	dataTypes, err := normalizeDataTypes(req.DataTypes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query := `
+		UPDATE organizations SET deterministic_data_types = $2, updated_at = NOW()
+		WHERE organization_id = $1
+		RETURNING ` + organizationColumns
+
+	org, err := scanOrganization(s.db.QueryRowContext(ctx, query, req.OrganizationId, pq.Array(dataTypes)))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+	}
+
+	log.Printf("[Persistence] Organization %s now tokenizes %v deterministically", req.OrganizationId, dataTypes)
+
+	return &pb.SetDeterministicDataTypesResponse{
+		Organization: org,
+		Status:       "success",
+	}, nil
+}
+
+// setOrganizationStatus updates an organization's lifecycle status and returns the updated
+// record. A shredded organization keeps its status.
+func (s *PersistenceService) setOrganizationStatus(ctx context.Context, organizationID string, orgStatus string, reason string) (*pb.Organization, error) {
+	current, err := s.getOrganizationStatus(ctx, organizationID)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", organizationID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+	}
+	if current == types.OrganizationStatusShredded {
+		return nil, status.Errorf(codes.FailedPrecondition, "organization %s has been shredded", organizationID)
+	}
+
+	query := `
+		UPDATE organizations SET
+			status = $2,
+			suspended_at = CASE WHEN $2 = 'suspended' THEN NOW() ELSE NULL END,
+			suspended_reason = CASE WHEN $2 = 'suspended' THEN $3::text ELSE NULL END,
+			updated_at = NOW()
+		WHERE organization_id = $1 AND status <> 'shredded'
+		RETURNING ` + organizationColumns
+
+	org, err := scanOrganization(s.db.QueryRowContext(ctx, query, organizationID, orgStatus, reason))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", organizationID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+	}
+
+	return org, nil
+}
+
+// getOrganizationStatus returns the lifecycle status of an organization
+func (s *PersistenceService) getOrganizationStatus(ctx context.Context, organizationID string) (string, error) {
+	var orgStatus string
+	err := s.db.QueryRowContext(ctx, `SELECT status FROM organizations WHERE organization_id = $1`, organizationID).Scan(&orgStatus)
+	return orgStatus, err
+}
+
+// organizationSettings are the parts of an organization that govern its tokens
+type organizationSettings struct {
+	status                 string
+	cipherSuite            string
+	deterministicDataTypes []string
+}
+
+// getOrganizationSettings returns the lifecycle status and token settings of an organization
+func (s *PersistenceService) getOrganizationSettings(ctx context.Context, organizationID string) (*organizationSettings, error) {
+	var settings organizationSettings
+	err := s.db.QueryRowContext(ctx, `
+		SELECT status, cipher_suite, deterministic_data_types FROM organizations WHERE organization_id = $1
+	`, organizationID).Scan(&settings.status, &settings.cipherSuite, pq.Array(&settings.deterministicDataTypes))
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getOrganization loads a single organization
+func (s *PersistenceService) getOrganization(ctx context.Context, q queryRower, organizationID string) (*pb.Organization, error) {
+	query := `SELECT ` + organizationColumns + ` FROM organizations WHERE organization_id = $1`
+	return scanOrganization(q.QueryRowContext(ctx, query, organizationID))
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanOrganization converts a database row into an Organization message
+func scanOrganization(row rowScanner) (*pb.Organization, error) {
+	var org pb.Organization
+	var createdAt, updatedAt time.Time
+	var suspendedAt, shreddedAt *time.Time
+	var suspendedReason sql.NullString
+
+	if err := row.Scan(
+		&org.OrganizationId,
+		&org.DisplayName,
+		&org.Status,
+		&createdAt,
+		&updatedAt,
+		&suspendedAt,
+		&suspendedReason,
+		&org.CipherSuite,
+		pq.Array(&org.DeterministicDataTypes),
+		&shreddedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	org.CreatedAt = timestamppb.New(createdAt)
+	org.UpdatedAt = timestamppb.New(updatedAt)
+	if suspendedAt != nil {
+		org.SuspendedAt = timestamppb.New(*suspendedAt)
+	}
+	org.SuspendedReason = suspendedReason.String
+	if shreddedAt != nil {
+		org.ShreddedAt = timestamppb.New(*shreddedAt)
+	}
+
+	return &org, nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RotateTEK stores a new active TEK version for an organization. The previous version is
+// deactivated but kept, so tokens encrypted with it can still be decrypted.
+func (s *PersistenceService) RotateTEK(ctx context.Context, req *pb.RotateTEKRequest) (*pb.RotateTEKResponse, error) {
+	log.Printf("[gRPC] RotateTEK called for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if len(req.EncryptedTek) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "encrypted_tek is required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the organization so concurrent rotations are applied one after the other
+	var orgStatus string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status FROM organizations WHERE organization_id = $1 FOR UPDATE
+	`, req.OrganizationId).Scan(&orgStatus)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to lock organization: %v", err)
+	}
+
+	var activeVersion, orgKeyVersion int
+	var orgKeyHash string
+	var previousOrgKeyHash sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		SELECT version, org_key_hash, org_key_version, previous_org_key_hash FROM organization_teks
+		WHERE organization_id = $1 AND is_active = true
+	`, req.OrganizationId).Scan(&activeVersion, &orgKeyHash, &orgKeyVersion, &previousOrgKeyHash)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s has no TEK", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load active TEK: %v", err)
+	}
+
+	rotatedAt := time.Now()
+	newVersion := activeVersion + 1
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE organization_teks SET is_active = false, rotated_at = $3
+		WHERE organization_id = $1 AND version = $2
+	`, req.OrganizationId, activeVersion, rotatedAt); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to deactivate TEK version %d: %v", activeVersion, err)
+	}
+
+	// The organization key is unchanged, so the new version carries the same key state
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO organization_teks (organization_id, encrypted_tek, org_key_hash, created_at, version, is_active, org_key_version, previous_org_key_hash)
+		VALUES ($1, $2, $3, $4, $5, true, $6, $7)
+	`, req.OrganizationId, req.EncryptedTek, orgKeyHash, rotatedAt, newVersion, orgKeyVersion, previousOrgKeyHash); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store TEK version %d: %v", newVersion, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit TEK rotation: %v", err)
+	}
+
+	log.Printf("🔄 [Persistence] TEK rotated for organization %s: version %d -> %d", req.OrganizationId, activeVersion, newVersion)
+
+	return &pb.RotateTEKResponse{
+		OrganizationId: req.OrganizationId,
+		Version:        int32(newVersion),
+		RotatedAt:      timestamppb.New(rotatedAt),
+		Status:         "success",
+	}, nil
+}
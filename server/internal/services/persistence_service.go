@@ -2,19 +2,24 @@ package services
 
 import (
 	"context"
-	"crypto/sha256"
+	"crypto/ed25519"
 	"database/sql"
 	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
 	"github.com/PlainFunction/mistokenly/internal/common/types"
 	pb "github.com/PlainFunction/mistokenly/proto/persistence"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -25,7 +30,18 @@ type PersistenceService struct {
 	db          *sql.DB
 	pgmqDB      *sql.DB
 	redisClient *redis.Client
+	limiter     *lockout.Limiter            // Brute-force protection for organization keys
+	auditClient types.AuditServiceInterface // Optional; receives lockout events
+	kekProvider types.KEKProvider           // Optional; needed to re-encrypt tokens and rewrap TEKs
 	stopCh      chan struct{}
+
+	keyRotationsMu sync.Mutex
+	keyRotations   map[string]bool // Organizations with a key rotation job running in this process
+
+	tekRewrapMu  sync.Mutex
+	tekRewrapJob *pb.KEKRewrapJob // Latest KEK rewrap job run by this process
+
+	shredSigningKey ed25519.PrivateKey // Signs shred records; nil disables shredding
 }
 
 func NewPersistenceService(cfg *config.Config) (*PersistenceService, error) {
@@ -94,19 +110,86 @@ func NewPersistenceService(cfg *config.Config) (*PersistenceService, error) {
 		redisClient = nil
 	}
 
+	// Lockout counters are shared through Redis when available, per replica otherwise
+	var lockoutStore lockout.Store
+	if redisClient != nil {
+		lockoutStore = lockout.NewRedisStore(redisClient)
+		log.Printf("✅ [Persistence] Brute-force lockout counters stored in cache")
+	} else {
+		lockoutStore = lockout.NewMemoryStore()
+		log.Printf("ℹ️ [Persistence] Brute-force lockout counters stored in memory")
+	}
+
+	// The KEK ring is only needed to re-encrypt tokens when an organization key is
+	// rotated and to rewrap TEKs when the KEK is rotated. The remote provider is the key
+	// service client, which is set via SetKEKProvider.
+	var kekProvider types.KEKProvider
+	if cfg.KEKProvider == kek.ProviderRemote {
+		log.Printf("🔑 [Persistence] KEK held by the key service")
+	} else if kek.Configured(cfg) {
+		provider, err := kek.NewProvider(cfg)
+		if err != nil {
+			db.Close()
+			pgmqDB.Close()
+			return nil, fmt.Errorf("failed to load KEK: %w", err)
+		}
+		kekProvider = provider
+	} else {
+		log.Printf("ℹ️ [Persistence] KEK not configured, organization key rotation and TEK rewrapping disabled")
+	}
+
+	var shredSigningKey ed25519.PrivateKey
+	if cfg.ShredSigningKey != "" {
+		shredSigningKey, err = parseShredSigningKey(cfg.ShredSigningKey)
+		if err != nil {
+			db.Close()
+			pgmqDB.Close()
+			return nil, fmt.Errorf("failed to load SHRED_SIGNING_KEY: %w", err)
+		}
+	} else {
+		log.Printf("ℹ️ [Persistence] SHRED_SIGNING_KEY not configured, organization shredding disabled")
+	}
+
 	return &PersistenceService{
-		config:      cfg,
-		db:          db,
-		pgmqDB:      pgmqDB,
-		redisClient: redisClient,
-		stopCh:      make(chan struct{}),
+		config:       cfg,
+		db:           db,
+		pgmqDB:       pgmqDB,
+		redisClient:  redisClient,
+		limiter:      newLimiter(cfg, lockoutStore),
+		kekProvider:  kekProvider,
+		stopCh:       make(chan struct{}),
+		keyRotations: make(map[string]bool),
+
+		shredSigningKey: shredSigningKey,
 	}, nil
 }
 
+// SetAuditClient sets the audit service client used to report lockouts
+func (s *PersistenceService) SetAuditClient(client types.AuditServiceInterface) {
+	s.auditClient = client
+	if client != nil {
+		log.Printf("✅ [Persistence] Audit service client configured")
+	}
+}
+
+// SetKEKProvider sets the KEK provider when it is the key service client
+func (s *PersistenceService) SetKEKProvider(provider types.KEKProvider) {
+	s.kekProvider = provider
+	if provider != nil {
+		log.Printf("✅ [Persistence] KEK provider configured, current KEK: %s", provider.CurrentKEKID())
+	}
+}
+
 func (s *PersistenceService) Close() error {
 	log.Println("[Persistence] Closing database connections")
 	close(s.stopCh)
 
+	if s.kekProvider != nil {
+		if err := s.kekProvider.Close(); err != nil {
+			log.Printf("⚠️  [Persistence] Failed to close KEK provider: %v", err)
+		}
+	}
+
 	var storageErr, pgmqErr, redisErr error
 	if s.db != nil {
 		storageErr = s.db.Close()
@@ -205,7 +288,12 @@ func (s *PersistenceService) deleteMessage(ctx context.Context, msgID int64) err
 	return err
 }
 
-// storePIIToken inserts or updates a PII token in the persistent database
+// errTokenExists is returned by storePIIToken for an insert-only token whose reference
+// hash is already taken
+var errTokenExists = errors.New("token already exists")
+
+// storePIIToken inserts or updates a PII token in the persistent database. Insert-only
+// requests never replace an existing token and fail with errTokenExists instead.
 func (s *PersistenceService) storePIIToken(ctx context.Context, req *pb.StorePIITokenRequest) error {
 	log.Printf("[Persistence] Storing token: %s (org: %s)", req.ReferenceHash, req.OrganizationId)
 
@@ -227,10 +315,30 @@ func (s *PersistenceService) storePIIToken(ctx context.Context, req *pb.StorePII
 		metadataJSON = []byte("{}")
 	}
 
+	// Messages queued before TEKs were versioned were encrypted with the first version
+	tekVersion := req.TekVersion
+	if tekVersion == 0 {
+		tekVersion = 1
+	}
+	orgKeyVersion := req.OrgKeyVersion
+	if orgKeyVersion == 0 {
+		orgKeyVersion = 1
+	}
+	// Messages queued before token formats were recorded carry no additional authenticated data
+	formatVersion := req.FormatVersion
+	if formatVersion == 0 {
+		formatVersion = envelope.TokenFormatV2
+	}
+
 	query := `
-		INSERT INTO pii_tokens (reference_hash, encrypted_data, iv, data_type, client_id, organization_id, expires_at, metadata)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO pii_tokens (reference_hash, encrypted_data, iv, data_type, client_id, organization_id, expires_at, metadata, tek_version, org_key_version, format_version, key_salt, blind_index)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, ''))
 		ON CONFLICT (reference_hash) 
+	`
+	if req.InsertOnly {
+		query += `DO NOTHING`
+	} else {
+		query += `
 		DO UPDATE SET
 			encrypted_data = EXCLUDED.encrypted_data,
 			iv = EXCLUDED.iv,
@@ -238,10 +346,18 @@ func (s *PersistenceService) storePIIToken(ctx context.Context, req *pb.StorePII
 			client_id = EXCLUDED.client_id,
 			expires_at = EXCLUDED.expires_at,
 			metadata = EXCLUDED.metadata,
+			tek_version = EXCLUDED.tek_version,
+			org_key_version = EXCLUDED.org_key_version,
+			format_version = EXCLUDED.format_version,
+			key_salt = EXCLUDED.key_salt,
+			blind_index = EXCLUDED.blind_index,
+			previous_encrypted_data = NULL,
+			previous_iv = NULL,
 			updated_at = CURRENT_TIMESTAMP
-	`
+		`
+	}
 
-	_, err = s.db.ExecContext(ctx, query,
+	result, err := s.db.ExecContext(ctx, query,
 		req.ReferenceHash,
 		req.EncryptedData,
 		req.Iv,
@@ -250,10 +366,20 @@ func (s *PersistenceService) storePIIToken(ctx context.Context, req *pb.StorePII
 		req.OrganizationId,
 		expiresAt,
 		metadataJSON,
+		tekVersion,
+		orgKeyVersion,
+		formatVersion,
+		req.KeySalt,
+		req.BlindIndex,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert token: %w", err)
 	}
+	if req.InsertOnly {
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return errTokenExists
+		}
+	}
 
 	// Cache the token after successful storage
 	if s.redisClient != nil {
@@ -284,6 +410,10 @@ func (s *PersistenceService) cacheToken(ctx context.Context, req *pb.StorePIITok
 		"client_id":       req.ClientId,
 		"organization_id": req.OrganizationId,
 		"metadata":        req.Metadata,
+		"tek_version":     req.TekVersion,
+		"org_key_version": req.OrgKeyVersion,
+		"format_version":  req.FormatVersion,
+		"key_salt":        req.KeySalt, // []byte will be base64 encoded by json.Marshal
 	}
 
 	if req.CreatedAt != nil {
@@ -327,7 +457,13 @@ func (s *PersistenceService) cacheToken(ctx context.Context, req *pb.StorePIITok
 func (s *PersistenceService) StorePIIToken(ctx context.Context, req *pb.StorePIITokenRequest) (*pb.StorePIITokenResponse, error) {
 	log.Printf("[gRPC] StorePIIToken called for token: %s", req.ReferenceHash)
 
-	if err := s.storePIIToken(ctx, req); err != nil {
+	if err := s.storePIIToken(ctx, req); errors.Is(err, errTokenExists) {
+		return &pb.StorePIITokenResponse{
+			ReferenceHash: req.ReferenceHash,
+			Status:        "conflict",
+			ErrorMessage:  err.Error(),
+		}, nil
+	} else if err != nil {
 		return &pb.StorePIITokenResponse{
 			ReferenceHash: req.ReferenceHash,
 			Status:        "error",
@@ -422,9 +558,9 @@ func (s *PersistenceService) retrieveFromCache(ctx context.Context, hash string,
 	if ivStr, ok := cacheEntry["iv"].(string); ok {
 		// Decode base64 string back to []byte
 		if iv, err := base64.StdEncoding.DecodeString(ivStr); err == nil {
-			// Validate IV length for AES-GCM (must be 12 bytes)
-			if len(iv) != 12 {
-				log.Printf("⚠️  [Persistence] Invalid IV length in cache: %d bytes, expected 12", len(iv))
+			// Validate the IV length against the nonce sizes of the cipher suites
+			if !envelope.ValidNonceSize(len(iv)) {
+				log.Printf("⚠️  [Persistence] Invalid IV length in cache: %d bytes", len(iv))
 				// Remove corrupted cache entry
 				s.redisClient.Del(ctx, cacheKey)
 				return nil, fmt.Errorf("corrupted cache entry: invalid IV length")
@@ -442,6 +578,24 @@ func (s *PersistenceService) retrieveFromCache(ctx context.Context, hash string,
 	if clientId, ok := cacheEntry["client_id"].(string); ok {
 		response.ClientId = clientId
 	}
+	if tekVersion, ok := cacheEntry["tek_version"].(float64); ok {
+		response.TekVersion = int32(tekVersion)
+	}
+	if orgKeyVersion, ok := cacheEntry["org_key_version"].(float64); ok {
+		response.OrgKeyVersion = int32(orgKeyVersion)
+	}
+	if formatVersion, ok := cacheEntry["format_version"].(float64); ok {
+		response.FormatVersion = int32(formatVersion)
+	}
+	if keySaltStr, ok := cacheEntry["key_salt"].(string); ok {
+		keySalt, err := base64.StdEncoding.DecodeString(keySaltStr)
+		if err != nil {
+			log.Printf("⚠️  [Persistence] Failed to decode key salt from cache: %v", err)
+			s.redisClient.Del(ctx, cacheKey)
+			return nil, fmt.Errorf("corrupted cache entry: invalid key salt")
+		}
+		response.KeySalt = keySalt
+	}
 	if metadata, ok := cacheEntry["metadata"].(map[string]interface{}); ok {
 		// Convert map[string]interface{} to map[string]string
 		stringMetadata := make(map[string]string)
@@ -467,7 +621,8 @@ func (s *PersistenceService) retrieveFromCache(ctx context.Context, hash string,
 // retrieveFromDatabase retrieves a token from the persistent database
 func (s *PersistenceService) retrieveFromDatabase(ctx context.Context, req *pb.RetrievePIITokenRequest) (*pb.RetrievePIITokenResponse, error) {
 	query := `
-		SELECT encrypted_data, iv, data_type, client_id, created_at, metadata, expires_at
+		SELECT encrypted_data, iv, data_type, client_id, created_at, metadata, expires_at, tek_version,
+			org_key_version, previous_encrypted_data, previous_iv, format_version, key_salt
 		FROM pii_tokens
 		WHERE reference_hash = $1 AND organization_id = $2
 	`
@@ -476,9 +631,12 @@ func (s *PersistenceService) retrieveFromDatabase(ctx context.Context, req *pb.R
 	var dataType, clientId string
 	var createdAt, expiresAt *time.Time
 	var metadataJSON []byte
+	var tekVersion, orgKeyVersion, formatVersion int32
+	var previousEncryptedData, previousIV, keySalt []byte
 
 	err := s.db.QueryRowContext(ctx, query, req.ReferenceHash, req.OrganizationId).Scan(
-		&encryptedData, &iv, &dataType, &clientId, &createdAt, &metadataJSON, &expiresAt,
+		&encryptedData, &iv, &dataType, &clientId, &createdAt, &metadataJSON, &expiresAt, &tekVersion,
+		&orgKeyVersion, &previousEncryptedData, &previousIV, &formatVersion, &keySalt,
 	)
 	if err == sql.ErrNoRows {
 		log.Printf("[Persistence] Token not found: %s for org: %s", req.ReferenceHash, req.OrganizationId)
@@ -515,15 +673,21 @@ func (s *PersistenceService) retrieveFromDatabase(ctx context.Context, req *pb.R
 
 	log.Printf("[Persistence] Token found: %s", req.ReferenceHash)
 	response := &pb.RetrievePIITokenResponse{
-		ReferenceHash:  req.ReferenceHash,
-		EncryptedData:  encryptedData,
-		Iv:             iv,
-		DataType:       dataType,
-		ClientId:       clientId,
-		OrganizationId: req.OrganizationId,
-		Metadata:       metadata,
-		Status:         "success",
-		ErrorMessage:   "",
+		ReferenceHash:         req.ReferenceHash,
+		EncryptedData:         encryptedData,
+		Iv:                    iv,
+		DataType:              dataType,
+		ClientId:              clientId,
+		OrganizationId:        req.OrganizationId,
+		Metadata:              metadata,
+		TekVersion:            tekVersion,
+		OrgKeyVersion:         orgKeyVersion,
+		FormatVersion:         formatVersion,
+		KeySalt:               keySalt,
+		Status:                "success",
+		ErrorMessage:          "",
+		PreviousEncryptedData: previousEncryptedData,
+		PreviousIv:            previousIV,
 	}
 
 	if createdAt != nil {
@@ -536,6 +700,62 @@ func (s *PersistenceService) retrieveFromDatabase(ctx context.Context, req *pb.R
 	return response, nil
 }
 
+// LookupPIITokens is the gRPC endpoint for finding an organization's live tokens by blind index
+func (s *PersistenceService) LookupPIITokens(ctx context.Context, req *pb.LookupPIITokensRequest) (*pb.LookupPIITokensResponse, error) {
+	log.Printf("[gRPC] LookupPIITokens called for org: %s (%d blind indexes)", req.OrganizationId, len(req.BlindIndexes))
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT reference_hash, format_version, created_at, expires_at
+		FROM pii_tokens
+		WHERE organization_id = $1 AND data_type = $2 AND blind_index = ANY($3) AND expires_at > NOW()
+		ORDER BY created_at DESC
+		LIMIT $4
+	`, req.OrganizationId, req.DataType, pq.Array(req.BlindIndexes), limit)
+	if err != nil {
+		log.Printf("[Persistence] Database error looking up tokens: %v", err)
+		return &pb.LookupPIITokensResponse{
+			Status:       "error",
+			ErrorMessage: fmt.Sprintf("Database error: %v", err),
+		}, nil
+	}
+	defer rows.Close()
+
+	var tokens []*pb.TokenReference
+	for rows.Next() {
+		var referenceHash string
+		var formatVersion int32
+		var createdAt, expiresAt time.Time
+		if err := rows.Scan(&referenceHash, &formatVersion, &createdAt, &expiresAt); err != nil {
+			return &pb.LookupPIITokensResponse{
+				Status:       "error",
+				ErrorMessage: fmt.Sprintf("Database error: %v", err),
+			}, nil
+		}
+		tokens = append(tokens, &pb.TokenReference{
+			ReferenceHash: referenceHash,
+			FormatVersion: formatVersion,
+			CreatedAt:     timestamppb.New(createdAt),
+			ExpiresAt:     timestamppb.New(expiresAt),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return &pb.LookupPIITokensResponse{
+			Status:       "error",
+			ErrorMessage: fmt.Sprintf("Database error: %v", err),
+		}, nil
+	}
+
+	return &pb.LookupPIITokensResponse{
+		Tokens: tokens,
+		Status: "success",
+	}, nil
+}
+
 // HealthCheck is the gRPC endpoint for checking the health of the persistence service
 func (s *PersistenceService) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
 	log.Printf("[gRPC] HealthCheck called from: %s", req.ServiceName)
@@ -605,42 +825,59 @@ func (s *PersistenceService) StoreTEK(ctx context.Context, req *pb.StoreTEKReque
 		tekRecord.RotatedAt = &rotatedAt
 	}
 
-	// Persist to database
-	if err := s.saveTEKToDatabase(ctx, tekRecord); err != nil {
+	// Persist to database (insert-only; an existing TEK wins)
+	stored, created, err := s.saveTEKToDatabase(ctx, tekRecord)
+	if err != nil {
 		return &pb.StoreTEKResponse{
 			Status:       "error",
 			ErrorMessage: fmt.Sprintf("failed to save TEK to database: %v", err),
 		}, nil
 	}
 
-	log.Printf("[Persistence] TEK stored for organization: %s", req.OrganizationId)
+	if created {
+		log.Printf("[Persistence] TEK stored for organization: %s", req.OrganizationId)
+	} else {
+		log.Printf("[Persistence] TEK already exists for organization: %s, returning existing TEK", req.OrganizationId)
+	}
 
-	return &pb.StoreTEKResponse{
-		OrganizationId: req.OrganizationId,
+	response := &pb.StoreTEKResponse{
+		OrganizationId: stored.OrganizationID,
 		Status:         "success",
-	}, nil
+		Created:        created,
+		EncryptedTek:   stored.EncryptedTEK,
+		OrgKeyHash:     stored.OrgKeyHash,
+		CreatedAt:      timestamppb.New(stored.CreatedAt),
+		Version:        int32(stored.Version),
+	}
+
+	return response, nil
 }
 
 // RetrieveTEK retrieves a Tenant Encryption Key for an organization
 func (s *PersistenceService) RetrieveTEK(ctx context.Context, req *pb.RetrieveTEKRequest) (*pb.RetrieveTEKResponse, error) {
 	log.Printf("[gRPC] RetrieveTEK called for organization: %s", req.OrganizationId)
 
-	// Load TEK from database
-	tekRecord, err := s.loadTEKFromDatabase(ctx, req.OrganizationId, req.OrganizationKey)
+	// Refuse to verify keys while the organization or source is locked out
+	if err := s.checkLockout(ctx, req.OrganizationId, req.Source); err != nil {
+		return nil, types.TEKErrorStatus(err)
+	}
+
+	// Load TEK from database. Not-found and key-mismatch are reported with distinct
+	// gRPC codes so callers never mistake a wrong key for a missing TEK.
+	tekRecord, err := s.loadTEKFromDatabase(ctx, req.OrganizationId, req.OrganizationKey, int(req.Version))
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return &pb.RetrieveTEKResponse{
-				Status:       "error",
-				ErrorMessage: "TEK not found for organization",
-			}, nil
+		if errors.Is(err, types.ErrOrganizationKeyMismatch) {
+			log.Printf("⚠️  [Persistence] Organization key verification failed for organization: %s", req.OrganizationId)
+			s.recordKeyFailure(ctx, req.OrganizationId, req.Source)
+		} else if errors.Is(err, types.ErrOrganizationSuspended) {
+			log.Printf("⚠️  [Persistence] TEK requested for suspended organization: %s", req.OrganizationId)
+		} else if !errors.Is(err, types.ErrTEKNotFound) {
+			log.Printf("[Persistence] Failed to load TEK for organization %s: %v", req.OrganizationId, err)
 		}
-		return &pb.RetrieveTEKResponse{
-			Status:       "error",
-			ErrorMessage: fmt.Sprintf("failed to load TEK: %v", err),
-		}, nil
+		return nil, types.TEKErrorStatus(err)
 	}
 
-	log.Printf("[Persistence] TEK retrieved for organization: %s", req.OrganizationId)
+	log.Printf("[Persistence] TEK version %d retrieved for organization: %s", tekRecord.Version, req.OrganizationId)
 
 	response := &pb.RetrieveTEKResponse{
 		OrganizationId: tekRecord.OrganizationID,
@@ -648,7 +885,12 @@ func (s *PersistenceService) RetrieveTEK(ctx context.Context, req *pb.RetrieveTE
 		OrgKeyHash:     tekRecord.OrgKeyHash,
 		CreatedAt:      timestamppb.New(tekRecord.CreatedAt),
 		Version:        int32(tekRecord.Version),
+		OrgKeyVersion:  int32(tekRecord.OrgKeyVersion),
+		RetiringOrgKey: tekRecord.RetiringOrgKey,
+		CipherSuite:    tekRecord.CipherSuite,
 		Status:         "success",
+
+		DeterministicDataTypes: tekRecord.DeterministicDataTypes,
 	}
 
 	if tekRecord.RotatedAt != nil {
@@ -658,80 +900,210 @@ func (s *PersistenceService) RetrieveTEK(ctx context.Context, req *pb.RetrieveTE
 	return response, nil
 }
 
-// loadTEKFromDatabase retrieves a TEK from the database
-func (s *PersistenceService) loadTEKFromDatabase(ctx context.Context, organizationID string, orgKey string) (*types.OrganizationTEK, error) {
-	query := `
-		SELECT encrypted_tek, org_key_hash, created_at, rotated_at, version
-		FROM organization_teks
-		WHERE organization_id = $1 AND is_active = true
-	`
+// loadTEKFromDatabase retrieves a TEK from the database: the active version if version
+// is 0, otherwise the requested one. Unknown and suspended organizations are rejected
+// before the organization key is checked, and the key is always verified against the
+// hash on the active version. While a key rotation is in progress the previous key is
+// accepted too; the returned TEK then carries the previous key's hash and version.
+func (s *PersistenceService) loadTEKFromDatabase(ctx context.Context, organizationID string, orgKey string, version int) (*types.OrganizationTEK, error) {
+	settings, err := s.getOrganizationSettings(ctx, organizationID)
+	if err == sql.ErrNoRows {
+		return nil, types.ErrTEKNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if settings.status == types.OrganizationStatusShredded {
+		return nil, types.ErrTEKNotFound
+	}
+	if settings.status != types.OrganizationStatusActive {
+		return nil, types.ErrOrganizationSuspended
+	}
 
-	var encryptedTEK []byte
-	var orgKeyHash string
-	var createdAt time.Time
-	var rotatedAt *time.Time
-	var version int
+	tek, err := s.loadStoredTEK(ctx, organizationID)
+	if err == sql.ErrNoRows {
+		return nil, types.ErrTEKNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	err := s.db.QueryRowContext(ctx, query, organizationID).Scan(
-		&encryptedTEK,
-		&orgKeyHash,
-		&createdAt,
-		&rotatedAt,
-		&version,
-	)
+	// Verify the organization key matches the stored hash
+	ok, needsRehash, err := orgkey.Verify(orgKey, tek.OrgKeyHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify organization key: %w", err)
+	}
+	if !ok && tek.PreviousOrgKeyHash != "" {
+		ok, err = s.verifyPreviousOrgKey(tek, orgKey)
+		if err != nil {
+			return nil, err
+		}
+		needsRehash = false
+	}
+	if !ok {
+		return nil, types.ErrOrganizationKeyMismatch
+	}
+
+	// Upgrade legacy or outdated hashes now that we know the key
+	if needsRehash {
+		if upgraded, err := s.upgradeOrgKeyHash(ctx, organizationID, orgKey, tek.OrgKeyHash); err != nil {
+			log.Printf("⚠️  [Persistence] Failed to upgrade organization key hash for %s: %v", organizationID, err)
+		} else if upgraded != "" {
+			tek.OrgKeyHash = upgraded
+			log.Printf("[Persistence] Organization key hash upgraded for organization: %s", organizationID)
+		}
+	}
+
+	tek.CipherSuite = settings.cipherSuite
+	tek.DeterministicDataTypes = settings.deterministicDataTypes
 
+	if version == 0 || version == tek.Version {
+		return tek, nil
+	}
+
+	versioned, err := s.loadTEKVersion(ctx, organizationID, version)
+	if err == sql.ErrNoRows {
+		return nil, types.ErrTEKNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
+	versioned.OrgKeyHash = tek.OrgKeyHash
+	versioned.OrgKeyVersion = tek.OrgKeyVersion
+	versioned.PreviousOrgKeyHash = tek.PreviousOrgKeyHash
+	versioned.RetiringOrgKey = tek.RetiringOrgKey
+	versioned.CipherSuite = tek.CipherSuite
+	versioned.DeterministicDataTypes = tek.DeterministicDataTypes
+
+	return versioned, nil
+}
 
-	// Verify the organization key matches the stored hash
-	if !s.verifyOrganizationKey(orgKey, orgKeyHash) {
-		return nil, fmt.Errorf("organization key verification failed")
+// verifyPreviousOrgKey checks orgKey against the previous key of a rotation in progress.
+// On a match tek is switched to the previous key's hash and version.
+func (s *PersistenceService) verifyPreviousOrgKey(tek *types.OrganizationTEK, orgKey string) (bool, error) {
+	ok, _, err := orgkey.Verify(orgKey, tek.PreviousOrgKeyHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify previous organization key: %w", err)
+	}
+	if ok {
+		tek.OrgKeyHash = tek.PreviousOrgKeyHash
+		tek.OrgKeyVersion--
+		tek.RetiringOrgKey = true
 	}
+	return ok, nil
+}
 
-	return &types.OrganizationTEK{
-		OrganizationID: organizationID,
-		EncryptedTEK:   encryptedTEK,
-		OrgKeyHash:     orgKeyHash,
-		CreatedAt:      createdAt,
-		RotatedAt:      rotatedAt,
-		Version:        version,
-	}, nil
+// upgradeOrgKeyHash replaces a verified stored hash with a current one. The update only
+// applies if the stored hash is unchanged; it returns the new hash, or "" if another
+// request upgraded it first.
+func (s *PersistenceService) upgradeOrgKeyHash(ctx context.Context, organizationID string, orgKey string, oldHash string) (string, error) {
+	newHash, err := orgkey.Hash(orgKey)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE organization_teks SET org_key_hash = $3
+		WHERE organization_id = $1 AND is_active = true AND org_key_hash = $2
+	`, organizationID, oldHash, newHash)
+	if err != nil {
+		return "", err
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return "", err
+	}
+	if updated == 0 {
+		return "", nil
+	}
+
+	return newHash, nil
 }
 
-// saveTEKToDatabase persists a TEK to the database
-func (s *PersistenceService) saveTEKToDatabase(ctx context.Context, tek *types.OrganizationTEK) error {
+// saveTEKToDatabase persists a TEK to the database without ever replacing an existing one.
+// It returns the TEK stored for the organization and whether this call created it; on
+// conflict the previously stored (winning) TEK is returned so callers converge on one key.
+func (s *PersistenceService) saveTEKToDatabase(ctx context.Context, tek *types.OrganizationTEK) (*types.OrganizationTEK, bool, error) {
 	query := `
 		INSERT INTO organization_teks (organization_id, encrypted_tek, org_key_hash, created_at, version, is_active)
 		VALUES ($1, $2, $3, $4, $5, true)
-		ON CONFLICT (organization_id) 
-		DO UPDATE SET
-			encrypted_tek = EXCLUDED.encrypted_tek,
-			org_key_hash = EXCLUDED.org_key_hash,
-			rotated_at = NOW(),
-			version = organization_teks.version + 1,
-			is_active = true
+		ON CONFLICT DO NOTHING
 	`
 
-	_, err := s.db.ExecContext(ctx, query,
+	result, err := s.db.ExecContext(ctx, query,
 		tek.OrganizationID,
 		tek.EncryptedTEK,
 		tek.OrgKeyHash,
 		tek.CreatedAt,
 		tek.Version,
 	)
+	if err != nil {
+		return nil, false, err
+	}
 
-	return err
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Read back the stored row so both the winner and the losers see the same TEK
+	stored, err := s.loadStoredTEK(ctx, tek.OrganizationID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load stored TEK: %w", err)
+	}
+
+	return stored, inserted == 1, nil
+}
+
+// loadStoredTEK reads the active TEK row for an organization without verifying any key
+func (s *PersistenceService) loadStoredTEK(ctx context.Context, organizationID string) (*types.OrganizationTEK, error) {
+	query := `
+		SELECT encrypted_tek, org_key_hash, created_at, rotated_at, version, org_key_version,
+			COALESCE(previous_org_key_hash, '')
+		FROM organization_teks
+		WHERE organization_id = $1 AND is_active = true
+	`
+
+	tek := &types.OrganizationTEK{OrganizationID: organizationID}
+	err := s.db.QueryRowContext(ctx, query, organizationID).Scan(
+		&tek.EncryptedTEK,
+		&tek.OrgKeyHash,
+		&tek.CreatedAt,
+		&tek.RotatedAt,
+		&tek.Version,
+		&tek.OrgKeyVersion,
+		&tek.PreviousOrgKeyHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tek, nil
 }
 
-// verifyOrganizationKey validates the provided organization key against stored hash
-func (s *PersistenceService) verifyOrganizationKey(orgKey string, storedHash string) bool {
-	// Hash the provided key
-	providedHash := sha256.Sum256([]byte(orgKey))
-	providedHashStr := hex.EncodeToString(providedHash[:])
+// loadTEKVersion reads a specific TEK version for an organization, active or not,
+// without verifying any key
+func (s *PersistenceService) loadTEKVersion(ctx context.Context, organizationID string, version int) (*types.OrganizationTEK, error) {
+	query := `
+		SELECT encrypted_tek, org_key_hash, created_at, rotated_at, version
+		FROM organization_teks
+		WHERE organization_id = $1 AND version = $2
+	`
+
+	tek := &types.OrganizationTEK{OrganizationID: organizationID}
+	err := s.db.QueryRowContext(ctx, query, organizationID, version).Scan(
+		&tek.EncryptedTEK,
+		&tek.OrgKeyHash,
+		&tek.CreatedAt,
+		&tek.RotatedAt,
+		&tek.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	// Compare hashes
-	return providedHashStr == storedHash
+	return tek, nil
 }
 
 // containsMessages checks if a string contains "messages"
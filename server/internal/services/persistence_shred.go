@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbAudit "github.com/PlainFunction/mistokenly/proto/audit"
+	pb "github.com/PlainFunction/mistokenly/proto/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// shredRecordType identifies the version of the signed shred record
+const shredRecordType = "mistokenly/organization-shred/v1"
+
+// shredCacheScanCount is the number of cache keys examined per SCAN while purging
+const shredCacheScanCount = 1000
+
+// shredRecord is the signed statement of what a shred destroyed. It is serialized once
+// and the signature covers those exact bytes.
+type shredRecord struct {
+	Type                 string        `json:"type"`
+	OrganizationID       string        `json:"organization_id"`
+	ShreddedAt           string        `json:"shredded_at"`
+	RequestedBy          string        `json:"requested_by,omitempty"`
+	Reason               string        `json:"reason,omitempty"`
+	TEKVersions          []shreddedTEK `json:"tek_versions"`
+	TokensDeleted        int64         `json:"tokens_deleted"`
+	TokensRetained       int64         `json:"tokens_retained"` // Rows left behind, now undecryptable
+	CacheEntriesPurged   int64         `json:"cache_entries_purged"`
+	QueuedMessagesPurged int64         `json:"queued_messages_purged"`
+	CachePurgeError      string        `json:"cache_purge_error,omitempty"`
+	QueuePurgeError      string        `json:"queue_purge_error,omitempty"`
+}
+
+// shreddedTEK identifies a destroyed TEK version without revealing it. The digest of the
+// wrapped TEK lets an auditor match the version against copies in backups.
+type shreddedTEK struct {
+	Version          int    `json:"version"`
+	KEKID            string `json:"kek_id,omitempty"` // The KEK that wrapped it, if known
+	WrappedTEKSHA256 string `json:"wrapped_tek_sha256"`
+}
+
+// parseShredSigningKey decodes the base64 Ed25519 private key or seed that signs shred records
+func parseShredSigningKey(encoded string) (ed25519.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	switch len(raw) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(raw), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(raw), nil
+	default:
+		return nil, fmt.Errorf("expected a %d-byte seed or %d-byte private key, got %d bytes", ed25519.SeedSize, ed25519.PrivateKeySize, len(raw))
+	}
+}
+
+// ShredOrganization crypto-shreds an organization. Every TEK version is deleted in one
+// transaction with the status change, optionally together with the token rows; without
+// the TEKs no ciphertext of the organization can be decrypted again. Cached tokens and
+// queued token writes are purged afterwards, and a record of everything destroyed is
+// signed and written to the audit log. The record is returned so the caller can keep it.
+func (s *PersistenceService) ShredOrganization(ctx context.Context, req *pb.ShredOrganizationRequest) (*pb.ShredOrganizationResponse, error) {
+	log.Printf("[gRPC] ShredOrganization called for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if req.ConfirmOrganizationId != req.OrganizationId {
+		return nil, status.Error(codes.InvalidArgument, "confirm_organization_id must repeat organization_id")
+	}
+	if s.shredSigningKey == nil {
+		return nil, status.Error(codes.Unavailable, "shredding requires SHRED_SIGNING_KEY on the persistence service")
+	}
+
+	// A rotation that is still making progress would keep writing the organization's tokens
+	if s.isLocalKeyRotation(req.OrganizationId) {
+		return nil, status.Errorf(codes.Aborted, "a key rotation is in progress for organization %s", req.OrganizationId)
+	}
+	if rotation, err := s.getRunningKeyRotation(ctx, req.OrganizationId); err == nil {
+		if s.keyRotationStatus(rotation) == keyRotationRunning {
+			return nil, status.Errorf(codes.Aborted, "a key rotation is in progress for organization %s", req.OrganizationId)
+		}
+	} else if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to load key rotation: %v", err)
+	}
+
+	record := &shredRecord{
+		Type:           shredRecordType,
+		OrganizationID: req.OrganizationId,
+		RequestedBy:    req.RequestedBy,
+		Reason:         req.Reason,
+	}
+
+	org, err := s.destroyOrganizationKeys(ctx, req, record)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("🔥 [Persistence] Organization %s shredded: %d TEK versions destroyed, %d tokens deleted", req.OrganizationId, len(record.TEKVersions), record.TokensDeleted)
+
+	// Whatever is left in the queue or the cache is ciphertext under the destroyed TEKs,
+	// so a failed purge is recorded rather than failing the shred
+	var warnings []string
+	if purged, err := s.purgeQueuedTokens(ctx, req.OrganizationId); err != nil {
+		log.Printf("⚠️  [Persistence] Failed to purge queued tokens of shredded organization %s: %v", req.OrganizationId, err)
+		record.QueuePurgeError = err.Error()
+		warnings = append(warnings, "queue purge failed: "+err.Error())
+	} else {
+		record.QueuedMessagesPurged = purged
+	}
+	if purged, err := s.purgeCachedTokens(ctx, req.OrganizationId); err != nil {
+		log.Printf("⚠️  [Persistence] Failed to purge cached tokens of shredded organization %s: %v", req.OrganizationId, err)
+		record.CachePurgeError = err.Error()
+		warnings = append(warnings, "cache purge failed: "+err.Error())
+	} else {
+		record.CacheEntriesPurged = purged
+	}
+
+	recordJSON, err := json.Marshal(record)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode shred record: %v", err)
+	}
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(s.shredSigningKey, recordJSON))
+	publicKey := base64.StdEncoding.EncodeToString(s.shredSigningKey.Public().(ed25519.PublicKey))
+
+	auditID, err := s.logShredRecord(ctx, req, string(recordJSON), signature, publicKey)
+	if err != nil {
+		log.Printf("⚠️  [Persistence] Failed to audit shred of organization %s: %v", req.OrganizationId, err)
+		warnings = append(warnings, "audit log write failed: "+err.Error())
+	}
+
+	resp := &pb.ShredOrganizationResponse{
+		Organization:         org,
+		Record:               string(recordJSON),
+		Signature:            signature,
+		PublicKey:            publicKey,
+		TekVersionsDestroyed: int32(len(record.TEKVersions)),
+		TokensDeleted:        record.TokensDeleted,
+		CacheEntriesPurged:   record.CacheEntriesPurged,
+		QueuedMessagesPurged: record.QueuedMessagesPurged,
+		AuditId:              auditID,
+		Status:               "success",
+	}
+	if len(warnings) > 0 {
+		resp.ErrorMessage = strings.Join(warnings, "; ")
+	}
+
+	return resp, nil
+}
+
+// destroyOrganizationKeys deletes the organization's TEK versions, and its tokens if
+// requested, and marks it shredded in one transaction. The record is filled in with
+// what was destroyed.
+func (s *PersistenceService) destroyOrganizationKeys(ctx context.Context, req *pb.ShredOrganizationRequest, record *shredRecord) (*pb.Organization, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var orgStatus string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status FROM organizations WHERE organization_id = $1 FOR UPDATE
+	`, req.OrganizationId).Scan(&orgStatus)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "organization %s not found", req.OrganizationId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+	}
+	if orgStatus == types.OrganizationStatusShredded {
+		return nil, status.Errorf(codes.FailedPrecondition, "organization %s has already been shredded", req.OrganizationId)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		DELETE FROM organization_teks WHERE organization_id = $1
+		RETURNING version, encrypted_tek
+	`, req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to destroy TEKs: %v", err)
+	}
+	for rows.Next() {
+		var tek shreddedTEK
+		var encryptedTEK []byte
+		if err := rows.Scan(&tek.Version, &encryptedTEK); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to destroy TEKs: %v", err)
+		}
+		digest := sha256.Sum256(encryptedTEK)
+		tek.WrappedTEKSHA256 = hex.EncodeToString(digest[:])
+		if s.kekProvider != nil {
+			tek.KEKID = s.kekProvider.WrappedKEKID(encryptedTEK)
+		}
+		clear(encryptedTEK)
+		record.TEKVersions = append(record.TEKVersions, tek)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to destroy TEKs: %v", err)
+	}
+	sort.Slice(record.TEKVersions, func(i, j int) bool {
+		return record.TEKVersions[i].Version < record.TEKVersions[j].Version
+	})
+
+	if req.DeleteTokens {
+		result, err := tx.ExecContext(ctx, `DELETE FROM pii_tokens WHERE organization_id = $1`, req.OrganizationId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete tokens: %v", err)
+		}
+		if record.TokensDeleted, err = result.RowsAffected(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete tokens: %v", err)
+		}
+	} else if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM pii_tokens WHERE organization_id = $1
+	`, req.OrganizationId).Scan(&record.TokensRetained); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count tokens: %v", err)
+	}
+
+	// An interrupted key rotation can never be resumed without the TEKs
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE organization_key_rotations
+		SET status = $2, completed_at = NOW(), updated_at = NOW(), error_message = 'organization was shredded'
+		WHERE organization_id = $1 AND status = $3
+	`, req.OrganizationId, keyRotationCompleted, keyRotationRunning); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to close key rotation: %v", err)
+	}
+
+	shreddedAt := time.Now().UTC()
+	record.ShreddedAt = shreddedAt.Format(time.RFC3339Nano)
+
+	org, err := scanOrganization(tx.QueryRowContext(ctx, `
+		UPDATE organizations SET status = $2, shredded_at = $3, updated_at = $3
+		WHERE organization_id = $1
+		RETURNING `+organizationColumns, req.OrganizationId, types.OrganizationStatusShredded, shreddedAt))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit shred: %v", err)
+	}
+
+	return org, nil
+}
+
+// purgeQueuedTokens deletes the organization's token writes that are still waiting in
+// the persistence queue
+func (s *PersistenceService) purgeQueuedTokens(ctx context.Context, organizationID string) (int64, error) {
+	if s.pgmqDB == nil {
+		return 0, errors.New("PGMQ database not available")
+	}
+
+	result, err := s.pgmqDB.ExecContext(ctx, `
+		DELETE FROM pgmq.q_pii_token_persistence WHERE message->>'organization_id' = $1
+	`, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// purgeCachedTokens deletes the organization's cached tokens. Cache keys only name the
+// reference hash, so every token entry is scanned and matched on its organization.
+func (s *PersistenceService) purgeCachedTokens(ctx context.Context, organizationID string) (int64, error) {
+	if s.redisClient == nil {
+		return 0, nil
+	}
+
+	var purged int64
+	var cursor uint64
+	for {
+		keys, next, err := s.redisClient.Scan(ctx, cursor, "pii:token:*", shredCacheScanCount).Result()
+		if err != nil {
+			return purged, err
+		}
+
+		if len(keys) > 0 {
+			values, err := s.redisClient.MGet(ctx, keys...).Result()
+			if err != nil {
+				return purged, err
+			}
+
+			var matched []string
+			for i, value := range values {
+				data, ok := value.(string)
+				if !ok {
+					continue
+				}
+				var entry struct {
+					OrganizationID string `json:"organization_id"`
+				}
+				if json.Unmarshal([]byte(data), &entry) == nil && entry.OrganizationID == organizationID {
+					matched = append(matched, keys[i])
+				}
+			}
+
+			if len(matched) > 0 {
+				deleted, err := s.redisClient.Del(ctx, matched...).Result()
+				if err != nil {
+					return purged, err
+				}
+				purged += deleted
+			}
+		}
+
+		if next == 0 {
+			return purged, nil
+		}
+		cursor = next
+	}
+}
+
+// logShredRecord writes the signed shred record to the audit log and returns its audit ID
+func (s *PersistenceService) logShredRecord(ctx context.Context, req *pb.ShredOrganizationRequest, record, signature, publicKey string) (string, error) {
+	if s.auditClient == nil {
+		return "", errors.New("audit client not available")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.auditClient.LogAccess(ctx, &pbAudit.LogAccessRequest{
+		Operation:         "shred",
+		RequestingService: "persistence-service",
+		RequestingUser:    req.RequestedBy,
+		Purpose:           req.Reason,
+		Timestamp:         timestamppb.New(time.Now()),
+		Metadata: map[string]string{
+			"organization_id": req.OrganizationId,
+			"record":          record,
+			"signature":       signature,
+			"public_key":      publicKey,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.Status != "success" {
+		return "", errors.New(resp.ErrorMessage)
+	}
+	return resp.AuditId, nil
+}
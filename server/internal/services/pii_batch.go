@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/secret"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// maxBatchItems bounds the number of items in a TokenizeBatch or DetokenizeBatch request
+const maxBatchItems = 1000
+
+// TokenizeBatch tokenizes the items of a batch with the organization's active TEK, which
+// is unwrapped once. Reference tokens are queued for persistence with a single
+// pgmq.send_batch; format-preserving and deterministic ones are stored one by one as in
+// Tokenize. Each item gets its own result, in request order.
+func (s *PIIService) TokenizeBatch(ctx context.Context, req *pb.TokenizeBatchRequest) (*pb.TokenizeBatchResponse, error) {
+	log.Printf("[PIIService] Tokenizing batch of %d items for organization: %s", len(req.Items), req.OrganizationId)
+
+	if err := validateTokenizeBatchRequest(req); err != nil {
+		return &pb.TokenizeBatchResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	// Reject unknown and suspended organizations before doing any work
+	tek, err := s.getTEK(ctx, req.OrganizationId, req.OrganizationKey)
+	if err != nil {
+		if accessErr := tekAccessError(err); accessErr != nil {
+			log.Printf("❌ [PIIService] Batch tokenization refused for organization %s: %v", req.OrganizationId, err)
+			return nil, accessErr
+		}
+		log.Printf("❌ [PIIService] Failed to load TEK: %v", err)
+		return &pb.TokenizeBatchResponse{
+			Status:       "error",
+			ErrorMessage: "failed to load organization TEK",
+		}, nil
+	}
+
+	// A key being rotated out only reads; new tokens must be created under the new key
+	if tek.RetiringOrgKey {
+		return &pb.TokenizeBatchResponse{
+			Status:       "error",
+			ErrorMessage: "organization key is being rotated out; tokenize with the new organization key",
+		}, nil
+	}
+
+	encryptionKey, err := s.finalEncryptionKey(tek, req.OrganizationKey)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		log.Printf("❌ [PIIService] Batch tokenization refused for organization %s: %v", req.OrganizationId, err)
+		return nil, accessErr
+	}
+	if err != nil {
+		log.Printf("❌ [PIIService] Failed to derive encryption key: %v", err)
+		return &pb.TokenizeBatchResponse{
+			Status:       "error",
+			ErrorMessage: "failed to encrypt PII data",
+		}, nil
+	}
+	defer encryptionKey.Destroy()
+
+	results := make([]*pb.TokenizeResponse, len(req.Items))
+	var queued []*TokenRecord
+	for i, item := range req.Items {
+		itemReq := tokenizeBatchItemRequest(req, item)
+		if err := s.validateTokenizeRequest(itemReq); err != nil {
+			results[i] = &pb.TokenizeResponse{
+				Status:       "error",
+				ErrorMessage: err.Error(),
+			}
+			continue
+		}
+
+		record := s.newTokenRecord(itemReq, tek)
+
+		// Format-preserving and deterministic tokens are stored synchronously
+		var itemErr error
+		switch {
+		case itemReq.TokenFormat == tokenFormatFPE:
+			results[i], itemErr = s.tokenizeFormatPreserving(ctx, itemReq, record, tek)
+		case slices.Contains(tek.DeterministicDataTypes, itemReq.DataType):
+			results[i], itemErr = s.tokenizeDeterministic(ctx, itemReq, record, tek)
+		default:
+			results[i] = s.sealBatchToken(itemReq.Data, record, tek, encryptionKey)
+			if results[i].Status == "success" {
+				queued = append(queued, record)
+			}
+		}
+		if itemErr != nil {
+			return nil, itemErr
+		}
+	}
+
+	// Queue for durable persistence - asynchronous commit
+	if err := s.queueBatchForPersistence(ctx, queued); err != nil {
+		log.Printf("⚠️  [PIIService] Failed to queue batch for persistence: %v", err)
+		// Continue - async persistence failure shouldn't fail the request
+	}
+
+	// Log the reference tokens of the batch as one audit event
+	if len(queued) > 0 {
+		hashes := make([]string, len(queued))
+		for i, record := range queued {
+			hashes[i] = record.ReferenceHash
+		}
+		metadata := map[string]string{
+			"organization_id": req.OrganizationId,
+			"items":           strconv.Itoa(len(queued)),
+		}
+		s.logAuditEvent(ctx, "tokenize", strings.Join(hashes, ","), req.ClientId, metadata)
+	}
+
+	log.Printf("✅ [PIIService] Batch tokenization completed for %d items", len(req.Items))
+
+	return &pb.TokenizeBatchResponse{
+		Results: results,
+		Status:  "success",
+	}, nil
+}
+
+// sealBatchToken encrypts one reference token of a batch with the batch's encryption key
+func (s *PIIService) sealBatchToken(data string, record *TokenRecord, tek *types.OrganizationTEK, encryptionKey *secret.Buffer) *pb.TokenizeResponse {
+	referenceHash, err := s.generateReferenceHash()
+	if err != nil {
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to generate reference hash",
+		}
+	}
+	record.ReferenceHash = referenceHash
+
+	if err := sealPII(data, record, tek, encryptionKey); err != nil {
+		log.Printf("❌ [PIIService] Encryption failed: %v", err)
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to encrypt PII data",
+		}
+	}
+
+	return &pb.TokenizeResponse{
+		ReferenceHash: fmt.Sprintf("tok_%s", referenceHash),
+		TokenType:     envelope.TokenType(record.FormatVersion),
+		ExpiresAt:     timestamppb.New(record.ExpiresAt),
+		Status:        "success",
+	}
+}
+
+// tokenizeBatchItemRequest returns the tokenize request of one batch item
+func tokenizeBatchItemRequest(req *pb.TokenizeBatchRequest, item *pb.TokenizeBatchItem) *pb.TokenizeRequest {
+	retentionPolicy := item.RetentionPolicy
+	if retentionPolicy == "" {
+		retentionPolicy = req.RetentionPolicy
+	}
+
+	return &pb.TokenizeRequest{
+		Data:             item.Data,
+		DataType:         item.DataType,
+		RetentionPolicy:  retentionPolicy,
+		ClientId:         req.ClientId,
+		Metadata:         req.Metadata,
+		OrganizationId:   req.OrganizationId,
+		OrganizationKey:  req.OrganizationKey,
+		TokenFormat:      item.TokenFormat,
+		PreserveBin:      item.PreserveBin,
+		PreserveLastFour: item.PreserveLastFour,
+	}
+}
+
+// DetokenizeBatch detokenizes the tokens of a batch. Each TEK version the tokens were
+// encrypted with is unwrapped once, and the access is logged as one audit event. Each
+// token gets its own result, in request order.
+func (s *PIIService) DetokenizeBatch(ctx context.Context, req *pb.DetokenizeBatchRequest) (*pb.DetokenizeBatchResponse, error) {
+	log.Printf("[PIIService] Detokenizing batch of %d tokens for organization: %s", len(req.ReferenceHashes), req.OrganizationId)
+
+	if err := validateDetokenizeBatchRequest(req); err != nil {
+		return &pb.DetokenizeBatchResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	// Reject unknown and suspended organizations before touching the token store
+	tek, err := s.getTEK(ctx, req.OrganizationId, req.OrganizationKey)
+	if err != nil {
+		if accessErr := tekAccessError(err); accessErr != nil {
+			log.Printf("❌ [PIIService] Batch detokenization refused for organization %s: %v", req.OrganizationId, err)
+			return nil, accessErr
+		}
+		log.Printf("❌ [PIIService] Failed to load TEK: %v", err)
+		return &pb.DetokenizeBatchResponse{
+			Status:       "error",
+			ErrorMessage: "failed to load organization TEK",
+		}, nil
+	}
+
+	keys := &batchKeys{service: s, organizationID: req.OrganizationId, orgKey: req.OrganizationKey}
+	defer keys.destroy()
+
+	results := make([]*pb.DetokenizeResponse, len(req.ReferenceHashes))
+	var accessed []string
+	for i, token := range req.ReferenceHashes {
+		hashOnly := detokenizeReferenceHash(req.OrganizationId, token)
+		result, err := s.detokenizeBatchItem(ctx, token, hashOnly, tek, keys)
+		if err != nil {
+			log.Printf("❌ [PIIService] Batch detokenization refused for organization %s: %v", req.OrganizationId, err)
+			return nil, err
+		}
+		results[i] = result
+		if result.Status == "success" {
+			accessed = append(accessed, hashOnly)
+		}
+	}
+
+	// Log the detokenization access for audit/compliance
+	if len(accessed) > 0 {
+		metadata := map[string]string{
+			"purpose":            req.Purpose,
+			"requesting_user":    req.RequestingUser,
+			"requesting_service": req.RequestingService,
+			"organization_id":    req.OrganizationId,
+			"items":              strconv.Itoa(len(accessed)),
+		}
+		s.logAuditEvent(ctx, "detokenize", strings.Join(accessed, ","), req.RequestingService, metadata)
+	}
+
+	log.Printf("✅ [PIIService] Batch detokenization completed for %d tokens", len(req.ReferenceHashes))
+
+	return &pb.DetokenizeBatchResponse{
+		Results: results,
+		Status:  "success",
+	}, nil
+}
+
+// detokenizeBatchItem detokenizes one token of a batch. It returns an error only when
+// the organization may not be used, which fails the whole batch.
+func (s *PIIService) detokenizeBatchItem(ctx context.Context, token, hashOnly string, tek *types.OrganizationTEK, keys *batchKeys) (*pb.DetokenizeResponse, error) {
+	if token == "" {
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "referenceHash field is required",
+		}, nil
+	}
+
+	tokenRecord, err := s.retrieveFromDatabase(ctx, hashOnly, keys.organizationID)
+	if err != nil {
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "token not found",
+		}, nil
+	}
+
+	if time.Now().After(tokenRecord.ExpiresAt) {
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "token has expired",
+		}, nil
+	}
+
+	// Pick the ciphertext that belongs to the presented organization key
+	ciphertext, iv, err := selectCiphertext(tokenRecord, tek.OrgKeyVersion)
+	if err != nil {
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	decryptedData, err := keys.open(ctx, ciphertext, iv, tokenRecord)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		return nil, accessErr
+	}
+	if err != nil {
+		log.Printf("❌ [PIIService] Decryption failed: %v", err)
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to decrypt PII data - invalid organization key",
+		}, nil
+	}
+
+	return &pb.DetokenizeResponse{
+		Data:              decryptedData,
+		DataType:          tokenRecord.DataType,
+		OriginalTimestamp: timestamppb.New(tokenRecord.CreatedAt),
+		AccessLogged:      true,
+		Status:            "success",
+	}, nil
+}
+
+// batchKeys derives an organization's final encryption keys once per TEK version for the
+// tokens of a batch. The keys are destroyed with the batch.
+type batchKeys struct {
+	service        *PIIService
+	organizationID string
+	orgKey         string
+	keys           map[int]*secret.Buffer
+}
+
+// open decrypts one of a record's ciphertexts like decryptPIIWithEnvelope, reusing the
+// key of its TEK version
+func (k *batchKeys) open(ctx context.Context, ciphertext, iv []byte, record *TokenRecord) (string, error) {
+	tekVersion, err := envelope.TokenKeyVersion(record.FormatVersion, ciphertext, record.TEKVersion)
+	if err != nil {
+		return "", err
+	}
+
+	encryptionKey, ok := k.keys[tekVersion]
+	if !ok {
+		// Decryption never provisions a new TEK
+		tekRecord, err := k.service.getTEKVersion(ctx, k.organizationID, k.orgKey, tekVersion)
+		if err != nil {
+			return "", fmt.Errorf("failed to get TEK: %w", err)
+		}
+		if encryptionKey, err = k.service.finalEncryptionKey(tekRecord, k.orgKey); err != nil {
+			return "", err
+		}
+		if k.keys == nil {
+			k.keys = make(map[int]*secret.Buffer)
+		}
+		k.keys[tekVersion] = encryptionKey
+	}
+
+	return openPII(ciphertext, iv, record, encryptionKey)
+}
+
+// destroy wipes every key derived for the batch
+func (k *batchKeys) destroy() {
+	for _, key := range k.keys {
+		key.Destroy()
+	}
+}
+
+// validateTokenizeBatchRequest validates the fields a batch shares; items are
+// validated one by one
+func validateTokenizeBatchRequest(req *pb.TokenizeBatchRequest) error {
+	if len(req.Items) == 0 {
+		return fmt.Errorf("items field is required")
+	}
+	if len(req.Items) > maxBatchItems {
+		return fmt.Errorf("a batch may have at most %d items", maxBatchItems)
+	}
+	if req.ClientId == "" {
+		return fmt.Errorf("clientId field is required")
+	}
+	if req.OrganizationId == "" {
+		return fmt.Errorf("organizationId field is required for envelope encryption")
+	}
+	if req.OrganizationKey == "" {
+		return fmt.Errorf("organizationKey is required for envelope encryption")
+	}
+
+	return nil
+}
+
+// validateDetokenizeBatchRequest validates the fields a batch shares; tokens are
+// validated one by one
+func validateDetokenizeBatchRequest(req *pb.DetokenizeBatchRequest) error {
+	if len(req.ReferenceHashes) == 0 {
+		return fmt.Errorf("referenceHashes field is required")
+	}
+	if len(req.ReferenceHashes) > maxBatchItems {
+		return fmt.Errorf("a batch may have at most %d tokens", maxBatchItems)
+	}
+	if req.Purpose == "" {
+		return fmt.Errorf("purpose field is required")
+	}
+	if req.RequestingService == "" {
+		return fmt.Errorf("requestingService field is required")
+	}
+	if req.OrganizationId == "" {
+		return fmt.Errorf("organizationId field is required for decryption")
+	}
+	if req.OrganizationKey == "" {
+		return fmt.Errorf("organizationKey is required for decryption")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// tokenizeDeterministic completes Tokenize for a data type the organization tokenizes
+// deterministically. The reference hash is an HMAC of the normalized value, and the
+// token is stored synchronously without replacing an existing one: if the value was
+// tokenized before, the earlier token is returned with its original expiry.
+func (s *PIIService) tokenizeDeterministic(ctx context.Context, req *pb.TokenizeRequest, record *TokenRecord, tek *types.OrganizationTEK) (*pb.TokenizeResponse, error) {
+	existing, err := s.storeDeterministicToken(ctx, req, record, tek)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		log.Printf("❌ [PIIService] Tokenization refused for organization %s: %v", req.OrganizationId, err)
+		return nil, accessErr
+	}
+	if err != nil {
+		log.Printf("❌ [PIIService] Deterministic tokenization failed: %v", err)
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to create deterministic token",
+		}, nil
+	}
+
+	s.logAuditEvent(ctx, "tokenize", record.ReferenceHash, req.ClientId, req.Metadata)
+
+	if existing != nil {
+		log.Printf("✅ [PIIService] Deterministic tokenization returned existing token")
+		record = existing
+	} else {
+		log.Printf("✅ [PIIService] Deterministic tokenization successful")
+	}
+
+	return &pb.TokenizeResponse{
+		ReferenceHash: fmt.Sprintf("tok_%s", record.ReferenceHash),
+		TokenType:     envelope.TokenType(record.FormatVersion),
+		ExpiresAt:     timestamppb.New(record.ExpiresAt),
+		Status:        "success",
+		Existing:      existing != nil,
+	}, nil
+}
+
+// storeDeterministicToken encrypts and stores the record under its deterministic reference
+// hash. It returns the stored record instead when the value already has a live token;
+// an expired one is replaced.
+func (s *PIIService) storeDeterministicToken(ctx context.Context, req *pb.TokenizeRequest, record *TokenRecord, tek *types.OrganizationTEK) (*TokenRecord, error) {
+	if s.persistenceClient == nil {
+		return nil, fmt.Errorf("deterministic tokens require the persistence service")
+	}
+
+	encryptionKey, err := s.finalEncryptionKey(tek, req.OrganizationKey)
+	if err != nil {
+		return nil, err
+	}
+	deterministicKey, err := envelope.DeriveDeterministicKey(encryptionKey.Bytes(), req.DataType)
+	encryptionKey.Destroy()
+	if err != nil {
+		return nil, err
+	}
+
+	record.ReferenceHash = envelope.DeterministicReferenceHash(deterministicKey, normalizePII(req.DataType, req.Data))
+	clear(deterministicKey)
+	if err := s.encryptPIIWithEnvelope(ctx, req.Data, record, req.OrganizationKey); err != nil {
+		return nil, err
+	}
+
+	storeReq := persistenceRequest(record)
+	storeReq.InsertOnly = true
+	resp, err := s.persistenceClient.StorePIIToken(ctx, storeReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store token: %w", err)
+	}
+	switch resp.Status {
+	case "success":
+		return nil, nil
+	case "conflict":
+	default:
+		return nil, fmt.Errorf("failed to store token: %s", resp.ErrorMessage)
+	}
+
+	existing, err := s.retrieveFromDatabase(ctx, record.ReferenceHash, record.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().Before(existing.ExpiresAt) {
+		return existing, nil
+	}
+
+	// The value's token has expired but not been purged yet; issue it afresh
+	storeReq.InsertOnly = false
+	resp, err = s.persistenceClient.StorePIIToken(ctx, storeReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store token: %w", err)
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("failed to store token: %s", resp.ErrorMessage)
+	}
+	return nil, nil
+}
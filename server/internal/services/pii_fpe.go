@@ -0,0 +1,369 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/ff1"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// Token formats a client can request from Tokenize
+const (
+	tokenFormatReference = "reference" // tok_<hash>, the default
+	tokenFormatFPE       = "fpe"       // Same digits and punctuation as the input, encrypted with FF1
+)
+
+// fpeTokenType is the token type reported for format-preserving tokens
+const fpeTokenType = "PII_TOKEN_FPE_FF1"
+
+const (
+	// fpeMaxAttempts bounds how many tweaks Tokenize tries before giving up on a token
+	// whose reference hash is taken
+	fpeMaxAttempts = 16
+
+	// fpeMinEncryptedDigits is the fewest digits FF1 may encrypt: 10^6 is ff1.MinDomainSize
+	fpeMinEncryptedDigits = 6
+
+	binLength      = 6
+	lastFourLength = 4
+)
+
+// fpeDigitCounts is the number of digits a value of each format-preserving data type may have
+var fpeDigitCounts = map[string]struct{ min, max int }{
+	"credit_card": {12, 19},
+	"ssn":         {9, 9},
+	"phone":       {7, 15}, // E.164 allows at most 15 digits
+}
+
+// fpeSeparators are the formatting characters kept in place around the digits
+const fpeSeparators = " -.()+/"
+
+var errFPETokenUnavailable = errors.New("no unused format-preserving token found; retry or use a reference token")
+
+// fpeValue is a value to tokenize with format-preserving encryption
+type fpeValue struct {
+	dataType  string
+	template  string // The input; its digits are replaced by the token's
+	digits    string
+	prefixLen int // Leading digits kept as they are (the BIN)
+	suffixLen int // Trailing digits kept as they are (the last four)
+}
+
+// parseFPEValue validates a tokenize request for a format-preserving token and splits
+// its data into digits and formatting
+func parseFPEValue(req *pb.TokenizeRequest) (*fpeValue, error) {
+	counts, ok := fpeDigitCounts[req.DataType]
+	if !ok {
+		return nil, fmt.Errorf("tokenFormat %q supports credit_card, ssn and phone, not %s", tokenFormatFPE, req.DataType)
+	}
+	if req.PreserveBin && req.DataType != "credit_card" {
+		return nil, fmt.Errorf("preserveBin is only supported for credit_card")
+	}
+
+	digits, ok := fpeTokenDigits(req.Data)
+	if !ok {
+		return nil, fmt.Errorf("%s may only contain digits and the separators %q", req.DataType, fpeSeparators)
+	}
+	if len(digits) < counts.min || len(digits) > counts.max {
+		if counts.min == counts.max {
+			return nil, fmt.Errorf("%s must have %d digits", req.DataType, counts.min)
+		}
+		return nil, fmt.Errorf("%s must have between %d and %d digits", req.DataType, counts.min, counts.max)
+	}
+	if req.DataType == "credit_card" && !luhnValid(digits) {
+		return nil, fmt.Errorf("credit_card fails the Luhn check")
+	}
+
+	value := &fpeValue{dataType: req.DataType, template: req.Data, digits: digits}
+	if req.PreserveBin {
+		value.prefixLen = binLength
+	}
+	if req.PreserveLastFour {
+		value.suffixLen = lastFourLength
+	}
+	if len(digits)-value.prefixLen-value.suffixLen < fpeMinEncryptedDigits {
+		return nil, fmt.Errorf("at least %d digits of the %s must be encrypted; preserve fewer digits", fpeMinEncryptedDigits, req.DataType)
+	}
+
+	return value, nil
+}
+
+// tweak returns the FF1 tweak of an attempt: the data type, the preserved digits and
+// the attempt number, so preserved digits are bound to the encrypted ones
+func (v *fpeValue) tweak(attempt int) []byte {
+	prefix := v.digits[:v.prefixLen]
+	suffix := v.digits[len(v.digits)-v.suffixLen:]
+
+	tweak := make([]byte, 0, 16+len(v.dataType)+len(prefix)+len(suffix))
+	for _, field := range []string{v.dataType, prefix, suffix} {
+		tweak = binary.BigEndian.AppendUint32(tweak, uint32(len(field)))
+		tweak = append(tweak, field...)
+	}
+	return binary.BigEndian.AppendUint32(tweak, uint32(attempt))
+}
+
+// encrypt returns the token digits of an attempt. Card numbers are cycle-walked: the
+// encrypted digits are encrypted again until the whole number passes the Luhn check,
+// which ends because FF1 is a permutation and the input passes it.
+func (v *fpeValue) encrypt(cipher *ff1.Cipher, attempt int) (string, error) {
+	prefix := v.digits[:v.prefixLen]
+	suffix := v.digits[len(v.digits)-v.suffixLen:]
+	middle := v.digits[v.prefixLen : len(v.digits)-v.suffixLen]
+	tweak := v.tweak(attempt)
+
+	for {
+		var err error
+		if middle, err = cipher.Encrypt(middle, tweak); err != nil {
+			return "", err
+		}
+		token := prefix + middle + suffix
+		if v.dataType != "credit_card" || luhnValid(token) {
+			return token, nil
+		}
+	}
+}
+
+// format places token digits into the input's formatting
+func (v *fpeValue) format(tokenDigits string) string {
+	out := []byte(v.template)
+	next := 0
+	for i := range out {
+		if out[i] >= '0' && out[i] <= '9' {
+			out[i] = tokenDigits[next]
+			next++
+		}
+	}
+	return string(out)
+}
+
+// tokenizeFormatPreserving completes Tokenize for tokenFormat "fpe"
+func (s *PIIService) tokenizeFormatPreserving(ctx context.Context, req *pb.TokenizeRequest, record *TokenRecord, tek *types.OrganizationTEK) (*pb.TokenizeResponse, error) {
+	value, err := parseFPEValue(req)
+	if err != nil {
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	token, err := s.tokenizeFPE(ctx, value, record, tek, req.OrganizationKey)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		log.Printf("❌ [PIIService] Tokenization refused for organization %s: %v", req.OrganizationId, err)
+		return nil, accessErr
+	}
+	if errors.Is(err, errFPETokenUnavailable) {
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		log.Printf("❌ [PIIService] Format-preserving tokenization failed: %v", err)
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to create format-preserving token",
+		}, nil
+	}
+
+	s.logAuditEvent(ctx, "tokenize", record.ReferenceHash, req.ClientId, req.Metadata)
+
+	log.Printf("✅ [PIIService] Format-preserving tokenization successful")
+
+	return &pb.TokenizeResponse{
+		ReferenceHash: token,
+		TokenType:     fpeTokenType,
+		ExpiresAt:     timestamppb.New(record.ExpiresAt),
+		Status:        "success",
+	}, nil
+}
+
+// tokenizeFPE encrypts the record's value into a format-preserving token and stores the
+// record under the token's reference hash. Tokens are stored synchronously and never
+// replace another value's token: when the hash is taken by one, the next tweak is tried.
+// A value that already has a live token under the same keys gets that token back, with
+// its original expiry in the record.
+func (s *PIIService) tokenizeFPE(ctx context.Context, value *fpeValue, record *TokenRecord, tek *types.OrganizationTEK, orgKey string) (string, error) {
+	if s.persistenceClient == nil {
+		return "", fmt.Errorf("format-preserving tokens require the persistence service")
+	}
+
+	cipher, err := s.fpeCipher(tek, orgKey, value.dataType)
+	if err != nil {
+		return "", err
+	}
+
+	for attempt := 0; attempt < fpeMaxAttempts; attempt++ {
+		tokenDigits, err := value.encrypt(cipher, attempt)
+		if err != nil {
+			return "", fmt.Errorf("failed to encrypt with FF1: %w", err)
+		}
+		if tokenDigits == value.digits {
+			continue
+		}
+
+		record.ReferenceHash = envelope.FPEReferenceHash(record.OrganizationID, tokenDigits)
+		if err := s.encryptPIIWithEnvelope(ctx, value.template, record, orgKey); err != nil {
+			return "", err
+		}
+		// The token's digits are not stored, so a lookup could not return it
+		record.BlindIndex = ""
+
+		req := persistenceRequest(record)
+		req.InsertOnly = true
+		resp, err := s.persistenceClient.StorePIIToken(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to store token: %w", err)
+		}
+		switch resp.Status {
+		case "success":
+			return value.format(tokenDigits), nil
+		case "conflict":
+		default:
+			return "", fmt.Errorf("failed to store token: %s", resp.ErrorMessage)
+		}
+
+		existing, err := s.fpeExistingToken(ctx, value, record.ReferenceHash, tek, orgKey)
+		if err != nil {
+			return "", err
+		}
+		if existing == nil {
+			log.Printf("[PIIService] Format-preserving token attempt %d is taken, trying the next tweak", attempt)
+			continue
+		}
+		if time.Now().Before(existing.ExpiresAt) {
+			record.ExpiresAt = existing.ExpiresAt
+			return value.format(tokenDigits), nil
+		}
+
+		// The value's token has expired but not been purged yet; issue it afresh
+		req.InsertOnly = false
+		resp, err = s.persistenceClient.StorePIIToken(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to store token: %w", err)
+		}
+		if resp.Status != "success" {
+			return "", fmt.Errorf("failed to store token: %s", resp.ErrorMessage)
+		}
+		return value.format(tokenDigits), nil
+	}
+
+	return "", errFPETokenUnavailable
+}
+
+// fpeExistingToken returns the token stored under a taken reference hash if it holds the
+// same digits as the value, and nil if it holds another value or cannot be read with the
+// presented organization key
+func (s *PIIService) fpeExistingToken(ctx context.Context, value *fpeValue, hash string, tek *types.OrganizationTEK, orgKey string) (*TokenRecord, error) {
+	existing, err := s.retrieveFromDatabase(ctx, hash, tek.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.DataType != value.dataType {
+		return nil, nil
+	}
+
+	ciphertext, iv, err := selectCiphertext(existing, tek.OrgKeyVersion)
+	if err != nil {
+		return nil, nil
+	}
+	data, err := s.decryptPIIWithEnvelope(ctx, ciphertext, iv, existing, orgKey)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, nil
+	}
+
+	if digits, ok := fpeTokenDigits(data); !ok || digits != value.digits {
+		return nil, nil
+	}
+	return existing, nil
+}
+
+// fpeCipher returns FF1 keyed for the data type from the organization's TEK and key
+func (s *PIIService) fpeCipher(tekRecord *types.OrganizationTEK, orgKey, dataType string) (*ff1.Cipher, error) {
+	encryptionKey, err := s.finalEncryptionKey(tekRecord, orgKey)
+	if err != nil {
+		return nil, err
+	}
+	defer encryptionKey.Destroy()
+
+	fpeKey, err := envelope.DeriveFPEKey(encryptionKey.Bytes(), dataType)
+	if err != nil {
+		return nil, err
+	}
+	// The cipher expands its own key schedule, so the derived key can go right away
+	defer clear(fpeKey)
+
+	return ff1.New(fpeKey, 10)
+}
+
+// detokenizeReferenceHash returns the reference hash a presented token is stored under:
+// the hash itself for tok_ tokens, the hash of the digits for format-preserving ones
+func detokenizeReferenceHash(organizationID, token string) string {
+	hashOnly := strings.TrimPrefix(token, "tok_")
+	if isReferenceHash(hashOnly) {
+		return hashOnly
+	}
+	if digits, ok := fpeTokenDigits(token); ok && len(digits) > 0 {
+		return envelope.FPEReferenceHash(organizationID, digits)
+	}
+	return hashOnly
+}
+
+// isReferenceHash reports whether s has the form of a random reference hash: 32
+// lowercase hex characters. Format-preserving tokens have at most 19 digits.
+func isReferenceHash(s string) bool {
+	if len(s) != 32 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !(s[i] >= '0' && s[i] <= '9' || s[i] >= 'a' && s[i] <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// fpeTokenDigits returns the digits of a value made of digits and fpeSeparators
+func fpeTokenDigits(value string) (string, bool) {
+	var digits strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch ch := value[i]; {
+		case ch >= '0' && ch <= '9':
+			digits.WriteByte(ch)
+		case strings.IndexByte(fpeSeparators, ch) >= 0:
+		default:
+			return "", false
+		}
+	}
+	return digits.String(), true
+}
+
+// luhnValid reports whether a digit string passes the Luhn check
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// RewrapTEKs asks the persistence service to rewrap every stored TEK under the current
+// KEK. Replicas of this service must carry the same KEK ring so they can unwrap TEKs
+// under either KEK while the job runs.
+func (s *PIIService) RewrapTEKs(ctx context.Context, req *pb.RewrapTEKsRequest) (*pb.RewrapTEKsResponse, error) {
+	log.Printf("[PIIService] Rewrapping TEKs under the current KEK")
+
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.RewrapTEKs(ctx, &pbPersistence.RewrapTEKsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RewrapTEKsResponse{
+		Job:    toPIIRewrapJob(resp.Job),
+		Status: "success",
+	}, nil
+}
+
+// GetKEKStatus reports how many stored TEKs each KEK still wraps
+func (s *PIIService) GetKEKStatus(ctx context.Context, req *pb.GetKEKStatusRequest) (*pb.GetKEKStatusResponse, error) {
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.GetKEKStatus(ctx, &pbPersistence.GetKEKStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	references := make([]*pb.KEKReference, 0, len(resp.References))
+	for _, ref := range resp.References {
+		references = append(references, &pb.KEKReference{
+			KekId:  ref.KekId,
+			Teks:   ref.Teks,
+			InRing: ref.InRing,
+		})
+	}
+
+	return &pb.GetKEKStatusResponse{
+		CurrentKekId:  resp.CurrentKekId,
+		References:    references,
+		RemainingTeks: resp.RemainingTeks,
+		Job:           toPIIRewrapJob(resp.Job),
+		Status:        "success",
+	}, nil
+}
+
+// toPIIRewrapJob converts a persistence KEKRewrapJob message to its PII service counterpart
+func toPIIRewrapJob(job *pbPersistence.KEKRewrapJob) *pb.KEKRewrapJob {
+	if job == nil {
+		return nil
+	}
+	return &pb.KEKRewrapJob{
+		Status:        job.Status,
+		TotalTeks:     job.TotalTeks,
+		RewrappedTeks: job.RewrappedTeks,
+		FailedTeks:    job.FailedTeks,
+		StartedAt:     job.StartedAt,
+		CompletedAt:   job.CompletedAt,
+		ErrorMessage:  job.ErrorMessage,
+	}
+}
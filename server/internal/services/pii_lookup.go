@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// LookupToken returns the live tokens of a known value. The value is matched through
+// its blind index, a keyed HMAC of the normalized value that is stored next to each
+// token, so the caller must present the organization key and the server keeps nothing
+// it could reverse.
+func (s *PIIService) LookupToken(ctx context.Context, req *pb.LookupTokenRequest) (*pb.LookupTokenResponse, error) {
+	log.Printf("[PIIService] Looking up %s token for organization: %s", req.DataType, req.OrganizationId)
+
+	if err := s.validateLookupTokenRequest(req); err != nil {
+		return &pb.LookupTokenResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	blindIndexes, err := s.blindIndexes(ctx, req)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		log.Printf("❌ [PIIService] Lookup refused for organization %s: %v", req.OrganizationId, err)
+		return nil, accessErr
+	}
+	if err != nil {
+		log.Printf("❌ [PIIService] Failed to compute blind indexes: %v", err)
+		return &pb.LookupTokenResponse{
+			Status:       "error",
+			ErrorMessage: "failed to load organization TEK",
+		}, nil
+	}
+
+	resp, err := s.persistenceClient.LookupPIITokens(ctx, &pbPersistence.LookupPIITokensRequest{
+		OrganizationId: req.OrganizationId,
+		DataType:       req.DataType,
+		BlindIndexes:   blindIndexes,
+	})
+	if err == nil && resp.Status != "success" {
+		err = errors.New(resp.ErrorMessage)
+	}
+	if err != nil {
+		log.Printf("❌ [PIIService] Token lookup failed: %v", err)
+		return &pb.LookupTokenResponse{
+			Status:       "error",
+			ErrorMessage: "failed to look up tokens",
+		}, nil
+	}
+
+	matches := make([]*pb.TokenMatch, 0, len(resp.Tokens))
+	for _, token := range resp.Tokens {
+		matches = append(matches, &pb.TokenMatch{
+			ReferenceHash: fmt.Sprintf("tok_%s", token.ReferenceHash),
+			TokenType:     envelope.TokenType(int(token.FormatVersion)),
+			CreatedAt:     token.CreatedAt,
+			ExpiresAt:     token.ExpiresAt,
+		})
+	}
+
+	// Log the lookup for audit/compliance; the value itself is never logged
+	metadata := map[string]string{
+		"purpose":            req.Purpose,
+		"requesting_user":    req.RequestingUser,
+		"requesting_service": req.RequestingService,
+		"organization_id":    req.OrganizationId,
+		"data_type":          req.DataType,
+		"matches":            fmt.Sprintf("%d", len(matches)),
+	}
+	s.logAuditEvent(ctx, "lookup", "", req.RequestingService, metadata)
+
+	log.Printf("✅ [PIIService] Lookup found %d tokens", len(matches))
+
+	return &pb.LookupTokenResponse{
+		Tokens: matches,
+		Status: "success",
+	}, nil
+}
+
+// blindIndexes returns the blind index of the request's value under each of the
+// organization's TEK versions, since tokens keep the version they were created with
+func (s *PIIService) blindIndexes(ctx context.Context, req *pb.LookupTokenRequest) ([]string, error) {
+	if s.persistenceClient == nil {
+		return nil, fmt.Errorf("persistence service client not available")
+	}
+
+	active, err := s.getTEK(ctx, req.OrganizationId, req.OrganizationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	value := normalizePII(req.DataType, req.Data)
+	var indexes []string
+	for version := 1; version <= active.Version; version++ {
+		tek := active
+		if version != active.Version {
+			if tek, err = s.getTEKVersion(ctx, req.OrganizationId, req.OrganizationKey, version); errors.Is(err, types.ErrTEKNotFound) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		encryptionKey, err := s.finalEncryptionKey(tek, req.OrganizationKey)
+		if err != nil {
+			return nil, err
+		}
+		blindIndexKey, err := envelope.DeriveBlindIndexKey(encryptionKey.Bytes(), req.DataType)
+		encryptionKey.Destroy()
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, envelope.BlindIndex(blindIndexKey, value))
+		clear(blindIndexKey)
+	}
+
+	return indexes, nil
+}
+
+// validateLookupTokenRequest validates a lookup request
+func (s *PIIService) validateLookupTokenRequest(req *pb.LookupTokenRequest) error {
+	if req.Data == "" {
+		return fmt.Errorf("data field is required")
+	}
+	if !validDataTypes[req.DataType] {
+		return fmt.Errorf("invalid dataType: %s", req.DataType)
+	}
+	if req.Purpose == "" {
+		return fmt.Errorf("purpose field is required")
+	}
+	if req.RequestingService == "" {
+		return fmt.Errorf("requestingService field is required")
+	}
+	if req.OrganizationId == "" {
+		return fmt.Errorf("organizationId field is required")
+	}
+	if req.OrganizationKey == "" {
+		return fmt.Errorf("organizationKey is required for lookup")
+	}
+
+	return nil
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// CreateOrganization onboards a tenant: it provisions and wraps a fresh TEK and
+// registers the organization with the persistence service. If no organization key
+// is supplied a random one is generated and returned exactly once.
+func (s *PIIService) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	log.Printf("[PIIService] Creating organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organizationId is required")
+	}
+	if len(req.OrganizationId) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "organizationId must be at most 255 characters")
+	}
+	if _, err := envelope.ParseSuite(req.CipherSuite); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	orgKey := req.OrganizationKey
+	generatedKey := ""
+	if orgKey == "" {
+		key, err := generateOrganizationKey()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate organization key: %v", err)
+		}
+		orgKey = key
+		generatedKey = key
+	}
+
+	encryptedTEK, err := s.generateWrappedTEK()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Hash the organization key for storage
+	orgKeyHash, err := orgkey.Hash(orgKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash organization key: %v", err)
+	}
+
+	resp, err := s.persistenceClient.CreateOrganization(ctx, &pbPersistence.CreateOrganizationRequest{
+		OrganizationId: req.OrganizationId,
+		DisplayName:    req.DisplayName,
+		EncryptedTek:   encryptedTEK,
+		OrgKeyHash:     orgKeyHash,
+		CreatedAt:      timestamppb.New(time.Now()),
+		CipherSuite:    req.CipherSuite,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ [PIIService] Organization onboarded: %s", req.OrganizationId)
+
+	return &pb.CreateOrganizationResponse{
+		Organization:    toPIIOrganization(resp.Organization),
+		OrganizationKey: generatedKey,
+		Status:          "success",
+	}, nil
+}
+
+// GetOrganization returns a single organization
+func (s *PIIService) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.GetOrganization(ctx, &pbPersistence.GetOrganizationRequest{
+		OrganizationId: req.OrganizationId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOrganizationResponse{
+		Organization: toPIIOrganization(resp.Organization),
+		Status:       "success",
+	}, nil
+}
+
+// ListOrganizations returns onboarded organizations
+func (s *PIIService) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.ListOrganizations(ctx, &pbPersistence.ListOrganizationsRequest{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := make([]*pb.Organization, 0, len(resp.Organizations))
+	for _, org := range resp.Organizations {
+		organizations = append(organizations, toPIIOrganization(org))
+	}
+
+	return &pb.ListOrganizationsResponse{
+		Organizations: organizations,
+		TotalCount:    resp.TotalCount,
+		Status:        "success",
+	}, nil
+}
+
+// SuspendOrganization blocks tokenize and detokenize for an organization
+func (s *PIIService) SuspendOrganization(ctx context.Context, req *pb.SuspendOrganizationRequest) (*pb.SuspendOrganizationResponse, error) {
+	log.Printf("[PIIService] Suspending organization: %s", req.OrganizationId)
+
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.SuspendOrganization(ctx, &pbPersistence.SuspendOrganizationRequest{
+		OrganizationId: req.OrganizationId,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Stop serving the cached TEK immediately on every replica
+	s.invalidateTEKs(ctx, req.OrganizationId)
+
+	return &pb.SuspendOrganizationResponse{
+		Organization: toPIIOrganization(resp.Organization),
+		Status:       "success",
+	}, nil
+}
+
+// ReactivateOrganization lifts a suspension
+func (s *PIIService) ReactivateOrganization(ctx context.Context, req *pb.ReactivateOrganizationRequest) (*pb.ReactivateOrganizationResponse, error) {
+	log.Printf("[PIIService] Reactivating organization: %s", req.OrganizationId)
+
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.ReactivateOrganization(ctx, &pbPersistence.ReactivateOrganizationRequest{
+		OrganizationId: req.OrganizationId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateTEKs(ctx, req.OrganizationId)
+
+	return &pb.ReactivateOrganizationResponse{
+		Organization: toPIIOrganization(resp.Organization),
+		Status:       "success",
+	}, nil
+}
+
+// UnlockOrganization clears the brute-force lockout counters for an organization
+func (s *PIIService) UnlockOrganization(ctx context.Context, req *pb.UnlockOrganizationRequest) (*pb.UnlockOrganizationResponse, error) {
+	log.Printf("[PIIService] Unlocking organization: %s", req.OrganizationId)
+
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	if _, err := s.persistenceClient.UnlockOrganization(ctx, &pbPersistence.UnlockOrganizationRequest{
+		OrganizationId: req.OrganizationId,
+		Source:         req.Source,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pb.UnlockOrganizationResponse{
+		Status: "success",
+	}, nil
+}
+
+// SetOrganizationCipherSuite changes the cipher suite new tokens of an organization are
+// sealed with. Existing tokens keep decrypting with the suite named in their header.
+func (s *PIIService) SetOrganizationCipherSuite(ctx context.Context, req *pb.SetOrganizationCipherSuiteRequest) (*pb.SetOrganizationCipherSuiteResponse, error) {
+	log.Printf("[PIIService] Setting cipher suite of organization %s to %s", req.OrganizationId, req.CipherSuite)
+
+	if req.CipherSuite == "" {
+		return nil, status.Error(codes.InvalidArgument, "cipherSuite is required")
+	}
+	if _, err := envelope.ParseSuite(req.CipherSuite); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.SetOrganizationCipherSuite(ctx, &pbPersistence.SetOrganizationCipherSuiteRequest{
+		OrganizationId: req.OrganizationId,
+		CipherSuite:    req.CipherSuite,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Cached TEKs carry the previous suite
+	s.invalidateTEKs(ctx, req.OrganizationId)
+
+	return &pb.SetOrganizationCipherSuiteResponse{
+		Organization: toPIIOrganization(resp.Organization),
+		Status:       "success",
+	}, nil
+}
+
+// SetDeterministicDataTypes replaces the data types whose reference tokens an organization
+// derives from the value. Existing tokens are unaffected.
+func (s *PIIService) SetDeterministicDataTypes(ctx context.Context, req *pb.SetDeterministicDataTypesRequest) (*pb.SetDeterministicDataTypesResponse, error) {
+	log.Printf("[PIIService] Setting deterministic data types of organization %s to %v", req.OrganizationId, req.DataTypes)
+
+	dataTypes, err := normalizeDataTypes(req.DataTypes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.SetDeterministicDataTypes(ctx, &pbPersistence.SetDeterministicDataTypesRequest{
+		OrganizationId: req.OrganizationId,
+		DataTypes:      dataTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Cached TEKs carry the previous data types
+	s.invalidateTEKs(ctx, req.OrganizationId)
+
+	return &pb.SetDeterministicDataTypesResponse{
+		Organization: toPIIOrganization(resp.Organization),
+		Status:       "success",
+	}, nil
+}
+
+// ShredOrganization crypto-shreds an organization: the persistence service destroys its
+// TEKs, purges its cached and queued tokens and signs a record of the shred. Nothing the
+// organization tokenized can be decrypted afterwards, with or without its key.
+func (s *PIIService) ShredOrganization(ctx context.Context, req *pb.ShredOrganizationRequest) (*pb.ShredOrganizationResponse, error) {
+	log.Printf("[PIIService] Shredding organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organizationId is required")
+	}
+	if req.ConfirmOrganizationId != req.OrganizationId {
+		return nil, status.Error(codes.InvalidArgument, "confirmOrganizationId must repeat the organization ID")
+	}
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.ShredOrganization(ctx, &pbPersistence.ShredOrganizationRequest{
+		OrganizationId:        req.OrganizationId,
+		ConfirmOrganizationId: req.ConfirmOrganizationId,
+		DeleteTokens:          req.DeleteTokens,
+		Reason:                req.Reason,
+		RequestedBy:           req.RequestedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the cached TEKs on every replica now rather than when they expire. A replica
+	// that misses the notification keeps its cached TEKs until cachedTEKsExpireAt.
+	s.invalidateTEKs(ctx, req.OrganizationId)
+	cachedTEKsExpireAt := time.Now().Add(tekCacheTTL)
+
+	log.Printf("🔥 [PIIService] Organization shredded: %s", req.OrganizationId)
+
+	return &pb.ShredOrganizationResponse{
+		Organization:         toPIIOrganization(resp.Organization),
+		Record:               resp.Record,
+		Signature:            resp.Signature,
+		PublicKey:            resp.PublicKey,
+		TekVersionsDestroyed: resp.TekVersionsDestroyed,
+		TokensDeleted:        resp.TokensDeleted,
+		CacheEntriesPurged:   resp.CacheEntriesPurged,
+		QueuedMessagesPurged: resp.QueuedMessagesPurged,
+		AuditId:              resp.AuditId,
+		CachedTeksExpireAt:   timestamppb.New(cachedTEKsExpireAt),
+		Status:               resp.Status,
+		ErrorMessage:         resp.ErrorMessage,
+	}, nil
+}
+
+// generateOrganizationKey returns a random 256-bit organization key
+func generateOrganizationKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// toPIIOrganization converts a persistence Organization message to its PII service counterpart
+func toPIIOrganization(org *pbPersistence.Organization) *pb.Organization {
+	if org == nil {
+		return nil
+	}
+	return &pb.Organization{
+		OrganizationId:  org.OrganizationId,
+		DisplayName:     org.DisplayName,
+		Status:          org.Status,
+		CreatedAt:       org.CreatedAt,
+		UpdatedAt:       org.UpdatedAt,
+		SuspendedAt:     org.SuspendedAt,
+		SuspendedReason: org.SuspendedReason,
+		CipherSuite:     org.CipherSuite,
+
+		DeterministicDataTypes: org.DeterministicDataTypes,
+		ShreddedAt:             org.ShreddedAt,
+	}
+}
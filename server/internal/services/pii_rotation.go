@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// RotateTEK provisions a new TEK version for an organization. New tokens are encrypted
+// with it right away on every replica that receives the invalidation and within
+// tekCacheTTL on one that misses it; existing tokens keep decrypting with the version
+// recorded alongside them.
+func (s *PIIService) RotateTEK(ctx context.Context, req *pb.RotateTEKRequest) (*pb.RotateTEKResponse, error) {
+	log.Printf("[PIIService] Rotating TEK for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organizationId is required")
+	}
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	encryptedTEK, err := s.generateWrappedTEK()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp, err := s.persistenceClient.RotateTEK(ctx, &pbPersistence.RotateTEKRequest{
+		OrganizationId: req.OrganizationId,
+		EncryptedTek:   encryptedTEK,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateTEKs(ctx, req.OrganizationId)
+
+	log.Printf("✅ [PIIService] TEK rotated for organization %s, active version: %d", req.OrganizationId, resp.Version)
+
+	return &pb.RotateTEKResponse{
+		OrganizationId: resp.OrganizationId,
+		Version:        resp.Version,
+		RotatedAt:      resp.RotatedAt,
+		Status:         "success",
+	}, nil
+}
+
+// RotateOrganizationKey replaces an organization's key. The persistence service
+// re-encrypts the organization's tokens in the background; until it finishes the
+// previous key can still detokenize every token. If no new key is supplied a random
+// one is generated and returned exactly once. An interrupted rotation is resumed by
+// calling this again with the same pair of keys.
+func (s *PIIService) RotateOrganizationKey(ctx context.Context, req *pb.RotateOrganizationKeyRequest) (*pb.RotateOrganizationKeyResponse, error) {
+	log.Printf("[PIIService] Rotating organization key for organization: %s", req.OrganizationId)
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organizationId is required")
+	}
+	if req.OrganizationKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "organizationKey is required")
+	}
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	newKey := req.NewOrganizationKey
+	generatedKey := ""
+	if newKey == "" {
+		key, err := generateOrganizationKey()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate organization key: %v", err)
+		}
+		newKey = key
+		generatedKey = key
+	}
+
+	newKeyHash, err := orgkey.Hash(newKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash organization key: %v", err)
+	}
+
+	resp, err := s.persistenceClient.RotateOrganizationKey(ctx, &pbPersistence.RotateOrganizationKeyRequest{
+		OrganizationId:     req.OrganizationId,
+		OrganizationKey:    req.OrganizationKey,
+		NewOrganizationKey: newKey,
+		NewOrgKeyHash:      newKeyHash,
+		Source:             lockout.SourceFromContext(ctx, s.config.GatewaySourceKey),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Cached entries were verified against the replaced key hash
+	s.invalidateTEKs(ctx, req.OrganizationId)
+
+	log.Printf("✅ [PIIService] Organization key rotation running for %s", req.OrganizationId)
+
+	return &pb.RotateOrganizationKeyResponse{
+		Rotation:        toPIIKeyRotation(resp.Rotation),
+		OrganizationKey: generatedKey,
+		Status:          "success",
+	}, nil
+}
+
+// GetOrganizationKeyRotation reports the progress of an organization's latest key rotation
+func (s *PIIService) GetOrganizationKeyRotation(ctx context.Context, req *pb.GetOrganizationKeyRotationRequest) (*pb.GetOrganizationKeyRotationResponse, error) {
+	if s.persistenceClient == nil {
+		return nil, status.Error(codes.Unavailable, "persistence service client not available")
+	}
+
+	resp, err := s.persistenceClient.GetOrganizationKeyRotation(ctx, &pbPersistence.GetOrganizationKeyRotationRequest{
+		OrganizationId: req.OrganizationId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOrganizationKeyRotationResponse{
+		Rotation: toPIIKeyRotation(resp.Rotation),
+		Status:   "success",
+	}, nil
+}
+
+// toPIIKeyRotation converts a persistence OrganizationKeyRotation message to its PII service counterpart
+func toPIIKeyRotation(rotation *pbPersistence.OrganizationKeyRotation) *pb.OrganizationKeyRotation {
+	if rotation == nil {
+		return nil
+	}
+	return &pb.OrganizationKeyRotation{
+		RotationId:      rotation.RotationId,
+		OrganizationId:  rotation.OrganizationId,
+		Status:          rotation.Status,
+		FromVersion:     rotation.FromVersion,
+		ToVersion:       rotation.ToVersion,
+		TotalTokens:     rotation.TotalTokens,
+		ProcessedTokens: rotation.ProcessedTokens,
+		FailedTokens:    rotation.FailedTokens,
+		StartedAt:       rotation.StartedAt,
+		UpdatedAt:       rotation.UpdatedAt,
+		CompletedAt:     rotation.CompletedAt,
+		ErrorMessage:    rotation.ErrorMessage,
+	}
+}
+
+// generateWrappedTEK generates a random TEK and returns it wrapped with the KEK
+func (s *PIIService) generateWrappedTEK() ([]byte, error) {
+	// 32 bytes for AES-256
+	tek := make([]byte, 32)
+	if _, err := rand.Read(tek); err != nil {
+		return nil, fmt.Errorf("failed to generate TEK: %w", err)
+	}
+
+	encryptedTEK, err := s.wrapTEKWithKEK(tek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap TEK with KEK: %w", err)
+	}
+
+	return encryptedTEK, nil
+}
@@ -1,29 +1,39 @@
 package services
 
 import (
+	"bytes"
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/rand"
-	"crypto/sha256"
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/PlainFunction/mistokenly/internal/common/config"
+	"github.com/PlainFunction/mistokenly/internal/common/envelope"
+	"github.com/PlainFunction/mistokenly/internal/common/kek"
+	"github.com/PlainFunction/mistokenly/internal/common/lockout"
+	"github.com/PlainFunction/mistokenly/internal/common/orgkey"
+	"github.com/PlainFunction/mistokenly/internal/common/secret"
 	"github.com/PlainFunction/mistokenly/internal/common/types"
 	pbPersistence "github.com/PlainFunction/mistokenly/proto/persistence"
 	pb "github.com/PlainFunction/mistokenly/proto/pii"
-	_ "github.com/lib/pq"
-	"golang.org/x/crypto/hkdf"
+	"github.com/lib/pq"
 )
 
+// tekCacheTTL bounds how long a cached TEK is trusted, so that suspensions made
+// through another replica take effect here as well when the invalidation notice is missed
+const tekCacheTTL = time.Minute
+
 // PIIService implements the actual PII tokenization and detokenization logic
 type PIIService struct {
 	config            *config.Config
@@ -31,18 +41,28 @@ type PIIService struct {
 	persistenceClient types.PersistenceServiceInterface // gRPC client for persistence service
 	kekProvider       types.KEKProvider                 // Key Encryption Key provider
 	// In-memory cache of organization TEKs (in production, retrieve from secure vault)
-	tekCache map[string]*types.OrganizationTEK
+	tekCache *tekCache
+	// Receives TEK invalidations from other replicas; nil without PGMQ
+	tekInvalidations *pq.Listener
 }
 
 // NewPIIService creates a new PII service instance
 func NewPIIService(cfg *config.Config) (*PIIService, error) {
-	// Initialize KEK provider using static base64-encoded KEK
-	log.Printf("🔑 [PIIService] Initializing static KEK provider")
-	kekProvider, err := types.NewStaticKEKProvider(cfg.KEKBase64)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize static KEK provider: %w", err)
+	// Initialize the KEK provider selected by KEK_PROVIDER. The remote provider is the
+	// key service client, which is set via SetKEKProvider.
+	var kekProvider types.KEKProvider
+	if cfg.KEKProvider == kek.ProviderRemote {
+		log.Printf("🔑 [PIIService] KEK held by the key service")
+	} else {
+		log.Printf("🔑 [PIIService] Initializing %s KEK provider", cfg.KEKProvider)
+		provider, err := kek.NewProvider(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize %s KEK provider: %w", cfg.KEKProvider, err)
+		}
+		log.Printf("✅ [PIIService] KEK provider initialized, current KEK: %s", provider.CurrentKEKID())
+		kekProvider = provider
 	}
-	log.Printf("✅ [PIIService] Static KEK provider initialized")
+
 	// Initialize PGMQ database connection for async persistence
 	pgmqDB, err := sql.Open("postgres", cfg.PGMQDatabaseURL)
 	if err != nil {
@@ -62,12 +82,24 @@ func NewPIIService(cfg *config.Config) (*PIIService, error) {
 		}
 	}
 
+	if cfg.GatewaySourceKey == "" && cfg.LockoutSourceMaxAttempts > 0 {
+		log.Printf("⚠️  [PIIService] GATEWAY_SOURCE_KEY is not set; failed key verifications through the API gateway all count against the gateway's address")
+	}
+
 	service := &PIIService{
 		config:            cfg,
 		pgmqDB:            pgmqDB,
 		persistenceClient: nil, // Will be set via SetPersistenceClient if needed
 		kekProvider:       kekProvider,
-		tekCache:          make(map[string]*types.OrganizationTEK),
+		tekCache:          newTEKCache(tekCacheTTL),
+	}
+
+	if pgmqDB != nil {
+		if err := service.listenForTEKInvalidations(cfg.PGMQDatabaseURL); err != nil {
+			log.Printf("⚠️  [PIIService] Failed to listen for TEK invalidations: %v (other replicas' changes apply within %s)", err, tekCacheTTL)
+		}
+	} else {
+		log.Printf("⚠️  [PIIService] TEK invalidations are not shared without PGMQ; other replicas' changes apply within %s", tekCacheTTL)
 	}
 
 	log.Printf("✅ [PIIService] Cryptographic Zero-Knowledge mode enabled")
@@ -84,6 +116,14 @@ func (s *PIIService) SetPersistenceClient(client types.PersistenceServiceInterfa
 	}
 }
 
+// SetKEKProvider sets the KEK provider when it is the key service client (used to avoid import cycles)
+func (s *PIIService) SetKEKProvider(provider types.KEKProvider) {
+	s.kekProvider = provider
+	if provider != nil {
+		log.Printf("✅ [PIIService] KEK provider configured, current KEK: %s", provider.CurrentKEKID())
+	}
+}
+
 // Tokenize implements the tokenization logic with actual encryption
 func (s *PIIService) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
 	log.Printf("[PIIService] Tokenizing data type: %s for organization: %s", req.DataType, req.OrganizationId)
@@ -104,6 +144,39 @@ func (s *PIIService) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb
 		}, nil
 	}
 
+	// Reject unknown and suspended organizations before doing any work
+	tek, err := s.getTEK(ctx, req.OrganizationId, req.OrganizationKey)
+	if err != nil {
+		if accessErr := tekAccessError(err); accessErr != nil {
+			log.Printf("❌ [PIIService] Tokenization refused for organization %s: %v", req.OrganizationId, err)
+			return nil, accessErr
+		}
+		log.Printf("❌ [PIIService] Failed to load TEK: %v", err)
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to load organization TEK",
+		}, nil
+	}
+
+	// A key being rotated out only reads; new tokens must be created under the new key
+	if tek.RetiringOrgKey {
+		return &pb.TokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "organization key is being rotated out; tokenize with the new organization key",
+		}, nil
+	}
+
+	// Create token record, expiring after the retention policy
+	tokenRecord := s.newTokenRecord(req, tek)
+	log.Printf("[PIIService] Tokenizing with retention policy '%s' -> token expires at: %v", req.RetentionPolicy, tokenRecord.ExpiresAt)
+
+	if req.TokenFormat == tokenFormatFPE {
+		return s.tokenizeFormatPreserving(ctx, req, tokenRecord, tek)
+	}
+	if slices.Contains(tek.DeterministicDataTypes, req.DataType) {
+		return s.tokenizeDeterministic(ctx, req, tokenRecord, tek)
+	}
+
 	// Generate reference hash
 	referenceHash, err := s.generateReferenceHash()
 	if err != nil {
@@ -112,15 +185,14 @@ func (s *PIIService) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb
 			ErrorMessage: "failed to generate reference hash",
 		}, nil
 	}
+	tokenRecord.ReferenceHash = referenceHash
 
-	// Calculate expiration time
-	retentionDuration := s.getRetentionDuration(req.RetentionPolicy)
-	log.Printf("[PIIService] Tokenizing with retention policy '%s' -> duration: %v", req.RetentionPolicy, retentionDuration)
-	expiresAt := time.Now().Add(retentionDuration)
-	log.Printf("[PIIService] Token expires at: %v", expiresAt)
-
-	// Encrypt the PII data using envelope encryption with HKDF
-	encryptedData, iv, err := s.encryptPIIWithEnvelope(req.Data, req.OrganizationId, req.OrganizationKey)
+	// Encrypt the PII data into the record using envelope encryption with HKDF
+	err = s.encryptPIIWithEnvelope(ctx, req.Data, tokenRecord, req.OrganizationKey)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		log.Printf("❌ [PIIService] Tokenization refused for organization %s: %v", req.OrganizationId, err)
+		return nil, accessErr
+	}
 	if err != nil {
 		log.Printf("❌ [PIIService] Encryption failed: %v", err)
 		return &pb.TokenizeResponse{
@@ -129,19 +201,6 @@ func (s *PIIService) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb
 		}, nil
 	}
 
-	// Create token record with IV
-	tokenRecord := &TokenRecord{
-		ReferenceHash:  referenceHash,
-		EncryptedData:  encryptedData,
-		IV:             iv,
-		DataType:       req.DataType,
-		ClientID:       req.ClientId,
-		OrganizationID: req.OrganizationId,
-		CreatedAt:      time.Now(),
-		ExpiresAt:      expiresAt,
-		Metadata:       req.Metadata,
-	}
-
 	// Queue for durable persistence - asynchronous commit
 	if err := s.queueForPersistence(ctx, tokenRecord); err != nil {
 		log.Printf("⚠️  [PIIService] Failed to queue for persistence: %v", err)
@@ -155,7 +214,7 @@ func (s *PIIService) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb
 
 	return &pb.TokenizeResponse{
 		ReferenceHash: fmt.Sprintf("tok_%s", referenceHash),
-		TokenType:     "PII_TOKEN_V2_ENVELOPE",
+		TokenType:     envelope.TokenType(tokenRecord.FormatVersion),
 		ExpiresAt:     timestamppb.New(tokenRecord.ExpiresAt),
 		Status:        "success",
 	}, nil
@@ -181,10 +240,22 @@ func (s *PIIService) Detokenize(ctx context.Context, req *pb.DetokenizeRequest)
 		}, nil
 	}
 
-	// Extract hash from token format (remove "tok_" prefix)
-	hashOnly := req.ReferenceHash
-	if len(hashOnly) > 4 && hashOnly[:4] == "tok_" {
-		hashOnly = hashOnly[4:]
+	// Extract hash from token format (remove "tok_" prefix); format-preserving tokens
+	// are stored under a hash of their digits
+	hashOnly := detokenizeReferenceHash(req.OrganizationId, req.ReferenceHash)
+
+	// Reject unknown and suspended organizations before touching the token store
+	tek, err := s.getTEK(ctx, req.OrganizationId, req.OrganizationKey)
+	if err != nil {
+		if accessErr := tekAccessError(err); accessErr != nil {
+			log.Printf("❌ [PIIService] Detokenization refused for organization %s: %v", req.OrganizationId, err)
+			return nil, accessErr
+		}
+		log.Printf("❌ [PIIService] Failed to load TEK: %v", err)
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: "failed to load organization TEK",
+		}, nil
 	}
 
 	// Retrieve from persistence service
@@ -208,13 +279,27 @@ func (s *PIIService) Detokenize(ctx context.Context, req *pb.DetokenizeRequest)
 		}, nil
 	}
 
+	// Pick the ciphertext that belongs to the presented organization key
+	ciphertext, iv, err := selectCiphertext(tokenRecord, tek.OrgKeyVersion)
+	if err != nil {
+		return &pb.DetokenizeResponse{
+			Status:       "error",
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
 	// Decrypt the PII data using envelope decryption with organization key
 	decryptedData, err := s.decryptPIIWithEnvelope(
-		tokenRecord.EncryptedData,
-		tokenRecord.IV,
-		tokenRecord.OrganizationID,
+		ctx,
+		ciphertext,
+		iv,
+		tokenRecord,
 		req.OrganizationKey,
 	)
+	if accessErr := tekAccessError(err); accessErr != nil {
+		log.Printf("❌ [PIIService] Detokenization refused for organization %s: %v", req.OrganizationId, err)
+		return nil, accessErr
+	}
 	if err != nil {
 		log.Printf("❌ [PIIService] Decryption failed: %v", err)
 		return &pb.DetokenizeResponse{
@@ -266,20 +351,127 @@ type TokenRecord struct {
 	ReferenceHash  string
 	EncryptedData  []byte
 	IV             []byte // Initialization Vector for AES-GCM
+	TEKVersion     int    // Version of the organization TEK that encrypted the data
+	OrgKeyVersion  int    // Version of the organization key that encrypted the data
+	FormatVersion  int    // Token format; determines the key derivation and AES-GCM additional authenticated data
+	KeySalt        []byte // Random salt of the per-record field key, from format 4 on
+	BlindIndex     string // Keyed HMAC of the normalized value for LookupToken; empty if not indexed
 	DataType       string
 	ClientID       string
 	OrganizationID string // Tenant/organization identifier
 	CreatedAt      time.Time
 	ExpiresAt      time.Time
 	Metadata       map[string]string
+
+	// Ciphertext under the previous organization key, kept while a key rotation is in progress
+	PreviousEncryptedData []byte
+	PreviousIV            []byte
+}
+
+// newTokenRecord returns the record of a new token for a tokenize request, expiring
+// after the request's retention policy. The reference hash and ciphertext are set later.
+func (s *PIIService) newTokenRecord(req *pb.TokenizeRequest, tek *types.OrganizationTEK) *TokenRecord {
+	now := time.Now()
+	return &TokenRecord{
+		OrgKeyVersion:  tek.OrgKeyVersion,
+		DataType:       req.DataType,
+		ClientID:       req.ClientId,
+		OrganizationID: req.OrganizationId,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(s.getRetentionDuration(req.RetentionPolicy)),
+		Metadata:       req.Metadata,
+	}
+}
+
+// additionalData returns the additional authenticated data the token was encrypted with.
+// Tokens persisted before formats were recorded have none.
+func (r *TokenRecord) additionalData() []byte {
+	return envelope.TokenAAD(r.FormatVersion, r.ReferenceHash, r.OrganizationID, r.DataType, max(r.TEKVersion, 1))
+}
+
+// tokenKey returns the key the token was encrypted with, given the organization's final
+// encryption key for its TEK version
+func (r *TokenRecord) tokenKey(encryptionKey *secret.Buffer) (*secret.Buffer, error) {
+	return tokenKey(r.FormatVersion, encryptionKey, r.DataType, r.KeySalt)
+}
+
+// tokenKey returns the key a token of the given format is encrypted with in a secret
+// buffer of its own, which the caller must destroy
+func tokenKey(format int, encryptionKey *secret.Buffer, dataType string, keySalt []byte) (*secret.Buffer, error) {
+	key, err := envelope.TokenKey(format, encryptionKey.Bytes(), dataType, keySalt)
+	if err != nil {
+		return nil, err
+	}
+	// Tokens before field keys use the final encryption key itself, which stays the caller's
+	if format < envelope.TokenFormatV4 {
+		key = bytes.Clone(key)
+	}
+	return secret.FromBytes(key)
+}
+
+// selectCiphertext returns the token's ciphertext for the given organization key version.
+// During a key rotation re-encrypted tokens still carry their ciphertext under the
+// previous key, so the previous key keeps working until the rotation completes.
+func selectCiphertext(record *TokenRecord, orgKeyVersion int) ([]byte, []byte, error) {
+	recordVersion := max(record.OrgKeyVersion, 1)
+	orgKeyVersion = max(orgKeyVersion, 1)
+
+	switch {
+	case recordVersion == orgKeyVersion:
+		return record.EncryptedData, record.IV, nil
+	case recordVersion == orgKeyVersion+1 && len(record.PreviousEncryptedData) > 0:
+		return record.PreviousEncryptedData, record.PreviousIV, nil
+	case recordVersion < orgKeyVersion:
+		return nil, nil, fmt.Errorf("token has not been re-encrypted with the new organization key yet; use the previous key until the key rotation completes")
+	default:
+		return nil, nil, fmt.Errorf("token was encrypted with a different organization key")
+	}
 }
 
 // Helper methods
 
+// validDataTypes are the data types that can be tokenized
+var validDataTypes = map[string]bool{
+	"email":       true,
+	"ssn":         true,
+	"phone":       true,
+	"credit_card": true,
+	"name":        true,
+	"address":     true,
+}
+
+// normalizePII returns the form of a value that deterministic tokens and blind indexes
+// are derived from, so trivially different spellings of one value match
+func normalizePII(dataType, value string) string {
+	switch dataType {
+	case "email":
+		return strings.ToLower(strings.TrimSpace(value))
+	case "ssn", "phone", "credit_card":
+		return strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, value)
+	default:
+		return strings.ToLower(strings.Join(strings.Fields(value), " "))
+	}
+}
+
+// normalizeDataTypes validates a list of data types and returns it sorted and without duplicates
+func normalizeDataTypes(dataTypes []string) ([]string, error) {
+	normalized := make([]string, 0, len(dataTypes))
+	for _, dataType := range dataTypes {
+		if !validDataTypes[dataType] {
+			return nil, fmt.Errorf("invalid dataType: %s", dataType)
+		}
+		normalized = append(normalized, dataType)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
 func (s *PIIService) validateTokenizeRequest(req *pb.TokenizeRequest) error {
-	fmt.Printf("🔍 [Validation] Request: %+v\n", req)
-	fmt.Printf("🔍 [Validation] OrganizationId: '%s'\n", req.OrganizationId)
-	fmt.Printf("🔍 [Validation] OrganizationKey: '%s'\n", req.OrganizationKey)
 	if req.Data == "" {
 		return fmt.Errorf("data field is required")
 	}
@@ -294,17 +486,21 @@ func (s *PIIService) validateTokenizeRequest(req *pb.TokenizeRequest) error {
 	}
 
 	// Validate data type
-	validTypes := map[string]bool{
-		"email":       true,
-		"ssn":         true,
-		"phone":       true,
-		"credit_card": true,
-		"name":        true,
-		"address":     true,
+	if !validDataTypes[req.DataType] {
+		return fmt.Errorf("invalid dataType: %s", req.DataType)
 	}
 
-	if !validTypes[req.DataType] {
-		return fmt.Errorf("invalid dataType: %s", req.DataType)
+	switch req.TokenFormat {
+	case "", tokenFormatReference:
+		if req.PreserveBin || req.PreserveLastFour {
+			return fmt.Errorf("preserveBin and preserveLastFour require tokenFormat %q", tokenFormatFPE)
+		}
+	case tokenFormatFPE:
+		if _, err := parseFPEValue(req); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid tokenFormat: %s; expected %q or %q", req.TokenFormat, tokenFormatReference, tokenFormatFPE)
 	}
 
 	return nil
@@ -335,17 +531,26 @@ func (s *PIIService) generateReferenceHash() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-// getOrCreateTEK retrieves or creates a TEK for an organization locally
-func (s *PIIService) getOrCreateTEK(ctx context.Context, organizationID string, orgKey string) (*types.OrganizationTEK, error) {
-	// Check cache first
-	if tek, exists := s.tekCache[organizationID]; exists {
-		// Verify the organization key matches
-		if s.verifyOrganizationKey(orgKey, tek.OrgKeyHash) {
+// getTEK retrieves the active TEK of an onboarded organization from cache or the persistence service.
+// TEKs are only provisioned by CreateOrganization; unknown organizations fail with
+// ErrTEKNotFound, suspended ones with ErrOrganizationSuspended and a wrong organization
+// key always fails with ErrOrganizationKeyMismatch. Concurrent loads for the same
+// organization and key are coalesced.
+func (s *PIIService) getTEK(ctx context.Context, organizationID string, orgKey string) (*types.OrganizationTEK, error) {
+	return s.getTEKVersion(ctx, organizationID, orgKey, 0)
+}
+
+// getTEKVersion is getTEK for a specific TEK version; version 0 selects the active one
+func (s *PIIService) getTEKVersion(ctx context.Context, organizationID string, orgKey string, version int) (*types.OrganizationTEK, error) {
+	// Check cache first - a hit requires the same organization key that was verified on load
+	if tek, exists := s.tekCache.Get(organizationID, version, orgKey); exists {
+		return tek, nil
+	}
+	// Tokens encrypted with the current version are served by the cached active TEK
+	if version != 0 {
+		if tek, exists := s.tekCache.Get(organizationID, 0, orgKey); exists && tek.Version == version {
 			return tek, nil
 		}
-		// Key mismatch - remove from cache and reload from persistence service
-		delete(s.tekCache, organizationID)
-		log.Printf("⚠️  [PIIService] Organization key mismatch for %s, reloading from persistence service", organizationID)
 	}
 
 	// Check if persistence client is available
@@ -353,69 +558,56 @@ func (s *PIIService) getOrCreateTEK(ctx context.Context, organizationID string,
 		return nil, fmt.Errorf("persistence service client not available")
 	}
 
-	// Try to retrieve existing TEK from persistence service
+	// Coalesce concurrent loads per organization and key. The shared load must not
+	// fail for every waiter because the caller that started it went away. Callers
+	// arriving after an invalidation do not join a load started before it.
+	loadCtx := context.WithoutCancel(ctx)
+	generation := s.tekCache.Generation()
+	flightKey := fmt.Sprintf("%s:%d:%d:%s", organizationID, version, generation, hex.EncodeToString(s.tekCache.keyDigest(orgKey)))
+
+	tekRecord, err := s.tekCache.Do(flightKey, func() (*types.OrganizationTEK, error) {
+		return s.retrieveTEK(loadCtx, organizationID, orgKey, version)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the organization key against the stored hash
+	ok, _, err := orgkey.Verify(orgKey, tekRecord.OrgKeyHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify organization key: %w", err)
+	}
+	if !ok {
+		return nil, types.ErrOrganizationKeyMismatch
+	}
+
+	s.tekCache.Set(organizationID, version, orgKey, tekRecord, generation)
+	return tekRecord, nil
+}
+
+// retrieveTEK retrieves a version of the organization's TEK from the persistence service
+func (s *PIIService) retrieveTEK(ctx context.Context, organizationID string, orgKey string, version int) (*types.OrganizationTEK, error) {
 	retrieveReq := &pbPersistence.RetrieveTEKRequest{
 		OrganizationId:  organizationID,
 		OrganizationKey: orgKey,
+		Source:          lockout.SourceFromContext(ctx, s.config.GatewaySourceKey),
+		Version:         int32(version),
 	}
 
 	retrieveResp, err := s.persistenceClient.RetrieveTEK(ctx, retrieveReq)
-
-	// Check if TEK doesn't exist (either gRPC error or status indicates not found)
-	shouldCreateTEK := err != nil || (retrieveResp != nil && retrieveResp.Status != "success")
-
-	if shouldCreateTEK {
-		// If TEK doesn't exist, create a new one
-		log.Printf("🔄 [PIIService] TEK not found for organization %s, creating new one", organizationID)
-
-		// Generate a new random TEK (32 bytes for AES-256)
-		tek := make([]byte, 32)
-		if _, err := rand.Read(tek); err != nil {
-			return nil, fmt.Errorf("failed to generate TEK: %w", err)
-		}
-
-		// Wrap TEK with KEK
-		encryptedTEK, err := s.wrapTEKWithKEK(tek)
-		if err != nil {
-			return nil, fmt.Errorf("failed to wrap TEK with KEK: %w", err)
-		}
-
-		// Hash the organization key for storage
-		orgKeyHash := sha256.Sum256([]byte(orgKey))
-		orgKeyHashStr := hex.EncodeToString(orgKeyHash[:])
-
-		// Create TEK record
-		tekRecord := &types.OrganizationTEK{
-			OrganizationID: organizationID,
-			EncryptedTEK:   encryptedTEK,
-			OrgKeyHash:     orgKeyHashStr,
-			CreatedAt:      time.Now(),
-			Version:        1,
-		}
-
-		// Store TEK via persistence service
-		storeReq := &pbPersistence.StoreTEKRequest{
-			OrganizationId: organizationID,
-			EncryptedTek:   encryptedTEK,
-			OrgKeyHash:     orgKeyHashStr,
-			CreatedAt:      timestamppb.New(tekRecord.CreatedAt),
-			Version:        int32(tekRecord.Version),
+	if err = types.TEKError(err); err != nil {
+		switch {
+		case errors.Is(err, types.ErrOrganizationKeyMismatch):
+			log.Printf("⚠️  [PIIService] Organization key rejected for organization %s", organizationID)
+			return nil, err
+		case errors.Is(err, types.ErrTEKNotFound), errors.Is(err, types.ErrOrganizationSuspended), errors.Is(err, types.ErrOrganizationLocked):
+			return nil, err
+		default:
+			return nil, fmt.Errorf("failed to retrieve TEK: %w", err)
 		}
-
-		storeResp, err := s.persistenceClient.StoreTEK(ctx, storeReq)
-		if err != nil {
-			return nil, fmt.Errorf("failed to store TEK: %w", err)
-		}
-
-		if storeResp.Status != "success" {
-			return nil, fmt.Errorf("persistence service error storing TEK: %s", storeResp.ErrorMessage)
-		}
-
-		// Cache it
-		s.tekCache[organizationID] = tekRecord
-		log.Printf("✅ [PIIService] New TEK created and cached for organization: %s", organizationID)
-
-		return tekRecord, nil
+	}
+	if retrieveResp.Status != "success" {
+		return nil, fmt.Errorf("persistence service error retrieving TEK: %s", retrieveResp.ErrorMessage)
 	}
 
 	// Convert response to OrganizationTEK
@@ -425,6 +617,11 @@ func (s *PIIService) getOrCreateTEK(ctx context.Context, organizationID string,
 		OrgKeyHash:     retrieveResp.OrgKeyHash,
 		CreatedAt:      retrieveResp.CreatedAt.AsTime(),
 		Version:        int(retrieveResp.Version),
+		OrgKeyVersion:  int(retrieveResp.OrgKeyVersion),
+		RetiringOrgKey: retrieveResp.RetiringOrgKey,
+		CipherSuite:    retrieveResp.CipherSuite,
+
+		DeterministicDataTypes: retrieveResp.DeterministicDataTypes,
 	}
 
 	if retrieveResp.RotatedAt != nil {
@@ -432,209 +629,198 @@ func (s *PIIService) getOrCreateTEK(ctx context.Context, organizationID string,
 		tekRecord.RotatedAt = &rotatedAt
 	}
 
-	// Cache it
-	s.tekCache[organizationID] = tekRecord
-	log.Printf("✅ [PIIService] TEK retrieved and cached for organization: %s", organizationID)
-
+	log.Printf("✅ [PIIService] TEK version %d retrieved for organization: %s", tekRecord.Version, organizationID)
 	return tekRecord, nil
 }
 
-// verifyOrganizationKey validates the provided organization key against stored hash
-func (s *PIIService) verifyOrganizationKey(orgKey string, storedHash string) bool {
-	// Hash the provided key
-	providedHash := sha256.Sum256([]byte(orgKey))
-	providedHashStr := hex.EncodeToString(providedHash[:])
-
-	// Compare hashes
-	return providedHashStr == storedHash
+// tekAccessError converts a TEK lookup failure into the gRPC status returned to
+// callers, or returns nil if err is not an organization access failure
+func tekAccessError(err error) error {
+	switch {
+	case errors.Is(err, types.ErrOrganizationKeyMismatch):
+		return status.Error(codes.Unauthenticated, "invalid organization key")
+	case errors.Is(err, types.ErrTEKNotFound):
+		return status.Error(codes.NotFound, "organization not found")
+	case errors.Is(err, types.ErrOrganizationSuspended):
+		return status.Error(codes.FailedPrecondition, "organization is suspended")
+	case errors.Is(err, types.ErrOrganizationLocked):
+		return types.TEKErrorStatus(err)
+	default:
+		return nil
+	}
 }
 
-// wrapTEKWithKEK wraps a Tenant Encryption Key with the Key Encryption Key
+// wrapTEKWithKEK wraps a Tenant Encryption Key with the current Key Encryption Key
 func (s *PIIService) wrapTEKWithKEK(tek []byte) ([]byte, error) {
-	// Get the KEK from the provider
-	kek, err := s.kekProvider.GetKEK()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get KEK: %w", err)
-	}
-
-	// Create AES cipher with KEK
-	block, err := aes.NewCipher(kek)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	if s.kekProvider == nil {
+		return nil, fmt.Errorf("KEK provider not configured")
 	}
+	return s.kekProvider.WrapTEK(tek)
+}
 
-	// Generate random IV for AES-GCM
-	iv := make([]byte, 12) // GCM standard nonce size
-	if _, err := rand.Read(iv); err != nil {
-		return nil, fmt.Errorf("failed to generate IV: %w", err)
+// unwrapTEKWithKEK unwraps a Tenant Encryption Key with the Key Encryption Key that wrapped it.
+// The plaintext TEK is returned in a secret buffer, which the caller must destroy.
+func (s *PIIService) unwrapTEKWithKEK(encryptedTEK []byte) (*secret.Buffer, error) {
+	if s.kekProvider == nil {
+		return nil, fmt.Errorf("KEK provider not configured")
 	}
-
-	// Create GCM cipher
-	gcm, err := cipher.NewGCM(block)
+	tek, err := s.kekProvider.UnwrapTEK(encryptedTEK)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GCM cipher: %w", err)
+		return nil, err
 	}
+	return secret.FromBytes(tek)
+}
 
-	// Encrypt TEK with KEK
-	ciphertext := gcm.Seal(nil, iv, tek, nil)
-
-	// Prepend IV to ciphertext for storage
-	encryptedTEK := append(iv, ciphertext...)
-
-	return encryptedTEK, nil
+// deriveKeyWithHKDF derives a key using HKDF with organization key and TEK. The key is
+// returned in a secret buffer, which the caller must destroy.
+func (s *PIIService) deriveKeyWithHKDF(orgKey string, tek *secret.Buffer) (*secret.Buffer, error) {
+	return deriveSecretKey(orgKey, tek)
 }
 
-// unwrapTEKWithKEK unwraps a Tenant Encryption Key using the Key Encryption Key
-func (s *PIIService) unwrapTEKWithKEK(encryptedTEK []byte) ([]byte, error) {
-	// Get the KEK from the provider
-	kek, err := s.kekProvider.GetKEK()
+// deriveSecretKey derives the final encryption key from a TEK and an organization key
+// (see envelope.DeriveKey) into a secret buffer, which the caller must destroy
+func deriveSecretKey(orgKey string, tek *secret.Buffer) (*secret.Buffer, error) {
+	key, err := envelope.DeriveKey(orgKey, tek.Bytes())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get KEK: %w", err)
+		return nil, err
 	}
+	return secret.FromBytes(key)
+}
 
-	// Extract IV from the beginning of encrypted data
-	if len(encryptedTEK) < 12 {
-		return nil, fmt.Errorf("encrypted TEK too short")
+// finalEncryptionKey unwraps a TEK and derives the organization's final encryption key from
+// it. The plaintext TEK is wiped before returning; the caller must destroy the key.
+func (s *PIIService) finalEncryptionKey(tekRecord *types.OrganizationTEK, orgKey string) (*secret.Buffer, error) {
+	tek, err := s.unwrapTEKWithKEK(tekRecord.EncryptedTEK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap TEK: %w", err)
 	}
-	iv := encryptedTEK[:12]
-	ciphertext := encryptedTEK[12:]
+	defer tek.Destroy()
 
-	// Create AES cipher with KEK
-	block, err := aes.NewCipher(kek)
+	encryptionKey, err := s.deriveKeyWithHKDF(orgKey, tek)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+		return nil, fmt.Errorf("failed to derive encryption key: %w", err)
 	}
 
-	// Create GCM cipher
-	gcm, err := cipher.NewGCM(block)
+	return encryptionKey, nil
+}
+
+// encryptPIIWithEnvelope encrypts PII data using envelope encryption locally with the
+// organization's active TEK and cipher suite. The ciphertext, IV, TEK version and key
+// salt are set on the record, which is written in the current token format.
+func (s *PIIService) encryptPIIWithEnvelope(ctx context.Context, data string, record *TokenRecord, orgKey string) error {
+	// Get TEK for the organization - the organization must have been onboarded
+	tekRecord, err := s.getTEK(ctx, record.OrganizationID, orgKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GCM cipher: %w", err)
+		return fmt.Errorf("failed to get TEK: %w", err)
 	}
 
-	// Decrypt TEK
-	tek, err := gcm.Open(nil, iv, ciphertext, nil)
+	// Unwrap the TEK using KEK and derive the encryption key using HKDF
+	encryptionKey, err := s.finalEncryptionKey(tekRecord, orgKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt TEK: %w", err)
+		return err
 	}
+	defer encryptionKey.Destroy()
 
-	return tek, nil
-}
-
-// deriveKeyWithHKDF derives a key using HKDF with organization key and TEK
-func (s *PIIService) deriveKeyWithHKDF(orgKey string, tek []byte) ([]byte, error) {
-	// Use organization key as salt
-	salt := []byte(orgKey)
-
-	// Use TEK as input key material
-	ikm := tek
-
-	// Create HKDF with SHA-256
-	hkdf := hkdf.New(sha256.New, ikm, salt, nil)
-
-	// Derive 32-byte key for AES-256
-	derivedKey := make([]byte, 32)
-	if _, err := io.ReadFull(hkdf, derivedKey); err != nil {
-		return nil, fmt.Errorf("failed to derive key with HKDF: %w", err)
+	if err := sealPII(data, record, tekRecord, encryptionKey); err != nil {
+		return err
 	}
 
-	return derivedKey, nil
-}
+	log.Printf("🔐 [PIIService] PII data encrypted with envelope encryption")
 
-// encryptPIIWithEnvelope encrypts PII data using envelope encryption locally
-func (s *PIIService) encryptPIIWithEnvelope(data string, organizationID string, orgKey string) ([]byte, []byte, error) {
-	// Get or create TEK for the organization
-	tekRecord, err := s.getOrCreateTEK(context.Background(), organizationID, orgKey)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get TEK: %w", err)
-	}
+	return nil
+}
 
-	// Unwrap the TEK using KEK
-	tek, err := s.unwrapTEKWithKEK(tekRecord.EncryptedTEK)
+// sealPII encrypts PII data into the record with the final encryption key of the TEK
+// version, in the current token format and the TEK's cipher suite
+func sealPII(data string, record *TokenRecord, tekRecord *types.OrganizationTEK, encryptionKey *secret.Buffer) error {
+	// Derive the field key for this data type and record
+	var err error
+	record.FormatVersion = envelope.CurrentTokenFormat
+	record.TEKVersion = tekRecord.Version
+	if record.KeySalt, err = envelope.NewKeySalt(); err != nil {
+		return err
+	}
+	fieldKey, err := record.tokenKey(encryptionKey)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to unwrap TEK: %w", err)
+		return fmt.Errorf("failed to derive field key: %w", err)
 	}
+	defer fieldKey.Destroy()
 
-	// Derive encryption key using HKDF
-	encryptionKey, err := s.deriveKeyWithHKDF(orgKey, tek)
+	// Index the value so that LookupToken can find the token
+	blindIndexKey, err := envelope.DeriveBlindIndexKey(encryptionKey.Bytes(), record.DataType)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to derive encryption key: %w", err)
+		return err
 	}
+	record.BlindIndex = envelope.BlindIndex(blindIndexKey, normalizePII(record.DataType, data))
+	clear(blindIndexKey)
 
-	// Create AES cipher
-	block, err := aes.NewCipher(encryptionKey)
+	suite, err := envelope.ParseSuite(tekRecord.CipherSuite)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create AES cipher: %w", err)
+		return err
 	}
 
-	// Generate random IV for AES-GCM
-	iv := make([]byte, 12) // GCM standard nonce size
-	if _, err := rand.Read(iv); err != nil {
-		return nil, nil, fmt.Errorf("failed to generate IV: %w", err)
-	}
-
-	// Validate IV length (should always be 12, but let's be sure)
-	if len(iv) != 12 {
-		return nil, nil, fmt.Errorf("generated IV has incorrect length: %d", len(iv))
-	}
-
-	// Create GCM cipher
-	gcm, err := cipher.NewGCM(block)
+	// Copy the data into locked memory so the bytes that are encrypted can be wiped
+	plaintext, err := secret.FromString(data)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create GCM cipher: %w", err)
+		return err
 	}
+	defer plaintext.Destroy()
 
-	// Encrypt the data
-	ciphertext := gcm.Seal(nil, iv, []byte(data), nil)
-
-	log.Printf("🔐 [PIIService] PII data encrypted with envelope encryption")
-
-	return ciphertext, iv, nil
+	// Seal the data with a fresh random nonce, binding it to the token record
+	record.EncryptedData, record.IV, err = envelope.SealToken(suite, tekRecord.Version, fieldKey.Bytes(), plaintext.Bytes(), record.additionalData())
+	return err
 }
 
-// decryptPIIWithEnvelope decrypts PII data using envelope decryption locally
-func (s *PIIService) decryptPIIWithEnvelope(ciphertext []byte, iv []byte, organizationID string, orgKey string) (string, error) {
-	// Validate IV length for AES-GCM (must be exactly 12 bytes)
-	if len(iv) != 12 {
-		return "", fmt.Errorf("invalid IV length: got %d bytes, expected 12 bytes for AES-GCM", len(iv))
+// decryptPIIWithEnvelope decrypts one of the record's ciphertexts using envelope
+// decryption locally with the TEK version that encrypted it, in the record's token format
+func (s *PIIService) decryptPIIWithEnvelope(ctx context.Context, ciphertext []byte, iv []byte, record *TokenRecord, orgKey string) (string, error) {
+	// The ciphertext header names the TEK version; tokens without a header record it,
+	// and those persisted before TEKs were versioned used the first version
+	tekVersion, err := envelope.TokenKeyVersion(record.FormatVersion, ciphertext, record.TEKVersion)
+	if err != nil {
+		return "", err
 	}
 
-	// Get TEK for the organization
-	tekRecord, err := s.getOrCreateTEK(context.Background(), organizationID, orgKey)
+	// Get the TEK version for the organization - decryption never provisions a new TEK
+	tekRecord, err := s.getTEKVersion(ctx, record.OrganizationID, orgKey, tekVersion)
 	if err != nil {
 		return "", fmt.Errorf("failed to get TEK: %w", err)
 	}
 
-	// Unwrap the TEK using KEK
-	tek, err := s.unwrapTEKWithKEK(tekRecord.EncryptedTEK)
+	// Unwrap the TEK using KEK and derive the encryption key using HKDF
+	encryptionKey, err := s.finalEncryptionKey(tekRecord, orgKey)
 	if err != nil {
-		return "", fmt.Errorf("failed to unwrap TEK: %w", err)
+		return "", err
 	}
+	defer encryptionKey.Destroy()
 
-	// Derive encryption key using HKDF
-	encryptionKey, err := s.deriveKeyWithHKDF(orgKey, tek)
+	data, err := openPII(ciphertext, iv, record, encryptionKey)
 	if err != nil {
-		return "", fmt.Errorf("failed to derive encryption key: %w", err)
+		return "", err
 	}
 
-	// Create AES cipher
-	block, err := aes.NewCipher(encryptionKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to create AES cipher: %w", err)
-	}
+	log.Printf("🔓 [PIIService] PII data decrypted with envelope decryption")
+
+	return data, nil
+}
 
-	// Create GCM cipher
-	gcm, err := cipher.NewGCM(block)
+// openPII decrypts one of the record's ciphertexts with the final encryption key of the
+// TEK version that encrypted it
+func openPII(ciphertext []byte, iv []byte, record *TokenRecord, encryptionKey *secret.Buffer) (string, error) {
+	// Derive the field key the record was encrypted with
+	fieldKey, err := record.tokenKey(encryptionKey)
 	if err != nil {
-		return "", fmt.Errorf("failed to create GCM cipher: %w", err)
+		return "", fmt.Errorf("failed to derive field key: %w", err)
 	}
+	defer fieldKey.Destroy()
 
-	// Decrypt the data
-	plaintext, err := gcm.Open(nil, iv, ciphertext, nil)
+	// Decrypt the data with the cipher suite of the token's format
+	plaintext, err := envelope.OpenToken(record.FormatVersion, fieldKey.Bytes(), ciphertext, iv, record.additionalData())
 	if err != nil {
-		return "", fmt.Errorf("failed to decrypt data: %w", err)
+		return "", err
 	}
-
-	log.Printf("🔓 [PIIService] PII data decrypted with envelope decryption")
+	// The response needs a string; the decrypted bytes are wiped once it is made
+	defer clear(plaintext)
 
 	return string(plaintext), nil
 }
@@ -696,10 +882,17 @@ func (s *PIIService) retrieveFromDatabase(ctx context.Context, hash string, orga
 		ReferenceHash:  resp.ReferenceHash,
 		EncryptedData:  resp.EncryptedData,
 		IV:             resp.Iv,
+		TEKVersion:     int(resp.TekVersion),
+		OrgKeyVersion:  int(resp.OrgKeyVersion),
+		FormatVersion:  int(resp.FormatVersion),
+		KeySalt:        resp.KeySalt,
 		DataType:       resp.DataType,
 		ClientID:       resp.ClientId,
 		OrganizationID: resp.OrganizationId,
 		Metadata:       resp.Metadata,
+
+		PreviousEncryptedData: resp.PreviousEncryptedData,
+		PreviousIV:            resp.PreviousIv,
 	}
 
 	// Convert timestamps
@@ -723,11 +916,70 @@ func (s *PIIService) queueForPersistence(ctx context.Context, record *TokenRecor
 		return nil // Don't fail the request if PGMQ is unavailable
 	}
 
-	// Convert TokenRecord to persistence service request
+	// Marshal to JSON for PGMQ message
+	messageJSON, err := json.Marshal(persistenceRequest(record))
+	if err != nil {
+		log.Printf("❌ [PIIService] Failed to marshal persistence message: %v", err)
+		return fmt.Errorf("failed to marshal persistence message: %w", err)
+	}
+
+	// Publish to PGMQ queue
+	query := `SELECT pgmq.send($1, $2)`
+	_, err = s.pgmqDB.ExecContext(ctx, query, "pii_token_persistence", string(messageJSON))
+	if err != nil {
+		log.Printf("❌ [PIIService] Failed to publish to PGMQ: %v", err)
+		return fmt.Errorf("failed to publish to PGMQ: %w", err)
+	}
+
+	log.Printf("✅ [PIIService] Successfully queued token for persistence: %s", record.ReferenceHash)
+	return nil
+}
+
+// queueBatchForPersistence publishes the records to the persistence queue with a single
+// pgmq.send_batch call
+func (s *PIIService) queueBatchForPersistence(ctx context.Context, records []*TokenRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	log.Printf("[PIIService] Queuing %d tokens for persistence", len(records))
+
+	// Check if PGMQ is available
+	if s.pgmqDB == nil {
+		log.Printf("⚠️  [PIIService] PGMQ not available, skipping persistence queue")
+		return nil // Don't fail the request if PGMQ is unavailable
+	}
+
+	messages := make([]string, 0, len(records))
+	for _, record := range records {
+		messageJSON, err := json.Marshal(persistenceRequest(record))
+		if err != nil {
+			return fmt.Errorf("failed to marshal persistence message: %w", err)
+		}
+		messages = append(messages, string(messageJSON))
+	}
+
+	// Publish to PGMQ queue
+	query := `SELECT pgmq.send_batch($1, $2::jsonb[])`
+	if _, err := s.pgmqDB.ExecContext(ctx, query, "pii_token_persistence", pq.Array(messages)); err != nil {
+		log.Printf("❌ [PIIService] Failed to publish batch to PGMQ: %v", err)
+		return fmt.Errorf("failed to publish batch to PGMQ: %w", err)
+	}
+
+	log.Printf("✅ [PIIService] Successfully queued %d tokens for persistence", len(records))
+	return nil
+}
+
+// persistenceRequest converts a TokenRecord to a persistence service request
+func persistenceRequest(record *TokenRecord) *pbPersistence.StorePIITokenRequest {
 	req := &pbPersistence.StorePIITokenRequest{
 		ReferenceHash:  record.ReferenceHash,
 		EncryptedData:  record.EncryptedData,
 		Iv:             record.IV,
+		TekVersion:     int32(record.TEKVersion),
+		OrgKeyVersion:  int32(record.OrgKeyVersion),
+		FormatVersion:  int32(record.FormatVersion),
+		KeySalt:        record.KeySalt,
+		BlindIndex:     record.BlindIndex,
 		DataType:       record.DataType,
 		ClientId:       record.ClientID,
 		OrganizationId: record.OrganizationID,
@@ -742,23 +994,7 @@ func (s *PIIService) queueForPersistence(ctx context.Context, record *TokenRecor
 		req.ExpiresAt = timestamppb.New(record.ExpiresAt)
 	}
 
-	// Marshal to JSON for PGMQ message
-	messageJSON, err := json.Marshal(req)
-	if err != nil {
-		log.Printf("❌ [PIIService] Failed to marshal persistence message: %v", err)
-		return fmt.Errorf("failed to marshal persistence message: %w", err)
-	}
-
-	// Publish to PGMQ queue
-	query := `SELECT pgmq.send($1, $2)`
-	_, err = s.pgmqDB.ExecContext(ctx, query, "pii_token_persistence", string(messageJSON))
-	if err != nil {
-		log.Printf("❌ [PIIService] Failed to publish to PGMQ: %v", err)
-		return fmt.Errorf("failed to publish to PGMQ: %w", err)
-	}
-
-	log.Printf("✅ [PIIService] Successfully queued token for persistence: %s", record.ReferenceHash)
-	return nil
+	return req
 }
 
 func (s *PIIService) logAuditEvent(ctx context.Context, operation, hash, clientID string, metadata map[string]string) {
@@ -770,6 +1006,13 @@ func (s *PIIService) logAuditEvent(ctx context.Context, operation, hash, clientI
 func (s *PIIService) Close() error {
 	log.Println("🔌 [PIIService] Closing connections...")
 
+	if s.tekInvalidations != nil {
+		log.Println("  - Closing TEK invalidation listener...")
+		if err := s.tekInvalidations.Close(); err != nil {
+			log.Printf("⚠️  Failed to close TEK invalidation listener: %v", err)
+		}
+	}
+
 	if s.pgmqDB != nil {
 		log.Println("  - Closing PGMQ database connection...")
 		if err := s.pgmqDB.Close(); err != nil {
@@ -790,6 +1033,15 @@ func (s *PIIService) Close() error {
 		}
 	}
 
+	if s.kekProvider != nil {
+		log.Println("  - Closing KEK provider...")
+		if err := s.kekProvider.Close(); err != nil {
+			log.Printf("⚠️  Failed to close KEK provider: %v", err)
+		} else {
+			log.Println("  ✅ KEK provider closed")
+		}
+	}
+
 	log.Println("✅ [PIIService] All connections closed")
 	return nil
 }
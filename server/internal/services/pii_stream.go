@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sync"
+
+	"google.golang.org/grpc/status"
+
+	pb "github.com/PlainFunction/mistokenly/proto/pii"
+)
+
+// streamConcurrency bounds the messages of one stream that are processed at a time.
+// While every slot is busy the stream is not read, so gRPC flow control holds back a
+// client that sends faster than the service can tokenize.
+const streamConcurrency = 16
+
+// TokenizeStream tokenizes each message of the stream like Tokenize. Messages are
+// processed concurrently and answered as they complete; the stream ends once the client
+// has closed its side and every message has been answered.
+func (s *PIIService) TokenizeStream(stream pb.PIIService_TokenizeStreamServer) error {
+	log.Printf("[PIIService] Tokenize stream opened")
+
+	return serveStream(stream.Context(), stream.Recv, stream.Send, func(ctx context.Context, msg *pb.TokenizeStreamRequest) *pb.TokenizeStreamResponse {
+		if msg.Request == nil {
+			return &pb.TokenizeStreamResponse{
+				CorrelationId: msg.CorrelationId,
+				Response:      &pb.TokenizeResponse{Status: "error", ErrorMessage: "request field is required"},
+			}
+		}
+
+		resp, err := s.Tokenize(ctx, msg.Request)
+		if err != nil {
+			return &pb.TokenizeStreamResponse{
+				CorrelationId: msg.CorrelationId,
+				Response:      &pb.TokenizeResponse{Status: "error", ErrorMessage: status.Convert(err).Message()},
+				ErrorCode:     status.Code(err).String(),
+			}
+		}
+		return &pb.TokenizeStreamResponse{CorrelationId: msg.CorrelationId, Response: resp}
+	})
+}
+
+// DetokenizeStream detokenizes each message of the stream like Detokenize, processing
+// and answering messages as TokenizeStream does
+func (s *PIIService) DetokenizeStream(stream pb.PIIService_DetokenizeStreamServer) error {
+	log.Printf("[PIIService] Detokenize stream opened")
+
+	return serveStream(stream.Context(), stream.Recv, stream.Send, func(ctx context.Context, msg *pb.DetokenizeStreamRequest) *pb.DetokenizeStreamResponse {
+		if msg.Request == nil {
+			return &pb.DetokenizeStreamResponse{
+				CorrelationId: msg.CorrelationId,
+				Response:      &pb.DetokenizeResponse{Status: "error", ErrorMessage: "request field is required"},
+			}
+		}
+
+		resp, err := s.Detokenize(ctx, msg.Request)
+		if err != nil {
+			return &pb.DetokenizeStreamResponse{
+				CorrelationId: msg.CorrelationId,
+				Response:      &pb.DetokenizeResponse{Status: "error", ErrorMessage: status.Convert(err).Message()},
+				ErrorCode:     status.Code(err).String(),
+				ReferenceHash: msg.Request.ReferenceHash,
+			}
+		}
+		return &pb.DetokenizeStreamResponse{CorrelationId: msg.CorrelationId, Response: resp, ReferenceHash: msg.Request.ReferenceHash}
+	})
+}
+
+// serveStream reads messages until the client closes its side of the stream and
+// answers each with handle, running at most streamConcurrency at once. A failure to
+// receive or send ends the stream; a message that fails is answered like any other.
+func serveStream[Req, Resp any](ctx context.Context, recv func() (Req, error), send func(Resp) error, handle func(context.Context, Req) Resp) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		sendMu  sync.Mutex // grpc streams do not allow concurrent sends
+		sendErr error
+		slots   = make(chan struct{}, streamConcurrency)
+		handled int
+	)
+	answer := func(msg Req) {
+		defer wg.Done()
+		defer func() { <-slots }()
+
+		resp := handle(ctx, msg)
+
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		if sendErr != nil {
+			return
+		}
+		if err := send(resp); err != nil {
+			sendErr = err
+			cancel()
+		}
+	}
+
+	var recvErr error
+	for {
+		msg, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			recvErr = err
+			break
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		handled++
+		go answer(msg)
+	}
+	wg.Wait()
+
+	log.Printf("[PIIService] Stream closed after %d messages", handled)
+
+	switch {
+	case sendErr != nil:
+		return sendErr
+	case recvErr != nil:
+		return recvErr
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// tekInvalidationChannel is the PostgreSQL notification channel on the PGMQ database
+// through which PII service replicas tell each other to drop an organization's cached TEKs
+const tekInvalidationChannel = "mistokenly_tek_invalidation"
+
+// invalidateTEKs drops the cached TEKs of an organization on this replica and notifies
+// the other replicas to do the same. Replicas that miss the notification stop serving
+// their cached TEKs within tekCacheTTL.
+func (s *PIIService) invalidateTEKs(ctx context.Context, organizationID string) {
+	s.tekCache.Delete(organizationID)
+
+	if s.pgmqDB == nil {
+		return
+	}
+	// The change has been made, so the notification goes out even if the caller went away
+	if _, err := s.pgmqDB.ExecContext(context.WithoutCancel(ctx), "SELECT pg_notify($1, $2)", tekInvalidationChannel, organizationID); err != nil {
+		log.Printf("⚠️  [PIIService] Failed to notify other replicas to drop the cached TEKs of organization %s: %v", organizationID, err)
+	}
+}
+
+// listenForTEKInvalidations drops cached TEKs as other replicas invalidate them. While
+// the listener is disconnected notifications are lost, so the whole cache is cleared
+// when it disconnects and again once it is back.
+func (s *PIIService) listenForTEKInvalidations(databaseURL string) error {
+	listener := pq.NewListener(databaseURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if event == pq.ListenerEventDisconnected {
+			log.Printf("⚠️  [PIIService] TEK invalidation listener disconnected: %v", err)
+			s.tekCache.Clear()
+		}
+	})
+	if err := listener.Listen(tekInvalidationChannel); err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		for notification := range listener.Notify {
+			if notification == nil {
+				// Sent after a reconnect
+				log.Printf("✅ [PIIService] TEK invalidation listener reconnected")
+				s.tekCache.Clear()
+				continue
+			}
+			s.tekCache.Delete(notification.Extra)
+		}
+	}()
+
+	s.tekInvalidations = listener
+	return nil
+}
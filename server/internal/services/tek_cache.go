@@ -0,0 +1,176 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+// tekCache is an in-memory cache of organization TEKs that is safe for use by
+// concurrent gRPC handlers. Entries expire after ttl so organization status changes
+// made elsewhere are picked up. It also coalesces concurrent loads of the same key
+// so only one request per process retrieves a TEK at a time.
+//
+// Entries are keyed by organization and TEK version, where version 0 stands for
+// whichever version is currently active.
+//
+// Each entry remembers a keyed digest of the organization key that was verified when
+// it was loaded, so cache hits are checked with a cheap constant-time comparison
+// instead of repeating the slow organization key hash.
+//
+// Every invalidation advances the cache's generation. A TEK loaded in an earlier
+// generation is not cached, so a load that was in flight when an organization was
+// shredded or suspended cannot put its TEK back.
+type tekCache struct {
+	mu         sync.RWMutex
+	entries    map[tekCacheKey]tekCacheEntry
+	generation uint64
+	ttl        time.Duration
+	digestKey  []byte
+
+	flightMu sync.Mutex
+	flights  map[string]*tekFlight
+}
+
+// tekCacheKey identifies a cached TEK; version 0 is the active version
+type tekCacheKey struct {
+	organizationID string
+	version        int
+}
+
+// tekCacheEntry is a cached TEK and the time it stops being trusted
+type tekCacheEntry struct {
+	tek       *types.OrganizationTEK
+	keyDigest []byte
+	expiresAt time.Time
+}
+
+// tekFlight tracks an in-progress TEK load shared by concurrent callers
+type tekFlight struct {
+	wg  sync.WaitGroup
+	tek *types.OrganizationTEK
+	err error
+}
+
+// newTEKCache creates an empty TEK cache whose entries expire after ttl
+func newTEKCache(ttl time.Duration) *tekCache {
+	// The digest key only lives in this process, so digests are useless outside it
+	digestKey := make([]byte, 32)
+	if _, err := rand.Read(digestKey); err != nil {
+		panic("failed to generate TEK cache digest key: " + err.Error())
+	}
+
+	return &tekCache{
+		entries:   make(map[tekCacheKey]tekCacheEntry),
+		ttl:       ttl,
+		digestKey: digestKey,
+		flights:   make(map[string]*tekFlight),
+	}
+}
+
+// Get returns the cached TEK version for an organization if it has not expired and was
+// loaded with the same organization key
+func (c *tekCache) Get(organizationID string, version int, orgKey string) (*types.OrganizationTEK, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[tekCacheKey{organizationID, version}]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	if !hmac.Equal(c.keyDigest(orgKey), entry.keyDigest) {
+		return nil, false
+	}
+	return entry.tek, true
+}
+
+// Generation returns the current generation, to be passed to Set with the TEK loaded after it
+func (c *tekCache) Generation() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generation
+}
+
+// Set caches a TEK version for an organization along with the organization key it was
+// verified against. The TEK is dropped if the cache was invalidated since generation.
+func (c *tekCache) Set(organizationID string, version int, orgKey string, tek *types.OrganizationTEK, generation uint64) {
+	entry := tekCacheEntry{
+		tek:       tek,
+		keyDigest: c.keyDigest(orgKey),
+		expiresAt: time.Now().Add(c.ttl),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.entries[tekCacheKey{organizationID, version}] = entry
+}
+
+// keyDigest returns the keyed digest of an organization key
+func (c *tekCache) keyDigest(orgKey string) []byte {
+	mac := hmac.New(sha256.New, c.digestKey)
+	mac.Write([]byte(orgKey))
+	return mac.Sum(nil)
+}
+
+// Delete removes every cached TEK version for an organization
+func (c *tekCache) Delete(organizationID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key := range c.entries {
+		if key.organizationID == organizationID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Clear removes every cached TEK
+func (c *tekCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	clear(c.entries)
+}
+
+// errTEKLoadPanicked is returned to the callers waiting on a load that panicked
+var errTEKLoadPanicked = errors.New("TEK load panicked")
+
+// Do runs load once for all concurrent callers using the same flight key and
+// returns the shared result to each of them. If load panics, the panic reaches the
+// caller that ran it and the waiting callers get errTEKLoadPanicked.
+func (c *tekCache) Do(key string, load func() (*types.OrganizationTEK, error)) (*types.OrganizationTEK, error) {
+	c.flightMu.Lock()
+	if flight, ok := c.flights[key]; ok {
+		c.flightMu.Unlock()
+		flight.wg.Wait()
+		return flight.tek, flight.err
+	}
+
+	flight := &tekFlight{}
+	flight.wg.Add(1)
+	c.flights[key] = flight
+	c.flightMu.Unlock()
+
+	completed := false
+	defer func() {
+		if !completed {
+			flight.tek, flight.err = nil, errTEKLoadPanicked
+		}
+		flight.wg.Done()
+
+		c.flightMu.Lock()
+		delete(c.flights, key)
+		c.flightMu.Unlock()
+	}()
+
+	flight.tek, flight.err = load()
+	completed = true
+	return flight.tek, flight.err
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PlainFunction/mistokenly/internal/common/types"
+)
+
+func TestTEKCacheDoCoalesces(t *testing.T) {
+	c := newTEKCache(time.Minute)
+	release := make(chan struct{})
+	tek := &types.OrganizationTEK{OrganizationID: "acme", Version: 1}
+
+	var loads int
+	var wg sync.WaitGroup
+	results := make([]*types.OrganizationTEK, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = c.Do("acme:0", func() (*types.OrganizationTEK, error) {
+				loads++
+				<-release
+				return tek, nil
+			})
+		}()
+	}
+
+	// Let the callers join the flight before it completes
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if loads != 1 {
+		t.Errorf("load ran %d times, want 1", loads)
+	}
+	for i, got := range results {
+		if got != tek {
+			t.Errorf("caller %d got %v, want the shared TEK", i, got)
+		}
+	}
+}
+
+func TestTEKCacheDoPanic(t *testing.T) {
+	c := newTEKCache(time.Minute)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	panicked := make(chan any, 1)
+	go func() {
+		defer func() { panicked <- recover() }()
+		c.Do("acme:0", func() (*types.OrganizationTEK, error) {
+			close(started)
+			<-release
+			panic("boom")
+		})
+	}()
+	<-started
+
+	waiter := make(chan error, 1)
+	go func() {
+		_, err := c.Do("acme:0", func() (*types.OrganizationTEK, error) {
+			t.Error("a waiting caller ran its own load")
+			return nil, nil
+		})
+		waiter <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	if r := <-panicked; r != "boom" {
+		t.Errorf("the loading caller recovered %v, want the panic", r)
+	}
+	select {
+	case err := <-waiter:
+		if !errors.Is(err, errTEKLoadPanicked) {
+			t.Errorf("waiting caller got %v, want errTEKLoadPanicked", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiting caller blocked after the load panicked")
+	}
+
+	// The failed flight is gone, so the next caller loads again
+	tek := &types.OrganizationTEK{OrganizationID: "acme"}
+	done := make(chan *types.OrganizationTEK, 1)
+	go func() {
+		got, _ := c.Do("acme:0", func() (*types.OrganizationTEK, error) { return tek, nil })
+		done <- got
+	}()
+	select {
+	case got := <-done:
+		if got != tek {
+			t.Errorf("Do after a panic = %v, want a fresh load", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Do blocked after an earlier load panicked")
+	}
+}
+
+func TestTEKCacheInvalidatedLoad(t *testing.T) {
+	c := newTEKCache(time.Minute)
+	tek := &types.OrganizationTEK{OrganizationID: "acme", Version: 1}
+
+	c.Set("acme", 0, "key", tek, c.Generation())
+	if got, ok := c.Get("acme", 0, "key"); !ok || got != tek {
+		t.Fatalf("Get = %v, %v, want the cached TEK", got, ok)
+	}
+	if _, ok := c.Get("acme", 0, "other key"); ok {
+		t.Error("Get served the TEK to a different organization key")
+	}
+
+	// A load that started before the organization was shredded finishes after it
+	generation := c.Generation()
+	c.Delete("acme")
+	c.Set("acme", 0, "key", tek, generation)
+	if _, ok := c.Get("acme", 0, "key"); ok {
+		t.Error("a TEK loaded before Delete was cached after it")
+	}
+
+	c.Set("acme", 0, "key", tek, c.Generation())
+	c.Set("globex", 0, "key", &types.OrganizationTEK{OrganizationID: "globex"}, c.Generation())
+	generation = c.Generation()
+	c.Clear()
+	if _, ok := c.Get("acme", 0, "key"); ok {
+		t.Error("Clear kept acme's TEK")
+	}
+	if _, ok := c.Get("globex", 0, "key"); ok {
+		t.Error("Clear kept globex's TEK")
+	}
+	c.Set("globex", 0, "key", tek, generation)
+	if _, ok := c.Get("globex", 0, "key"); ok {
+		t.Error("a TEK loaded before Clear was cached after it")
+	}
+}
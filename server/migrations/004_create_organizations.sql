@@ -0,0 +1,24 @@
+-- Organizations registry for explicit tenant onboarding
+-- Tokenization is only permitted for organizations that exist here and are active
+
+CREATE TABLE IF NOT EXISTS organizations (
+    organization_id VARCHAR(255) PRIMARY KEY,
+    display_name VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    suspended_at TIMESTAMP WITH TIME ZONE,
+    suspended_reason TEXT,
+
+    CONSTRAINT valid_organization_status CHECK (status IN ('active', 'suspended'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_organizations_status ON organizations(status);
+
+-- Register organizations that were created implicitly before onboarding existed
+INSERT INTO organizations (organization_id, created_at, updated_at)
+SELECT organization_id, created_at, created_at FROM organization_teks
+ON CONFLICT (organization_id) DO NOTHING;
+
+COMMENT ON TABLE organizations IS 'Registered tenants; tokenize and detokenize are rejected for unknown or suspended organizations';
+COMMENT ON COLUMN organizations.status IS 'Lifecycle status: active or suspended';
@@ -0,0 +1,7 @@
+-- Organization key hashes are stored as versioned Argon2id PHC strings with a per-organization salt,
+-- e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>, which no longer fit in VARCHAR(64).
+-- Legacy unsalted SHA-256 hex hashes remain valid and are upgraded on the next successful verification.
+
+ALTER TABLE organization_teks ALTER COLUMN org_key_hash TYPE TEXT;
+
+COMMENT ON COLUMN organization_teks.org_key_hash IS 'Argon2id PHC hash of the organization key (legacy rows: SHA-256 hex, upgraded on next use)';
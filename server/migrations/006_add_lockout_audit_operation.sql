@@ -0,0 +1,6 @@
+-- Brute-force lockouts on organization key verification are recorded in the audit log
+-- with operation 'lockout'.
+
+ALTER TABLE audit_logs DROP CONSTRAINT IF EXISTS valid_operation;
+ALTER TABLE audit_logs ADD CONSTRAINT valid_operation
+    CHECK (operation IN ('tokenize', 'detokenize', 'access', 'admin', 'lockout'));
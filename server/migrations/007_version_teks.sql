@@ -0,0 +1,17 @@
+-- TEKs are versioned: rotation adds a new active version for an organization and keeps
+-- earlier versions so tokens encrypted with them can still be decrypted.
+
+ALTER TABLE organization_teks DROP CONSTRAINT IF EXISTS organization_teks_organization_id_key;
+ALTER TABLE organization_teks ADD CONSTRAINT organization_teks_organization_id_version_key UNIQUE (organization_id, version);
+
+-- At most one active TEK per organization
+DROP INDEX IF EXISTS idx_organization_teks_active;
+CREATE UNIQUE INDEX idx_organization_teks_active ON organization_teks(organization_id) WHERE is_active = true;
+
+-- Every token records the TEK version that encrypted it; existing tokens were all
+-- encrypted with the first version
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS tek_version INTEGER NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN organization_teks.is_active IS 'True for the version used to encrypt new tokens; older versions are kept for decryption';
+COMMENT ON COLUMN organization_teks.rotated_at IS 'When this version was superseded by a newer one';
+COMMENT ON COLUMN pii_tokens.tek_version IS 'Version of the organization TEK that encrypted this token';
@@ -0,0 +1,41 @@
+-- Organization keys can be rotated. While a rotation is in progress the previous key
+-- stays valid, and every re-encrypted token keeps its ciphertext under the previous key
+-- until the background re-encryption job completes.
+
+ALTER TABLE organization_teks ADD COLUMN IF NOT EXISTS org_key_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE organization_teks ADD COLUMN IF NOT EXISTS previous_org_key_hash TEXT;
+
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS org_key_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS previous_encrypted_data BYTEA;
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS previous_iv BYTEA;
+
+-- Re-encryption walks an organization's tokens for one key version in reference order
+CREATE INDEX IF NOT EXISTS idx_pii_tokens_org_key_version ON pii_tokens(organization_id, org_key_version, reference_hash);
+
+CREATE TABLE IF NOT EXISTS organization_key_rotations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    from_version INTEGER NOT NULL,
+    to_version INTEGER NOT NULL,
+    total_tokens BIGINT NOT NULL DEFAULT 0,
+    processed_tokens BIGINT NOT NULL DEFAULT 0,
+    failed_tokens BIGINT NOT NULL DEFAULT 0,
+    last_reference_hash VARCHAR(64) NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    error_message TEXT,
+
+    CONSTRAINT valid_key_rotation_status CHECK (status IN ('running', 'completed'))
+);
+
+-- At most one rotation in progress per organization
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organization_key_rotations_running ON organization_key_rotations(organization_id) WHERE status = 'running';
+CREATE INDEX IF NOT EXISTS idx_organization_key_rotations_org ON organization_key_rotations(organization_id, started_at DESC);
+
+COMMENT ON COLUMN organization_teks.org_key_version IS 'Version of the organization key that org_key_hash belongs to';
+COMMENT ON COLUMN organization_teks.previous_org_key_hash IS 'Hash of the previous organization key; only set while a key rotation is in progress';
+COMMENT ON COLUMN pii_tokens.org_key_version IS 'Version of the organization key that encrypted encrypted_data';
+COMMENT ON COLUMN pii_tokens.previous_encrypted_data IS 'Ciphertext under the previous organization key, kept until the key rotation completes';
+COMMENT ON TABLE organization_key_rotations IS 'Background jobs re-encrypting tokens under a new organization key; last_reference_hash is the resume cursor';
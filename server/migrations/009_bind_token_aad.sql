@@ -0,0 +1,7 @@
+-- Tokens record the format they were encrypted in. Format 3 binds each ciphertext to
+-- its reference hash, organization, data type and TEK version with AES-GCM additional
+-- authenticated data; existing tokens were written in format 2, without it.
+
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS format_version INTEGER NOT NULL DEFAULT 2;
+
+COMMENT ON COLUMN pii_tokens.format_version IS 'Token format: 2 = AES-GCM without AAD, 3 = AES-GCM bound to the record with AAD';
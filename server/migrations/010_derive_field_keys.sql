@@ -0,0 +1,7 @@
+-- Format 4 tokens are encrypted with a field key derived from the organization's final
+-- encryption key, the token's data type and a random per-record salt. Tokens in earlier
+-- formats have no salt and keep using the organization-wide key.
+
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS key_salt BYTEA;
+
+COMMENT ON COLUMN pii_tokens.key_salt IS 'Random HKDF salt of the per-record field key; NULL for tokens before format 4';
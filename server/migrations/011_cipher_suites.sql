@@ -0,0 +1,11 @@
+-- Organizations choose the AEAD their new tokens are sealed with. Format 5 ciphertexts
+-- start with a header naming the suite, the TEK version and the nonce length, so every
+-- token decrypts with the suite it was sealed with regardless of later changes.
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS cipher_suite VARCHAR(32) NOT NULL DEFAULT 'aes-256-gcm';
+
+ALTER TABLE organizations DROP CONSTRAINT IF EXISTS valid_cipher_suite;
+ALTER TABLE organizations ADD CONSTRAINT valid_cipher_suite CHECK (cipher_suite IN ('aes-256-gcm', 'aes-256-gcm-siv', 'xchacha20-poly1305'));
+
+COMMENT ON COLUMN organizations.cipher_suite IS 'AEAD for new tokens: aes-256-gcm, aes-256-gcm-siv or xchacha20-poly1305';
+COMMENT ON COLUMN pii_tokens.iv IS 'Nonce of the cipher suite: 12 bytes for AES-256-GCM and AES-256-GCM-SIV, 24 for XChaCha20-Poly1305';
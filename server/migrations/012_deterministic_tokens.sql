@@ -0,0 +1,10 @@
+-- Organizations may tokenize chosen data types deterministically: the reference hash is
+-- an HMAC of the normalized value, so equal values share one token and can be joined.
+-- Tokens of other data types keep random reference hashes.
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS deterministic_data_types TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE organizations DROP CONSTRAINT IF EXISTS valid_deterministic_data_types;
+ALTER TABLE organizations ADD CONSTRAINT valid_deterministic_data_types CHECK (deterministic_data_types <@ ARRAY['email', 'ssn', 'phone', 'credit_card', 'name', 'address']::TEXT[]);
+
+COMMENT ON COLUMN organizations.deterministic_data_types IS 'Data types whose reference hashes are derived from the value instead of random';
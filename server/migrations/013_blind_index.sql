@@ -0,0 +1,17 @@
+-- Tokens carry a keyed blind index of their value so that callers holding the
+-- organization key can find the token of a known value without the server storing
+-- anything reversible. The index is an HMAC of the normalized value under a key derived
+-- from the TEK version and the organization key. Format-preserving tokens and tokens
+-- created before this migration have none.
+
+ALTER TABLE pii_tokens ADD COLUMN IF NOT EXISTS blind_index VARCHAR(64);
+
+CREATE INDEX IF NOT EXISTS idx_pii_tokens_blind_index ON pii_tokens(organization_id, data_type, blind_index)
+    WHERE blind_index IS NOT NULL;
+
+COMMENT ON COLUMN pii_tokens.blind_index IS 'Truncated HMAC-SHA256 of the normalized value under a per-organization, per-TEK-version key; NULL if not indexed';
+
+-- Lookups are recorded in the audit log with operation 'lookup'
+ALTER TABLE audit_logs DROP CONSTRAINT IF EXISTS valid_operation;
+ALTER TABLE audit_logs ADD CONSTRAINT valid_operation
+    CHECK (operation IN ('tokenize', 'detokenize', 'access', 'admin', 'lockout', 'lookup'));
@@ -0,0 +1,16 @@
+-- Organizations can be crypto-shredded: every TEK version is destroyed so that none of
+-- their ciphertexts can be decrypted again. The organization row stays behind with
+-- status 'shredded' so that its ID is never handed out again.
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS shredded_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE organizations DROP CONSTRAINT IF EXISTS valid_organization_status;
+ALTER TABLE organizations ADD CONSTRAINT valid_organization_status CHECK (status IN ('active', 'suspended', 'shredded'));
+
+COMMENT ON COLUMN organizations.status IS 'Lifecycle status: active, suspended or shredded';
+COMMENT ON COLUMN organizations.shredded_at IS 'When the organization''s TEKs were destroyed';
+
+-- The signed shred record is written to the audit log with operation 'shred'
+ALTER TABLE audit_logs DROP CONSTRAINT IF EXISTS valid_operation;
+ALTER TABLE audit_logs ADD CONSTRAINT valid_operation
+    CHECK (operation IN ('tokenize', 'detokenize', 'access', 'admin', 'lockout', 'lookup', 'shred'));
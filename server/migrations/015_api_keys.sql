@@ -0,0 +1,20 @@
+-- API keys authenticate clients of the API gateway. Only a SHA-256 hash of each key
+-- is stored; the key itself is shown once, when it is created.
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id VARCHAR(32) PRIMARY KEY,
+    client_id VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    key_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_client_id ON api_keys(client_id);
+
+COMMENT ON TABLE api_keys IS 'API keys of gateway clients; a key authenticates as its client_id';
+COMMENT ON COLUMN api_keys.key_id IS 'Public part of the key, embedded in it as mtk_<key_id>_<secret>';
+COMMENT ON COLUMN api_keys.key_hash IS 'Hex SHA-256 of the whole key';
+COMMENT ON COLUMN api_keys.last_used_at IS 'Last successful verification, updated at most once a minute';
@@ -0,0 +1,6 @@
+-- The KMS service audits every TEK wrap and unwrap, and every unseal request, with
+-- operations of their own
+ALTER TABLE audit_logs DROP CONSTRAINT IF EXISTS valid_operation;
+ALTER TABLE audit_logs ADD CONSTRAINT valid_operation
+    CHECK (operation IN ('tokenize', 'detokenize', 'access', 'admin', 'lockout', 'lookup', 'shred',
+                         'kek_wrap', 'kek_unwrap', 'kek_unseal'));
@@ -0,0 +1,938 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: kms/kms_service.proto
+
+package kms
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WrapKeyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Key               []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                      // Plaintext TEK
+	RequestingService string                 `protobuf:"bytes,2,opt,name=requesting_service,json=requestingService,proto3" json:"requesting_service,omitempty"` // Recorded in the audit log
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WrapKeyRequest) Reset() {
+	*x = WrapKeyRequest{}
+	mi := &file_kms_kms_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WrapKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrapKeyRequest) ProtoMessage() {}
+
+func (x *WrapKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrapKeyRequest.ProtoReflect.Descriptor instead.
+func (*WrapKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WrapKeyRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *WrapKeyRequest) GetRequestingService() string {
+	if x != nil {
+		return x.RequestingService
+	}
+	return ""
+}
+
+type WrapKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WrappedKey    []byte                 `protobuf:"bytes,1,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	KekId         string                 `protobuf:"bytes,2,opt,name=kek_id,json=kekId,proto3" json:"kek_id,omitempty"` // KEK that wrapped the key
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`            // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WrapKeyResponse) Reset() {
+	*x = WrapKeyResponse{}
+	mi := &file_kms_kms_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WrapKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrapKeyResponse) ProtoMessage() {}
+
+func (x *WrapKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrapKeyResponse.ProtoReflect.Descriptor instead.
+func (*WrapKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WrapKeyResponse) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+func (x *WrapKeyResponse) GetKekId() string {
+	if x != nil {
+		return x.KekId
+	}
+	return ""
+}
+
+func (x *WrapKeyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WrapKeyResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UnwrapKeyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WrappedKey        []byte                 `protobuf:"bytes,1,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	RequestingService string                 `protobuf:"bytes,2,opt,name=requesting_service,json=requestingService,proto3" json:"requesting_service,omitempty"` // Recorded in the audit log
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UnwrapKeyRequest) Reset() {
+	*x = UnwrapKeyRequest{}
+	mi := &file_kms_kms_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwrapKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwrapKeyRequest) ProtoMessage() {}
+
+func (x *UnwrapKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwrapKeyRequest.ProtoReflect.Descriptor instead.
+func (*UnwrapKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnwrapKeyRequest) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+func (x *UnwrapKeyRequest) GetRequestingService() string {
+	if x != nil {
+		return x.RequestingService
+	}
+	return ""
+}
+
+type UnwrapKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                  // Plaintext TEK
+	KekId         string                 `protobuf:"bytes,2,opt,name=kek_id,json=kekId,proto3" json:"kek_id,omitempty"` // KEK that wrapped the key
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`            // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwrapKeyResponse) Reset() {
+	*x = UnwrapKeyResponse{}
+	mi := &file_kms_kms_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwrapKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwrapKeyResponse) ProtoMessage() {}
+
+func (x *UnwrapKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwrapKeyResponse.ProtoReflect.Descriptor instead.
+func (*UnwrapKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnwrapKeyResponse) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *UnwrapKeyResponse) GetKekId() string {
+	if x != nil {
+		return x.KekId
+	}
+	return ""
+}
+
+func (x *UnwrapKeyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnwrapKeyResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetKeyInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WrappedKeys   [][]byte               `protobuf:"bytes,1,rep,name=wrapped_keys,json=wrappedKeys,proto3" json:"wrapped_keys,omitempty"` // Optional: wrapped TEKs to report the KEK IDs of
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeyInfoRequest) Reset() {
+	*x = GetKeyInfoRequest{}
+	mi := &file_kms_kms_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeyInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyInfoRequest) ProtoMessage() {}
+
+func (x *GetKeyInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetKeyInfoRequest) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetKeyInfoRequest) GetWrappedKeys() [][]byte {
+	if x != nil {
+		return x.WrappedKeys
+	}
+	return nil
+}
+
+type GetKeyInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // KEK provider behind the key service: "static", "vault" or "pkcs11"
+	CurrentKekId  string                 `protobuf:"bytes,2,opt,name=current_kek_id,json=currentKekId,proto3" json:"current_kek_id,omitempty"`
+	KekIds        []string               `protobuf:"bytes,3,rep,name=kek_ids,json=kekIds,proto3" json:"kek_ids,omitempty"`                        // KEKs that can unwrap, current first
+	WrappedKekIds []string               `protobuf:"bytes,4,rep,name=wrapped_kek_ids,json=wrappedKekIds,proto3" json:"wrapped_kek_ids,omitempty"` // KEK ID of each requested wrapped TEK, in order; "" if unknown
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                      // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeyInfoResponse) Reset() {
+	*x = GetKeyInfoResponse{}
+	mi := &file_kms_kms_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeyInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyInfoResponse) ProtoMessage() {}
+
+func (x *GetKeyInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetKeyInfoResponse) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetKeyInfoResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetKeyInfoResponse) GetCurrentKekId() string {
+	if x != nil {
+		return x.CurrentKekId
+	}
+	return ""
+}
+
+func (x *GetKeyInfoResponse) GetKekIds() []string {
+	if x != nil {
+		return x.KekIds
+	}
+	return nil
+}
+
+func (x *GetKeyInfoResponse) GetWrappedKekIds() []string {
+	if x != nil {
+		return x.WrappedKekIds
+	}
+	return nil
+}
+
+func (x *GetKeyInfoResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetKeyInfoResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SealStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sealed        bool                   `protobuf:"varint,1,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	Threshold     int32                  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"` // Shares needed to unseal
+	Progress      int32                  `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`   // Shares submitted so far
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SealStatus) Reset() {
+	*x = SealStatus{}
+	mi := &file_kms_kms_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SealStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealStatus) ProtoMessage() {}
+
+func (x *SealStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealStatus.ProtoReflect.Descriptor instead.
+func (*SealStatus) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SealStatus) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+func (x *SealStatus) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SealStatus) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type UnsealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         []byte                 `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`         // Output of the key ceremony, decoded from base64
+	Custodian     string                 `protobuf:"bytes,2,opt,name=custodian,proto3" json:"custodian,omitempty"` // Recorded in the audit log
+	Reset_        bool                   `protobuf:"varint,3,opt,name=reset,proto3" json:"reset,omitempty"`        // Discard the shares submitted so far instead of adding one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsealRequest) Reset() {
+	*x = UnsealRequest{}
+	mi := &file_kms_kms_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsealRequest) ProtoMessage() {}
+
+func (x *UnsealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsealRequest.ProtoReflect.Descriptor instead.
+func (*UnsealRequest) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnsealRequest) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *UnsealRequest) GetCustodian() string {
+	if x != nil {
+		return x.Custodian
+	}
+	return ""
+}
+
+func (x *UnsealRequest) GetReset_() bool {
+	if x != nil {
+		return x.Reset_
+	}
+	return false
+}
+
+type UnsealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SealStatus    *SealStatus            `protobuf:"bytes,1,opt,name=seal_status,json=sealStatus,proto3" json:"seal_status,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsealResponse) Reset() {
+	*x = UnsealResponse{}
+	mi := &file_kms_kms_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsealResponse) ProtoMessage() {}
+
+func (x *UnsealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsealResponse.ProtoReflect.Descriptor instead.
+func (*UnsealResponse) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnsealResponse) GetSealStatus() *SealStatus {
+	if x != nil {
+		return x.SealStatus
+	}
+	return nil
+}
+
+func (x *UnsealResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnsealResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetSealStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSealStatusRequest) Reset() {
+	*x = GetSealStatusRequest{}
+	mi := &file_kms_kms_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSealStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSealStatusRequest) ProtoMessage() {}
+
+func (x *GetSealStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSealStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSealStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{9}
+}
+
+type GetSealStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SealStatus    *SealStatus            `protobuf:"bytes,1,opt,name=seal_status,json=sealStatus,proto3" json:"seal_status,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSealStatusResponse) Reset() {
+	*x = GetSealStatusResponse{}
+	mi := &file_kms_kms_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSealStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSealStatusResponse) ProtoMessage() {}
+
+func (x *GetSealStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSealStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSealStatusResponse) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSealStatusResponse) GetSealStatus() *SealStatus {
+	if x != nil {
+		return x.SealStatus
+	}
+	return nil
+}
+
+func (x *GetSealStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetSealStatusResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_kms_kms_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HealthCheckRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "healthy", "sealed", "degraded", "unhealthy"
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_kms_kms_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kms_kms_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_kms_kms_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HealthCheckResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *HealthCheckResponse) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+var File_kms_kms_service_proto protoreflect.FileDescriptor
+
+const file_kms_kms_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15kms/kms_service.proto\x12\x03kms\x1a\x1fgoogle/protobuf/timestamp.proto\"Q\n" +
+	"\x0eWrapKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12-\n" +
+	"\x12requesting_service\x18\x02 \x01(\tR\x11requestingService\"\x86\x01\n" +
+	"\x0fWrapKeyResponse\x12\x1f\n" +
+	"\vwrapped_key\x18\x01 \x01(\fR\n" +
+	"wrappedKey\x12\x15\n" +
+	"\x06kek_id\x18\x02 \x01(\tR\x05kekId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"b\n" +
+	"\x10UnwrapKeyRequest\x12\x1f\n" +
+	"\vwrapped_key\x18\x01 \x01(\fR\n" +
+	"wrappedKey\x12-\n" +
+	"\x12requesting_service\x18\x02 \x01(\tR\x11requestingService\"y\n" +
+	"\x11UnwrapKeyResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x15\n" +
+	"\x06kek_id\x18\x02 \x01(\tR\x05kekId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"6\n" +
+	"\x11GetKeyInfoRequest\x12!\n" +
+	"\fwrapped_keys\x18\x01 \x03(\fR\vwrappedKeys\"\xd4\x01\n" +
+	"\x12GetKeyInfoResponse\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12$\n" +
+	"\x0ecurrent_kek_id\x18\x02 \x01(\tR\fcurrentKekId\x12\x17\n" +
+	"\akek_ids\x18\x03 \x03(\tR\x06kekIds\x12&\n" +
+	"\x0fwrapped_kek_ids\x18\x04 \x03(\tR\rwrappedKekIds\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"^\n" +
+	"\n" +
+	"SealStatus\x12\x16\n" +
+	"\x06sealed\x18\x01 \x01(\bR\x06sealed\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x05R\tthreshold\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\x05R\bprogress\"Y\n" +
+	"\rUnsealRequest\x12\x14\n" +
+	"\x05share\x18\x01 \x01(\fR\x05share\x12\x1c\n" +
+	"\tcustodian\x18\x02 \x01(\tR\tcustodian\x12\x14\n" +
+	"\x05reset\x18\x03 \x01(\bR\x05reset\"\x7f\n" +
+	"\x0eUnsealResponse\x120\n" +
+	"\vseal_status\x18\x01 \x01(\v2\x0f.kms.SealStatusR\n" +
+	"sealStatus\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x16\n" +
+	"\x14GetSealStatusRequest\"\x86\x01\n" +
+	"\x15GetSealStatusResponse\x120\n" +
+	"\vseal_status\x18\x01 \x01(\v2\x0f.kms.SealStatusR\n" +
+	"sealStatus\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"7\n" +
+	"\x12HealthCheckRequest\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\"\xa1\x02\n" +
+	"\x13HealthCheckResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12?\n" +
+	"\adetails\x18\x05 \x03(\v2%.kms.HealthCheckResponse.DetailsEntryR\adetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xfa\x02\n" +
+	"\n" +
+	"KMSService\x124\n" +
+	"\aWrapKey\x12\x13.kms.WrapKeyRequest\x1a\x14.kms.WrapKeyResponse\x12:\n" +
+	"\tUnwrapKey\x12\x15.kms.UnwrapKeyRequest\x1a\x16.kms.UnwrapKeyResponse\x12=\n" +
+	"\n" +
+	"GetKeyInfo\x12\x16.kms.GetKeyInfoRequest\x1a\x17.kms.GetKeyInfoResponse\x121\n" +
+	"\x06Unseal\x12\x12.kms.UnsealRequest\x1a\x13.kms.UnsealResponse\x12F\n" +
+	"\rGetSealStatus\x12\x19.kms.GetSealStatusRequest\x1a\x1a.kms.GetSealStatusResponse\x12@\n" +
+	"\vHealthCheck\x12\x17.kms.HealthCheckRequest\x1a\x18.kms.HealthCheckResponseB/Z-github.com/PlainFunction/mistokenly/proto/kmsb\x06proto3"
+
+var (
+	file_kms_kms_service_proto_rawDescOnce sync.Once
+	file_kms_kms_service_proto_rawDescData []byte
+)
+
+func file_kms_kms_service_proto_rawDescGZIP() []byte {
+	file_kms_kms_service_proto_rawDescOnce.Do(func() {
+		file_kms_kms_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kms_kms_service_proto_rawDesc), len(file_kms_kms_service_proto_rawDesc)))
+	})
+	return file_kms_kms_service_proto_rawDescData
+}
+
+var file_kms_kms_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_kms_kms_service_proto_goTypes = []any{
+	(*WrapKeyRequest)(nil),        // 0: kms.WrapKeyRequest
+	(*WrapKeyResponse)(nil),       // 1: kms.WrapKeyResponse
+	(*UnwrapKeyRequest)(nil),      // 2: kms.UnwrapKeyRequest
+	(*UnwrapKeyResponse)(nil),     // 3: kms.UnwrapKeyResponse
+	(*GetKeyInfoRequest)(nil),     // 4: kms.GetKeyInfoRequest
+	(*GetKeyInfoResponse)(nil),    // 5: kms.GetKeyInfoResponse
+	(*SealStatus)(nil),            // 6: kms.SealStatus
+	(*UnsealRequest)(nil),         // 7: kms.UnsealRequest
+	(*UnsealResponse)(nil),        // 8: kms.UnsealResponse
+	(*GetSealStatusRequest)(nil),  // 9: kms.GetSealStatusRequest
+	(*GetSealStatusResponse)(nil), // 10: kms.GetSealStatusResponse
+	(*HealthCheckRequest)(nil),    // 11: kms.HealthCheckRequest
+	(*HealthCheckResponse)(nil),   // 12: kms.HealthCheckResponse
+	nil,                           // 13: kms.HealthCheckResponse.DetailsEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_kms_kms_service_proto_depIdxs = []int32{
+	6,  // 0: kms.UnsealResponse.seal_status:type_name -> kms.SealStatus
+	6,  // 1: kms.GetSealStatusResponse.seal_status:type_name -> kms.SealStatus
+	14, // 2: kms.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	13, // 3: kms.HealthCheckResponse.details:type_name -> kms.HealthCheckResponse.DetailsEntry
+	0,  // 4: kms.KMSService.WrapKey:input_type -> kms.WrapKeyRequest
+	2,  // 5: kms.KMSService.UnwrapKey:input_type -> kms.UnwrapKeyRequest
+	4,  // 6: kms.KMSService.GetKeyInfo:input_type -> kms.GetKeyInfoRequest
+	7,  // 7: kms.KMSService.Unseal:input_type -> kms.UnsealRequest
+	9,  // 8: kms.KMSService.GetSealStatus:input_type -> kms.GetSealStatusRequest
+	11, // 9: kms.KMSService.HealthCheck:input_type -> kms.HealthCheckRequest
+	1,  // 10: kms.KMSService.WrapKey:output_type -> kms.WrapKeyResponse
+	3,  // 11: kms.KMSService.UnwrapKey:output_type -> kms.UnwrapKeyResponse
+	5,  // 12: kms.KMSService.GetKeyInfo:output_type -> kms.GetKeyInfoResponse
+	8,  // 13: kms.KMSService.Unseal:output_type -> kms.UnsealResponse
+	10, // 14: kms.KMSService.GetSealStatus:output_type -> kms.GetSealStatusResponse
+	12, // 15: kms.KMSService.HealthCheck:output_type -> kms.HealthCheckResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_kms_kms_service_proto_init() }
+func file_kms_kms_service_proto_init() {
+	if File_kms_kms_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kms_kms_service_proto_rawDesc), len(file_kms_kms_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kms_kms_service_proto_goTypes,
+		DependencyIndexes: file_kms_kms_service_proto_depIdxs,
+		MessageInfos:      file_kms_kms_service_proto_msgTypes,
+	}.Build()
+	File_kms_kms_service_proto = out.File
+	file_kms_kms_service_proto_goTypes = nil
+	file_kms_kms_service_proto_depIdxs = nil
+}
@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: kms/kms_service.proto
+
+package kms
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	KMSService_WrapKey_FullMethodName       = "/kms.KMSService/WrapKey"
+	KMSService_UnwrapKey_FullMethodName     = "/kms.KMSService/UnwrapKey"
+	KMSService_GetKeyInfo_FullMethodName    = "/kms.KMSService/GetKeyInfo"
+	KMSService_Unseal_FullMethodName        = "/kms.KMSService/Unseal"
+	KMSService_GetSealStatus_FullMethodName = "/kms.KMSService/GetSealStatus"
+	KMSService_HealthCheck_FullMethodName   = "/kms.KMSService/HealthCheck"
+)
+
+// KMSServiceClient is the client API for KMSService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// KMSService owns the Key Encryption Key. Other services send it TEKs to wrap and
+// unwrap, so the KEK itself never leaves the key service.
+type KMSServiceClient interface {
+	// WrapKey encrypts a TEK with the current KEK
+	WrapKey(ctx context.Context, in *WrapKeyRequest, opts ...grpc.CallOption) (*WrapKeyResponse, error)
+	// UnwrapKey decrypts a TEK with the KEK that wrapped it. Calls are rate-limited per
+	// caller and audited.
+	UnwrapKey(ctx context.Context, in *UnwrapKeyRequest, opts ...grpc.CallOption) (*UnwrapKeyResponse, error)
+	// GetKeyInfo reports the current KEK and the KEK ring, and optionally which KEK
+	// wrapped each of the given TEKs
+	GetKeyInfo(ctx context.Context, in *GetKeyInfoRequest, opts ...grpc.CallOption) (*GetKeyInfoResponse, error)
+	// Unseal submits one custodian's Shamir share of the KEK. When the key service runs
+	// sealed, the KEK is reconstructed once enough shares have been submitted.
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	// GetSealStatus reports whether the KEK is sealed and the unseal progress
+	GetSealStatus(ctx context.Context, in *GetSealStatusRequest, opts ...grpc.CallOption) (*GetSealStatusResponse, error)
+	// HealthCheck returns the health status of the key service; "sealed" until the KEK is unsealed
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type kMSServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKMSServiceClient(cc grpc.ClientConnInterface) KMSServiceClient {
+	return &kMSServiceClient{cc}
+}
+
+func (c *kMSServiceClient) WrapKey(ctx context.Context, in *WrapKeyRequest, opts ...grpc.CallOption) (*WrapKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WrapKeyResponse)
+	err := c.cc.Invoke(ctx, KMSService_WrapKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kMSServiceClient) UnwrapKey(ctx context.Context, in *UnwrapKeyRequest, opts ...grpc.CallOption) (*UnwrapKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnwrapKeyResponse)
+	err := c.cc.Invoke(ctx, KMSService_UnwrapKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kMSServiceClient) GetKeyInfo(ctx context.Context, in *GetKeyInfoRequest, opts ...grpc.CallOption) (*GetKeyInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeyInfoResponse)
+	err := c.cc.Invoke(ctx, KMSService_GetKeyInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kMSServiceClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, KMSService_Unseal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kMSServiceClient) GetSealStatus(ctx context.Context, in *GetSealStatusRequest, opts ...grpc.CallOption) (*GetSealStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSealStatusResponse)
+	err := c.cc.Invoke(ctx, KMSService_GetSealStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kMSServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, KMSService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KMSServiceServer is the server API for KMSService service.
+// All implementations must embed UnimplementedKMSServiceServer
+// for forward compatibility.
+//
+// KMSService owns the Key Encryption Key. Other services send it TEKs to wrap and
+// unwrap, so the KEK itself never leaves the key service.
+type KMSServiceServer interface {
+	// WrapKey encrypts a TEK with the current KEK
+	WrapKey(context.Context, *WrapKeyRequest) (*WrapKeyResponse, error)
+	// UnwrapKey decrypts a TEK with the KEK that wrapped it. Calls are rate-limited per
+	// caller and audited.
+	UnwrapKey(context.Context, *UnwrapKeyRequest) (*UnwrapKeyResponse, error)
+	// GetKeyInfo reports the current KEK and the KEK ring, and optionally which KEK
+	// wrapped each of the given TEKs
+	GetKeyInfo(context.Context, *GetKeyInfoRequest) (*GetKeyInfoResponse, error)
+	// Unseal submits one custodian's Shamir share of the KEK. When the key service runs
+	// sealed, the KEK is reconstructed once enough shares have been submitted.
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	// GetSealStatus reports whether the KEK is sealed and the unseal progress
+	GetSealStatus(context.Context, *GetSealStatusRequest) (*GetSealStatusResponse, error)
+	// HealthCheck returns the health status of the key service; "sealed" until the KEK is unsealed
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	mustEmbedUnimplementedKMSServiceServer()
+}
+
+// UnimplementedKMSServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKMSServiceServer struct{}
+
+func (UnimplementedKMSServiceServer) WrapKey(context.Context, *WrapKeyRequest) (*WrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapKey not implemented")
+}
+func (UnimplementedKMSServiceServer) UnwrapKey(context.Context, *UnwrapKeyRequest) (*UnwrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwrapKey not implemented")
+}
+func (UnimplementedKMSServiceServer) GetKeyInfo(context.Context, *GetKeyInfoRequest) (*GetKeyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyInfo not implemented")
+}
+func (UnimplementedKMSServiceServer) Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (UnimplementedKMSServiceServer) GetSealStatus(context.Context, *GetSealStatusRequest) (*GetSealStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSealStatus not implemented")
+}
+func (UnimplementedKMSServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedKMSServiceServer) mustEmbedUnimplementedKMSServiceServer() {}
+func (UnimplementedKMSServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeKMSServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KMSServiceServer will
+// result in compilation errors.
+type UnsafeKMSServiceServer interface {
+	mustEmbedUnimplementedKMSServiceServer()
+}
+
+func RegisterKMSServiceServer(s grpc.ServiceRegistrar, srv KMSServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKMSServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KMSService_ServiceDesc, srv)
+}
+
+func _KMSService_WrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KMSServiceServer).WrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KMSService_WrapKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KMSServiceServer).WrapKey(ctx, req.(*WrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KMSService_UnwrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KMSServiceServer).UnwrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KMSService_UnwrapKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KMSServiceServer).UnwrapKey(ctx, req.(*UnwrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KMSService_GetKeyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KMSServiceServer).GetKeyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KMSService_GetKeyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KMSServiceServer).GetKeyInfo(ctx, req.(*GetKeyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KMSService_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KMSServiceServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KMSService_Unseal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KMSServiceServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KMSService_GetSealStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSealStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KMSServiceServer).GetSealStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KMSService_GetSealStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KMSServiceServer).GetSealStatus(ctx, req.(*GetSealStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KMSService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KMSServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KMSService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KMSServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KMSService_ServiceDesc is the grpc.ServiceDesc for KMSService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KMSService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kms.KMSService",
+	HandlerType: (*KMSServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WrapKey",
+			Handler:    _KMSService_WrapKey_Handler,
+		},
+		{
+			MethodName: "UnwrapKey",
+			Handler:    _KMSService_UnwrapKey_Handler,
+		},
+		{
+			MethodName: "GetKeyInfo",
+			Handler:    _KMSService_GetKeyInfo_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _KMSService_Unseal_Handler,
+		},
+		{
+			MethodName: "GetSealStatus",
+			Handler:    _KMSService_GetSealStatus_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _KMSService_HealthCheck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kms/kms_service.proto",
+}
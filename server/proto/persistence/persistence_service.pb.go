@@ -35,6 +35,12 @@ type StorePIITokenRequest struct {
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	Metadata       map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TekVersion     int32                  `protobuf:"varint,10,opt,name=tek_version,json=tekVersion,proto3" json:"tek_version,omitempty"`            // TEK version that encrypted the data
+	OrgKeyVersion  int32                  `protobuf:"varint,11,opt,name=org_key_version,json=orgKeyVersion,proto3" json:"org_key_version,omitempty"` // Organization key version that encrypted the data
+	FormatVersion  int32                  `protobuf:"varint,12,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`   // Token format; 0 means 2, the format before ciphertexts were bound to their record
+	KeySalt        []byte                 `protobuf:"bytes,13,opt,name=key_salt,json=keySalt,proto3" json:"key_salt,omitempty"`                      // Random per-record salt of the field key, from format 4 on
+	InsertOnly     bool                   `protobuf:"varint,14,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`            // Never replace an existing token; the status is "conflict" instead
+	BlindIndex     string                 `protobuf:"bytes,15,opt,name=blind_index,json=blindIndex,proto3" json:"blind_index,omitempty"`             // Keyed HMAC of the normalized value; empty if the token is not indexed
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -132,10 +138,52 @@ func (x *StorePIITokenRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *StorePIITokenRequest) GetTekVersion() int32 {
+	if x != nil {
+		return x.TekVersion
+	}
+	return 0
+}
+
+func (x *StorePIITokenRequest) GetOrgKeyVersion() int32 {
+	if x != nil {
+		return x.OrgKeyVersion
+	}
+	return 0
+}
+
+func (x *StorePIITokenRequest) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *StorePIITokenRequest) GetKeySalt() []byte {
+	if x != nil {
+		return x.KeySalt
+	}
+	return nil
+}
+
+func (x *StorePIITokenRequest) GetInsertOnly() bool {
+	if x != nil {
+		return x.InsertOnly
+	}
+	return false
+}
+
+func (x *StorePIITokenRequest) GetBlindIndex() string {
+	if x != nil {
+		return x.BlindIndex
+	}
+	return ""
+}
+
 type StorePIITokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReferenceHash string                 `protobuf:"bytes,1,opt,name=reference_hash,json=referenceHash,proto3" json:"reference_hash,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success", "conflict" (insert_only) or "error"
 	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -246,20 +294,26 @@ func (x *RetrievePIITokenRequest) GetOrganizationId() string {
 }
 
 type RetrievePIITokenResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ReferenceHash  string                 `protobuf:"bytes,1,opt,name=reference_hash,json=referenceHash,proto3" json:"reference_hash,omitempty"`
-	EncryptedData  []byte                 `protobuf:"bytes,2,opt,name=encrypted_data,json=encryptedData,proto3" json:"encrypted_data,omitempty"`
-	Iv             []byte                 `protobuf:"bytes,3,opt,name=iv,proto3" json:"iv,omitempty"`
-	DataType       string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	ClientId       string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Metadata       map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
-	ErrorMessage   string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceHash         string                 `protobuf:"bytes,1,opt,name=reference_hash,json=referenceHash,proto3" json:"reference_hash,omitempty"`
+	EncryptedData         []byte                 `protobuf:"bytes,2,opt,name=encrypted_data,json=encryptedData,proto3" json:"encrypted_data,omitempty"`
+	Iv                    []byte                 `protobuf:"bytes,3,opt,name=iv,proto3" json:"iv,omitempty"`
+	DataType              string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	ClientId              string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	OrganizationId        string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Metadata              map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status                string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage          string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	TekVersion            int32                  `protobuf:"varint,12,opt,name=tek_version,json=tekVersion,proto3" json:"tek_version,omitempty"`                                   // TEK version that encrypted the data
+	OrgKeyVersion         int32                  `protobuf:"varint,13,opt,name=org_key_version,json=orgKeyVersion,proto3" json:"org_key_version,omitempty"`                        // Organization key version that encrypted encrypted_data
+	PreviousEncryptedData []byte                 `protobuf:"bytes,14,opt,name=previous_encrypted_data,json=previousEncryptedData,proto3" json:"previous_encrypted_data,omitempty"` // Set while a key rotation is in progress: the data under the previous organization key
+	PreviousIv            []byte                 `protobuf:"bytes,15,opt,name=previous_iv,json=previousIv,proto3" json:"previous_iv,omitempty"`
+	FormatVersion         int32                  `protobuf:"varint,16,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"` // Token format, which determines the additional authenticated data and key derivation
+	KeySalt               []byte                 `protobuf:"bytes,17,opt,name=key_salt,json=keySalt,proto3" json:"key_salt,omitempty"`                    // Random per-record salt of the field key, from format 4 on
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *RetrievePIITokenResponse) Reset() {
@@ -369,6 +423,245 @@ func (x *RetrievePIITokenResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *RetrievePIITokenResponse) GetTekVersion() int32 {
+	if x != nil {
+		return x.TekVersion
+	}
+	return 0
+}
+
+func (x *RetrievePIITokenResponse) GetOrgKeyVersion() int32 {
+	if x != nil {
+		return x.OrgKeyVersion
+	}
+	return 0
+}
+
+func (x *RetrievePIITokenResponse) GetPreviousEncryptedData() []byte {
+	if x != nil {
+		return x.PreviousEncryptedData
+	}
+	return nil
+}
+
+func (x *RetrievePIITokenResponse) GetPreviousIv() []byte {
+	if x != nil {
+		return x.PreviousIv
+	}
+	return nil
+}
+
+func (x *RetrievePIITokenResponse) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *RetrievePIITokenResponse) GetKeySalt() []byte {
+	if x != nil {
+		return x.KeySalt
+	}
+	return nil
+}
+
+type LookupPIITokensRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DataType       string                 `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	BlindIndexes   []string               `protobuf:"bytes,3,rep,name=blind_indexes,json=blindIndexes,proto3" json:"blind_indexes,omitempty"` // One per TEK version of the organization
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Defaults to 100
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LookupPIITokensRequest) Reset() {
+	*x = LookupPIITokensRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupPIITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPIITokensRequest) ProtoMessage() {}
+
+func (x *LookupPIITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPIITokensRequest.ProtoReflect.Descriptor instead.
+func (*LookupPIITokensRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LookupPIITokensRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *LookupPIITokensRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *LookupPIITokensRequest) GetBlindIndexes() []string {
+	if x != nil {
+		return x.BlindIndexes
+	}
+	return nil
+}
+
+func (x *LookupPIITokensRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// TokenReference describes a stored token without its ciphertext
+type TokenReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceHash string                 `protobuf:"bytes,1,opt,name=reference_hash,json=referenceHash,proto3" json:"reference_hash,omitempty"`
+	FormatVersion int32                  `protobuf:"varint,2,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenReference) Reset() {
+	*x = TokenReference{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenReference) ProtoMessage() {}
+
+func (x *TokenReference) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenReference.ProtoReflect.Descriptor instead.
+func (*TokenReference) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TokenReference) GetReferenceHash() string {
+	if x != nil {
+		return x.ReferenceHash
+	}
+	return ""
+}
+
+func (x *TokenReference) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *TokenReference) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TokenReference) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type LookupPIITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*TokenReference      `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"` // Newest first
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupPIITokensResponse) Reset() {
+	*x = LookupPIITokensResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupPIITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPIITokensResponse) ProtoMessage() {}
+
+func (x *LookupPIITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPIITokensResponse.ProtoReflect.Descriptor instead.
+func (*LookupPIITokensResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LookupPIITokensResponse) GetTokens() []*TokenReference {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *LookupPIITokensResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LookupPIITokensResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
@@ -378,7 +671,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_persistence_persistence_service_proto_msgTypes[4]
+	mi := &file_persistence_persistence_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +683,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_persistence_persistence_service_proto_msgTypes[4]
+	mi := &file_persistence_persistence_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +696,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{4}
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HealthCheckRequest) GetServiceName() string {
@@ -426,7 +719,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_persistence_persistence_service_proto_msgTypes[5]
+	mi := &file_persistence_persistence_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -438,7 +731,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_persistence_persistence_service_proto_msgTypes[5]
+	mi := &file_persistence_persistence_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +744,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{5}
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HealthCheckResponse) GetStatus() string {
@@ -504,7 +797,7 @@ type StoreTEKRequest struct {
 
 func (x *StoreTEKRequest) Reset() {
 	*x = StoreTEKRequest{}
-	mi := &file_persistence_persistence_service_proto_msgTypes[6]
+	mi := &file_persistence_persistence_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +809,7 @@ func (x *StoreTEKRequest) String() string {
 func (*StoreTEKRequest) ProtoMessage() {}
 
 func (x *StoreTEKRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_persistence_persistence_service_proto_msgTypes[6]
+	mi := &file_persistence_persistence_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +822,7 @@ func (x *StoreTEKRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreTEKRequest.ProtoReflect.Descriptor instead.
 func (*StoreTEKRequest) Descriptor() ([]byte, []int) {
-	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{6}
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StoreTEKRequest) GetOrganizationId() string {
@@ -579,13 +872,18 @@ type StoreTEKResponse struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
 	ErrorMessage   string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Created        bool                   `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`                              // false when another request already provisioned the TEK
+	EncryptedTek   []byte                 `protobuf:"bytes,5,opt,name=encrypted_tek,json=encryptedTek,proto3" json:"encrypted_tek,omitempty"` // The organization's stored TEK (the winner's on conflict)
+	OrgKeyHash     string                 `protobuf:"bytes,6,opt,name=org_key_hash,json=orgKeyHash,proto3" json:"org_key_hash,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Version        int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StoreTEKResponse) Reset() {
 	*x = StoreTEKResponse{}
-	mi := &file_persistence_persistence_service_proto_msgTypes[7]
+	mi := &file_persistence_persistence_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +895,7 @@ func (x *StoreTEKResponse) String() string {
 func (*StoreTEKResponse) ProtoMessage() {}
 
 func (x *StoreTEKResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_persistence_persistence_service_proto_msgTypes[7]
+	mi := &file_persistence_persistence_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +908,7 @@ func (x *StoreTEKResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreTEKResponse.ProtoReflect.Descriptor instead.
 func (*StoreTEKResponse) Descriptor() ([]byte, []int) {
-	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{7}
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StoreTEKResponse) GetOrganizationId() string {
@@ -634,30 +932,2611 @@ func (x *StoreTEKResponse) GetErrorMessage() string {
 	return ""
 }
 
-// RetrieveTEKRequest represents a request to retrieve a TEK for an organization
-type RetrieveTEKRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationKey string                 `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"` // For verification
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *StoreTEKResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
 }
 
-func (x *RetrieveTEKRequest) Reset() {
-	*x = RetrieveTEKRequest{}
-	mi := &file_persistence_persistence_service_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *StoreTEKResponse) GetEncryptedTek() []byte {
+	if x != nil {
+		return x.EncryptedTek
+	}
+	return nil
+}
+
+func (x *StoreTEKResponse) GetOrgKeyHash() string {
+	if x != nil {
+		return x.OrgKeyHash
+	}
+	return ""
+}
+
+func (x *StoreTEKResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StoreTEKResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RetrieveTEKRequest represents a request to retrieve a TEK for an organization
+type RetrieveTEKRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey string                 `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"` // For verification
+	Source          string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                          // Originating client address, used for brute-force lockout counters
+	Version         int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                                       // TEK version to return; 0 returns the active version
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RetrieveTEKRequest) Reset() {
+	*x = RetrieveTEKRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveTEKRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveTEKRequest) ProtoMessage() {}
+
+func (x *RetrieveTEKRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveTEKRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveTEKRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RetrieveTEKRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RetrieveTEKRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+func (x *RetrieveTEKRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RetrieveTEKRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RetrieveTEKResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId         string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	EncryptedTek           []byte                 `protobuf:"bytes,2,opt,name=encrypted_tek,json=encryptedTek,proto3" json:"encrypted_tek,omitempty"` // TEK encrypted with KEK
+	OrgKeyHash             string                 `protobuf:"bytes,3,opt,name=org_key_hash,json=orgKeyHash,proto3" json:"org_key_hash,omitempty"`     // SHA-256 hash of organization key
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RotatedAt              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"` // Nullable
+	Version                int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Status                 string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage           string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	OrgKeyVersion          int32                  `protobuf:"varint,9,opt,name=org_key_version,json=orgKeyVersion,proto3" json:"org_key_version,omitempty"`                            // Version of the organization key that was verified
+	RetiringOrgKey         bool                   `protobuf:"varint,10,opt,name=retiring_org_key,json=retiringOrgKey,proto3" json:"retiring_org_key,omitempty"`                        // The verified key is being rotated out and may only decrypt
+	CipherSuite            string                 `protobuf:"bytes,11,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`                                    // Cipher suite for new tokens of the organization
+	DeterministicDataTypes []string               `protobuf:"bytes,12,rep,name=deterministic_data_types,json=deterministicDataTypes,proto3" json:"deterministic_data_types,omitempty"` // Data types the organization tokenizes deterministically
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RetrieveTEKResponse) Reset() {
+	*x = RetrieveTEKResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveTEKResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveTEKResponse) ProtoMessage() {}
+
+func (x *RetrieveTEKResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveTEKResponse.ProtoReflect.Descriptor instead.
+func (*RetrieveTEKResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RetrieveTEKResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RetrieveTEKResponse) GetEncryptedTek() []byte {
+	if x != nil {
+		return x.EncryptedTek
+	}
+	return nil
+}
+
+func (x *RetrieveTEKResponse) GetOrgKeyHash() string {
+	if x != nil {
+		return x.OrgKeyHash
+	}
+	return ""
+}
+
+func (x *RetrieveTEKResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RetrieveTEKResponse) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return nil
+}
+
+func (x *RetrieveTEKResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RetrieveTEKResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RetrieveTEKResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RetrieveTEKResponse) GetOrgKeyVersion() int32 {
+	if x != nil {
+		return x.OrgKeyVersion
+	}
+	return 0
+}
+
+func (x *RetrieveTEKResponse) GetRetiringOrgKey() bool {
+	if x != nil {
+		return x.RetiringOrgKey
+	}
+	return false
+}
+
+func (x *RetrieveTEKResponse) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+func (x *RetrieveTEKResponse) GetDeterministicDataTypes() []string {
+	if x != nil {
+		return x.DeterministicDataTypes
+	}
+	return nil
+}
+
+// Organization describes a tenant registered with the platform
+type Organization struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId         string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DisplayName            string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Status                 string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "active", "suspended" or "shredded"
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SuspendedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"` // Nullable
+	SuspendedReason        string                 `protobuf:"bytes,7,opt,name=suspended_reason,json=suspendedReason,proto3" json:"suspended_reason,omitempty"`
+	CipherSuite            string                 `protobuf:"bytes,8,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`                                    // "aes-256-gcm", "aes-256-gcm-siv" or "xchacha20-poly1305"
+	DeterministicDataTypes []string               `protobuf:"bytes,9,rep,name=deterministic_data_types,json=deterministicDataTypes,proto3" json:"deterministic_data_types,omitempty"` // Data types whose reference tokens are derived from the value
+	ShreddedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=shredded_at,json=shreddedAt,proto3" json:"shredded_at,omitempty"`                                      // Nullable
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Organization) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Organization) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Organization) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetSuspendedReason() string {
+	if x != nil {
+		return x.SuspendedReason
+	}
+	return ""
+}
+
+func (x *Organization) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+func (x *Organization) GetDeterministicDataTypes() []string {
+	if x != nil {
+		return x.DeterministicDataTypes
+	}
+	return nil
+}
+
+func (x *Organization) GetShreddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShreddedAt
+	}
+	return nil
+}
+
+// CreateOrganizationRequest registers an organization and stores its first TEK atomically
+type CreateOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	EncryptedTek   []byte                 `protobuf:"bytes,3,opt,name=encrypted_tek,json=encryptedTek,proto3" json:"encrypted_tek,omitempty"` // TEK encrypted with KEK
+	OrgKeyHash     string                 `protobuf:"bytes,4,opt,name=org_key_hash,json=orgKeyHash,proto3" json:"org_key_hash,omitempty"`     // Hash of the organization key
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CipherSuite    string                 `protobuf:"bytes,6,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"` // Optional; defaults to "aes-256-gcm"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetEncryptedTek() []byte {
+	if x != nil {
+		return x.EncryptedTek
+	}
+	return nil
+}
+
+func (x *CreateOrganizationRequest) GetOrgKeyHash() string {
+	if x != nil {
+		return x.OrgKeyHash
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CreateOrganizationRequest) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *CreateOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationResponse) Reset() {
+	*x = GetOrganizationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *GetOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Optional filter: "active", "suspended" or "shredded"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListOrganizationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrganizationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrganizationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListOrganizationsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrganizationsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SuspendOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuspendOrganizationRequest) Reset() {
+	*x = SuspendOrganizationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendOrganizationRequest) ProtoMessage() {}
+
+func (x *SuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuspendOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendOrganizationResponse) Reset() {
+	*x = SuspendOrganizationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendOrganizationResponse) ProtoMessage() {}
+
+func (x *SuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SuspendOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SuspendOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ReactivateOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReactivateOrganizationRequest) Reset() {
+	*x = ReactivateOrganizationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateOrganizationRequest) ProtoMessage() {}
+
+func (x *ReactivateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReactivateOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ReactivateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateOrganizationResponse) Reset() {
+	*x = ReactivateOrganizationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateOrganizationResponse) ProtoMessage() {}
+
+func (x *ReactivateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReactivateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *ReactivateOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReactivateOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UnlockOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Source         string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // Optional: also clear the counters of this source address
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnlockOrganizationRequest) Reset() {
+	*x = UnlockOrganizationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockOrganizationRequest) ProtoMessage() {}
+
+func (x *UnlockOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UnlockOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnlockOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UnlockOrganizationRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type UnlockOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockOrganizationResponse) Reset() {
+	*x = UnlockOrganizationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockOrganizationResponse) ProtoMessage() {}
+
+func (x *UnlockOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*UnlockOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnlockOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnlockOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SetOrganizationCipherSuiteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CipherSuite    string                 `protobuf:"bytes,2,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetOrganizationCipherSuiteRequest) Reset() {
+	*x = SetOrganizationCipherSuiteRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrganizationCipherSuiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrganizationCipherSuiteRequest) ProtoMessage() {}
+
+func (x *SetOrganizationCipherSuiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrganizationCipherSuiteRequest.ProtoReflect.Descriptor instead.
+func (*SetOrganizationCipherSuiteRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetOrganizationCipherSuiteRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetOrganizationCipherSuiteRequest) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+type SetOrganizationCipherSuiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrganizationCipherSuiteResponse) Reset() {
+	*x = SetOrganizationCipherSuiteResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrganizationCipherSuiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrganizationCipherSuiteResponse) ProtoMessage() {}
+
+func (x *SetOrganizationCipherSuiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrganizationCipherSuiteResponse.ProtoReflect.Descriptor instead.
+func (*SetOrganizationCipherSuiteResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetOrganizationCipherSuiteResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SetOrganizationCipherSuiteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetOrganizationCipherSuiteResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SetDeterministicDataTypesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DataTypes      []string               `protobuf:"bytes,2,rep,name=data_types,json=dataTypes,proto3" json:"data_types,omitempty"` // Replaces the current list; empty turns deterministic tokens off
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetDeterministicDataTypesRequest) Reset() {
+	*x = SetDeterministicDataTypesRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeterministicDataTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeterministicDataTypesRequest) ProtoMessage() {}
+
+func (x *SetDeterministicDataTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeterministicDataTypesRequest.ProtoReflect.Descriptor instead.
+func (*SetDeterministicDataTypesRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetDeterministicDataTypesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetDeterministicDataTypesRequest) GetDataTypes() []string {
+	if x != nil {
+		return x.DataTypes
+	}
+	return nil
+}
+
+type SetDeterministicDataTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeterministicDataTypesResponse) Reset() {
+	*x = SetDeterministicDataTypesResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeterministicDataTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeterministicDataTypesResponse) ProtoMessage() {}
+
+func (x *SetDeterministicDataTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeterministicDataTypesResponse.ProtoReflect.Descriptor instead.
+func (*SetDeterministicDataTypesResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetDeterministicDataTypesResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SetDeterministicDataTypesResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetDeterministicDataTypesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ShredOrganizationRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ConfirmOrganizationId string                 `protobuf:"bytes,2,opt,name=confirm_organization_id,json=confirmOrganizationId,proto3" json:"confirm_organization_id,omitempty"` // Must repeat organization_id
+	DeleteTokens          bool                   `protobuf:"varint,3,opt,name=delete_tokens,json=deleteTokens,proto3" json:"delete_tokens,omitempty"`                             // Also delete the organization's token rows
+	Reason                string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy           string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ShredOrganizationRequest) Reset() {
+	*x = ShredOrganizationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShredOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShredOrganizationRequest) ProtoMessage() {}
+
+func (x *ShredOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShredOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ShredOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ShredOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ShredOrganizationRequest) GetConfirmOrganizationId() string {
+	if x != nil {
+		return x.ConfirmOrganizationId
+	}
+	return ""
+}
+
+func (x *ShredOrganizationRequest) GetDeleteTokens() bool {
+	if x != nil {
+		return x.DeleteTokens
+	}
+	return false
+}
+
+func (x *ShredOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ShredOrganizationRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type ShredOrganizationResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Organization         *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Record               string                 `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`                        // The signed shred record, a JSON document
+	Signature            string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`                  // Base64 Ed25519 signature of record
+	PublicKey            string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // Base64 Ed25519 key that verifies signature
+	TekVersionsDestroyed int32                  `protobuf:"varint,5,opt,name=tek_versions_destroyed,json=tekVersionsDestroyed,proto3" json:"tek_versions_destroyed,omitempty"`
+	TokensDeleted        int64                  `protobuf:"varint,6,opt,name=tokens_deleted,json=tokensDeleted,proto3" json:"tokens_deleted,omitempty"`
+	CacheEntriesPurged   int64                  `protobuf:"varint,7,opt,name=cache_entries_purged,json=cacheEntriesPurged,proto3" json:"cache_entries_purged,omitempty"`
+	QueuedMessagesPurged int64                  `protobuf:"varint,8,opt,name=queued_messages_purged,json=queuedMessagesPurged,proto3" json:"queued_messages_purged,omitempty"`
+	AuditId              string                 `protobuf:"bytes,9,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"` // Empty if the record could not be written to the audit log
+	Status               string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                 // "success" or "error"
+	ErrorMessage         string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShredOrganizationResponse) Reset() {
+	*x = ShredOrganizationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShredOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShredOrganizationResponse) ProtoMessage() {}
+
+func (x *ShredOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShredOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ShredOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ShredOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *ShredOrganizationResponse) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetTekVersionsDestroyed() int32 {
+	if x != nil {
+		return x.TekVersionsDestroyed
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetTokensDeleted() int64 {
+	if x != nil {
+		return x.TokensDeleted
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetCacheEntriesPurged() int64 {
+	if x != nil {
+		return x.CacheEntriesPurged
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetQueuedMessagesPurged() int64 {
+	if x != nil {
+		return x.QueuedMessagesPurged
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// RotateTEKRequest carries a freshly generated TEK that becomes the active version
+type RotateTEKRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	EncryptedTek   []byte                 `protobuf:"bytes,2,opt,name=encrypted_tek,json=encryptedTek,proto3" json:"encrypted_tek,omitempty"` // New TEK encrypted with KEK
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateTEKRequest) Reset() {
+	*x = RotateTEKRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTEKRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTEKRequest) ProtoMessage() {}
+
+func (x *RotateTEKRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTEKRequest.ProtoReflect.Descriptor instead.
+func (*RotateTEKRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RotateTEKRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RotateTEKRequest) GetEncryptedTek() []byte {
+	if x != nil {
+		return x.EncryptedTek
+	}
+	return nil
+}
+
+type RotateTEKResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Version        int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // The new active TEK version
+	RotatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage   string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateTEKResponse) Reset() {
+	*x = RotateTEKResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTEKResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTEKResponse) ProtoMessage() {}
+
+func (x *RotateTEKResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTEKResponse.ProtoReflect.Descriptor instead.
+func (*RotateTEKResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RotateTEKResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RotateTEKResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RotateTEKResponse) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return nil
+}
+
+func (x *RotateTEKResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RotateTEKResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// OrganizationKeyRotation describes a background re-encryption job
+type OrganizationKeyRotation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RotationId      string                 `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                               // "running", "interrupted" (resume with the same keys) or "completed"
+	FromVersion     int32                  `protobuf:"varint,4,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"` // Organization key version being replaced
+	ToVersion       int32                  `protobuf:"varint,5,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	TotalTokens     int64                  `protobuf:"varint,6,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"` // Tokens to re-encrypt when the job started
+	ProcessedTokens int64                  `protobuf:"varint,7,opt,name=processed_tokens,json=processedTokens,proto3" json:"processed_tokens,omitempty"`
+	FailedTokens    int64                  `protobuf:"varint,8,opt,name=failed_tokens,json=failedTokens,proto3" json:"failed_tokens,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Nullable
+	ErrorMessage    string                 `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrganizationKeyRotation) Reset() {
+	*x = OrganizationKeyRotation{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationKeyRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationKeyRotation) ProtoMessage() {}
+
+func (x *OrganizationKeyRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationKeyRotation.ProtoReflect.Descriptor instead.
+func (*OrganizationKeyRotation) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OrganizationKeyRotation) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *OrganizationKeyRotation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationKeyRotation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrganizationKeyRotation) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetProcessedTokens() int64 {
+	if x != nil {
+		return x.ProcessedTokens
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetFailedTokens() int64 {
+	if x != nil {
+		return x.FailedTokens
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *OrganizationKeyRotation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationKeyRotation) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *OrganizationKeyRotation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RotateOrganizationKeyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId     string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey    string                 `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`            // Current key, verified before rotating
+	NewOrganizationKey string                 `protobuf:"bytes,3,opt,name=new_organization_key,json=newOrganizationKey,proto3" json:"new_organization_key,omitempty"` // Held in memory only, for the duration of the job
+	NewOrgKeyHash      string                 `protobuf:"bytes,4,opt,name=new_org_key_hash,json=newOrgKeyHash,proto3" json:"new_org_key_hash,omitempty"`
+	Source             string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // Originating client address, used for brute-force lockout counters
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateOrganizationKeyRequest) Reset() {
+	*x = RotateOrganizationKeyRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOrganizationKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOrganizationKeyRequest) ProtoMessage() {}
+
+func (x *RotateOrganizationKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOrganizationKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateOrganizationKeyRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RotateOrganizationKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyRequest) GetNewOrganizationKey() string {
+	if x != nil {
+		return x.NewOrganizationKey
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyRequest) GetNewOrgKeyHash() string {
+	if x != nil {
+		return x.NewOrgKeyHash
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type RotateOrganizationKeyResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rotation      *OrganizationKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Status        string                   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                   `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateOrganizationKeyResponse) Reset() {
+	*x = RotateOrganizationKeyResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOrganizationKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOrganizationKeyResponse) ProtoMessage() {}
+
+func (x *RotateOrganizationKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOrganizationKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateOrganizationKeyResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RotateOrganizationKeyResponse) GetRotation() *OrganizationKeyRotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *RotateOrganizationKeyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetOrganizationKeyRotationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationKeyRotationRequest) Reset() {
+	*x = GetOrganizationKeyRotationRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationKeyRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationKeyRotationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationKeyRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationKeyRotationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationKeyRotationRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetOrganizationKeyRotationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationKeyRotationResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rotation      *OrganizationKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Status        string                   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                   `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationKeyRotationResponse) Reset() {
+	*x = GetOrganizationKeyRotationResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationKeyRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationKeyRotationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationKeyRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationKeyRotationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationKeyRotationResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetOrganizationKeyRotationResponse) GetRotation() *OrganizationKeyRotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *GetOrganizationKeyRotationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrganizationKeyRotationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// KEK rotation messages
+type KEKRewrapJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "running", "completed" or "failed"
+	TotalTeks     int64                  `protobuf:"varint,2,opt,name=total_teks,json=totalTeks,proto3" json:"total_teks,omitempty"`
+	RewrappedTeks int64                  `protobuf:"varint,3,opt,name=rewrapped_teks,json=rewrappedTeks,proto3" json:"rewrapped_teks,omitempty"`
+	FailedTeks    int64                  `protobuf:"varint,4,opt,name=failed_teks,json=failedTeks,proto3" json:"failed_teks,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KEKRewrapJob) Reset() {
+	*x = KEKRewrapJob{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KEKRewrapJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KEKRewrapJob) ProtoMessage() {}
+
+func (x *KEKRewrapJob) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KEKRewrapJob.ProtoReflect.Descriptor instead.
+func (*KEKRewrapJob) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *KEKRewrapJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KEKRewrapJob) GetTotalTeks() int64 {
+	if x != nil {
+		return x.TotalTeks
+	}
+	return 0
+}
+
+func (x *KEKRewrapJob) GetRewrappedTeks() int64 {
+	if x != nil {
+		return x.RewrappedTeks
+	}
+	return 0
+}
+
+func (x *KEKRewrapJob) GetFailedTeks() int64 {
+	if x != nil {
+		return x.FailedTeks
+	}
+	return 0
+}
+
+func (x *KEKRewrapJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *KEKRewrapJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *KEKRewrapJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RewrapTEKsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewrapTEKsRequest) Reset() {
+	*x = RewrapTEKsRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewrapTEKsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewrapTEKsRequest) ProtoMessage() {}
+
+func (x *RewrapTEKsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewrapTEKsRequest.ProtoReflect.Descriptor instead.
+func (*RewrapTEKsRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{40}
+}
+
+type RewrapTEKsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *KEKRewrapJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewrapTEKsResponse) Reset() {
+	*x = RewrapTEKsResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewrapTEKsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewrapTEKsResponse) ProtoMessage() {}
+
+func (x *RewrapTEKsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewrapTEKsResponse.ProtoReflect.Descriptor instead.
+func (*RewrapTEKsResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RewrapTEKsResponse) GetJob() *KEKRewrapJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *RewrapTEKsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RewrapTEKsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetKEKStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKEKStatusRequest) Reset() {
+	*x = GetKEKStatusRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKEKStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKEKStatusRequest) ProtoMessage() {}
+
+func (x *GetKEKStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKEKStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetKEKStatusRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{42}
+}
+
+type KEKReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KekId         string                 `protobuf:"bytes,1,opt,name=kek_id,json=kekId,proto3" json:"kek_id,omitempty"`     // Empty for TEKs wrapped before KEK IDs were introduced
+	Teks          int64                  `protobuf:"varint,2,opt,name=teks,proto3" json:"teks,omitempty"`                   // Stored TEKs wrapped with this KEK
+	InRing        bool                   `protobuf:"varint,3,opt,name=in_ring,json=inRing,proto3" json:"in_ring,omitempty"` // Whether the KEK is configured on the persistence service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KEKReference) Reset() {
+	*x = KEKReference{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KEKReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KEKReference) ProtoMessage() {}
+
+func (x *KEKReference) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KEKReference.ProtoReflect.Descriptor instead.
+func (*KEKReference) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *KEKReference) GetKekId() string {
+	if x != nil {
+		return x.KekId
+	}
+	return ""
+}
+
+func (x *KEKReference) GetTeks() int64 {
+	if x != nil {
+		return x.Teks
+	}
+	return 0
+}
+
+func (x *KEKReference) GetInRing() bool {
+	if x != nil {
+		return x.InRing
+	}
+	return false
+}
+
+type GetKEKStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentKekId  string                 `protobuf:"bytes,1,opt,name=current_kek_id,json=currentKekId,proto3" json:"current_kek_id,omitempty"`
+	References    []*KEKReference        `protobuf:"bytes,2,rep,name=references,proto3" json:"references,omitempty"`
+	RemainingTeks int64                  `protobuf:"varint,3,opt,name=remaining_teks,json=remainingTeks,proto3" json:"remaining_teks,omitempty"` // TEKs not yet wrapped with the current KEK
+	Job           *KEKRewrapJob          `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`                                           // Latest rewrap job run by the replica that answered, if any
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                     // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKEKStatusResponse) Reset() {
+	*x = GetKEKStatusResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKEKStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKEKStatusResponse) ProtoMessage() {}
+
+func (x *GetKEKStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKEKStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetKEKStatusResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetKEKStatusResponse) GetCurrentKekId() string {
+	if x != nil {
+		return x.CurrentKekId
+	}
+	return ""
+}
+
+func (x *GetKEKStatusResponse) GetReferences() []*KEKReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetKEKStatusResponse) GetRemainingTeks() int64 {
+	if x != nil {
+		return x.RemainingTeks
+	}
+	return 0
+}
+
+func (x *GetKEKStatusResponse) GetJob() *KEKRewrapJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GetKEKStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetKEKStatusResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// API key messages
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`          // Public part of the key
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Principal the key authenticates as
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Nullable: the key does not expire
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`      // Nullable
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Nullable; updated at most once a minute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *APIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *APIKey) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *APIKey) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	KeyHash       string                 `protobuf:"bytes,4,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`       // SHA-256 of the whole key, hex-encoded
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"` // The key itself; only set by the API gateway, which returns it once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClientId       string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Optional filter
+	IncludeRevoked bool                   `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetrieveTEKRequest) String() string {
+func (x *ListAPIKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrieveTEKRequest) ProtoMessage() {}
+func (*ListAPIKeysRequest) ProtoMessage() {}
 
-func (x *RetrieveTEKRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_persistence_persistence_service_proto_msgTypes[8]
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,54 +3547,107 @@ func (x *RetrieveTEKRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrieveTEKRequest.ProtoReflect.Descriptor instead.
-func (*RetrieveTEKRequest) Descriptor() ([]byte, []int) {
-	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RetrieveTEKRequest) GetOrganizationId() string {
+func (x *ListAPIKeysRequest) GetClientId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *RetrieveTEKRequest) GetOrganizationKey() string {
+func (x *ListAPIKeysRequest) GetIncludeRevoked() bool {
 	if x != nil {
-		return x.OrganizationKey
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *ListAPIKeysResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type RetrieveTEKResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	EncryptedTek   []byte                 `protobuf:"bytes,2,opt,name=encrypted_tek,json=encryptedTek,proto3" json:"encrypted_tek,omitempty"` // TEK encrypted with KEK
-	OrgKeyHash     string                 `protobuf:"bytes,3,opt,name=org_key_hash,json=orgKeyHash,proto3" json:"org_key_hash,omitempty"`     // SHA-256 hash of organization key
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	RotatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"` // Nullable
-	Version        int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
-	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
-	ErrorMessage   string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListAPIKeysResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
 }
 
-func (x *RetrieveTEKResponse) Reset() {
-	*x = RetrieveTEKResponse{}
-	mi := &file_persistence_persistence_service_proto_msgTypes[9]
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetrieveTEKResponse) String() string {
+func (x *RevokeAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrieveTEKResponse) ProtoMessage() {}
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
 
-func (x *RetrieveTEKResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_persistence_persistence_service_proto_msgTypes[9]
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,61 +3658,184 @@ func (x *RetrieveTEKResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrieveTEKResponse.ProtoReflect.Descriptor instead.
-func (*RetrieveTEKResponse) Descriptor() ([]byte, []int) {
-	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *RetrieveTEKResponse) GetOrganizationId() string {
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.KeyId
 	}
 	return ""
 }
 
-func (x *RetrieveTEKResponse) GetEncryptedTek() []byte {
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[51]
 	if x != nil {
-		return x.EncryptedTek
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RevokeAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
 	}
 	return nil
 }
 
-func (x *RetrieveTEKResponse) GetOrgKeyHash() string {
+func (x *RevokeAPIKeyResponse) GetStatus() string {
 	if x != nil {
-		return x.OrgKeyHash
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RetrieveTEKResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RevokeAPIKeyResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-func (x *RetrieveTEKResponse) GetRotatedAt() *timestamppb.Timestamp {
+type VerifyAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	KeyHash       string                 `protobuf:"bytes,2,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"` // SHA-256 of the whole key, hex-encoded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyRequest) Reset() {
+	*x = VerifyAPIKeyRequest{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyRequest) ProtoMessage() {}
+
+func (x *VerifyAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[52]
 	if x != nil {
-		return x.RotatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RetrieveTEKResponse) GetVersion() int32 {
+// Deprecated: Use VerifyAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *VerifyAPIKeyRequest) GetKeyId() string {
 	if x != nil {
-		return x.Version
+		return x.KeyId
 	}
-	return 0
+	return ""
 }
 
-func (x *RetrieveTEKResponse) GetStatus() string {
+func (x *VerifyAPIKeyRequest) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+type VerifyAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyResponse) Reset() {
+	*x = VerifyAPIKeyResponse{}
+	mi := &file_persistence_persistence_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyResponse) ProtoMessage() {}
+
+func (x *VerifyAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_persistence_persistence_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_persistence_persistence_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *VerifyAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *VerifyAPIKeyResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *RetrieveTEKResponse) GetErrorMessage() string {
+func (x *VerifyAPIKeyResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
@@ -791,7 +3846,7 @@ var File_persistence_persistence_service_proto protoreflect.FileDescriptor
 
 const file_persistence_persistence_service_proto_rawDesc = "" +
 	"\n" +
-	"%persistence/persistence_service.proto\x12\vpersistence\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x03\n" +
+	"%persistence/persistence_service.proto\x12\vpersistence\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x05\n" +
 	"\x14StorePIITokenRequest\x12%\n" +
 	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12%\n" +
 	"\x0eencrypted_data\x18\x02 \x01(\fR\rencryptedData\x12\x0e\n" +
@@ -803,7 +3858,17 @@ const file_persistence_persistence_service_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12K\n" +
-	"\bmetadata\x18\t \x03(\v2/.persistence.StorePIITokenRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\t \x03(\v2/.persistence.StorePIITokenRequest.MetadataEntryR\bmetadata\x12\x1f\n" +
+	"\vtek_version\x18\n" +
+	" \x01(\x05R\n" +
+	"tekVersion\x12&\n" +
+	"\x0forg_key_version\x18\v \x01(\x05R\rorgKeyVersion\x12%\n" +
+	"\x0eformat_version\x18\f \x01(\x05R\rformatVersion\x12\x19\n" +
+	"\bkey_salt\x18\r \x01(\fR\akeySalt\x12\x1f\n" +
+	"\vinsert_only\x18\x0e \x01(\bR\n" +
+	"insertOnly\x12\x1f\n" +
+	"\vblind_index\x18\x0f \x01(\tR\n" +
+	"blindIndex\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"{\n" +
@@ -813,7 +3878,7 @@ const file_persistence_persistence_service_proto_rawDesc = "" +
 	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"i\n" +
 	"\x17RetrievePIITokenRequest\x12%\n" +
 	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"\x9c\x04\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"\x80\x06\n" +
 	"\x18RetrievePIITokenResponse\x12%\n" +
 	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12%\n" +
 	"\x0eencrypted_data\x18\x02 \x01(\fR\rencryptedData\x12\x0e\n" +
@@ -828,10 +3893,34 @@ const file_persistence_persistence_service_proto_rawDesc = "" +
 	"\bmetadata\x18\t \x03(\v23.persistence.RetrievePIITokenResponse.MetadataEntryR\bmetadata\x12\x16\n" +
 	"\x06status\x18\n" +
 	" \x01(\tR\x06status\x12#\n" +
-	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x1a;\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x12\x1f\n" +
+	"\vtek_version\x18\f \x01(\x05R\n" +
+	"tekVersion\x12&\n" +
+	"\x0forg_key_version\x18\r \x01(\x05R\rorgKeyVersion\x126\n" +
+	"\x17previous_encrypted_data\x18\x0e \x01(\fR\x15previousEncryptedData\x12\x1f\n" +
+	"\vprevious_iv\x18\x0f \x01(\fR\n" +
+	"previousIv\x12%\n" +
+	"\x0eformat_version\x18\x10 \x01(\x05R\rformatVersion\x12\x19\n" +
+	"\bkey_salt\x18\x11 \x01(\fR\akeySalt\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\x16LookupPIITokensRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12#\n" +
+	"\rblind_indexes\x18\x03 \x03(\tR\fblindIndexes\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xd4\x01\n" +
+	"\x0eTokenReference\x12%\n" +
+	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12%\n" +
+	"\x0eformat_version\x18\x02 \x01(\x05R\rformatVersion\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x8b\x01\n" +
+	"\x17LookupPIITokensResponse\x123\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x1b.persistence.TokenReferenceR\x06tokens\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"7\n" +
 	"\x12HealthCheckRequest\x12!\n" +
 	"\fservice_name\x18\x01 \x01(\tR\vserviceName\"\xa9\x02\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
@@ -852,14 +3941,23 @@ const file_persistence_persistence_service_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"rotated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12\x18\n" +
-	"\aversion\x18\x06 \x01(\x05R\aversion\"x\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\"\xae\x02\n" +
 	"\x10StoreTEKResponse\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"h\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\bR\acreated\x12#\n" +
+	"\rencrypted_tek\x18\x05 \x01(\fR\fencryptedTek\x12 \n" +
+	"\forg_key_hash\x18\x06 \x01(\tR\n" +
+	"orgKeyHash\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\"\x9a\x01\n" +
 	"\x12RetrieveTEKRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12)\n" +
-	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\"\xd2\x02\n" +
+	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\"\x81\x04\n" +
 	"\x13RetrieveTEKResponse\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
 	"\rencrypted_tek\x18\x02 \x01(\fR\fencryptedTek\x12 \n" +
@@ -871,13 +3969,254 @@ const file_persistence_persistence_service_proto_rawDesc = "" +
 	"rotated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12\x18\n" +
 	"\aversion\x18\x06 \x01(\x05R\aversion\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x12#\n" +
-	"\rerror_message\x18\b \x01(\tR\ferrorMessage2\xba\x03\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12&\n" +
+	"\x0forg_key_version\x18\t \x01(\x05R\rorgKeyVersion\x12(\n" +
+	"\x10retiring_org_key\x18\n" +
+	" \x01(\bR\x0eretiringOrgKey\x12!\n" +
+	"\fcipher_suite\x18\v \x01(\tR\vcipherSuite\x128\n" +
+	"\x18deterministic_data_types\x18\f \x03(\tR\x16deterministicDataTypes\"\xec\x03\n" +
+	"\fOrganization\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fsuspended_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x12)\n" +
+	"\x10suspended_reason\x18\a \x01(\tR\x0fsuspendedReason\x12!\n" +
+	"\fcipher_suite\x18\b \x01(\tR\vcipherSuite\x128\n" +
+	"\x18deterministic_data_types\x18\t \x03(\tR\x16deterministicDataTypes\x12;\n" +
+	"\vshredded_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"shreddedAt\"\x8c\x02\n" +
+	"\x19CreateOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12#\n" +
+	"\rencrypted_tek\x18\x03 \x01(\fR\fencryptedTek\x12 \n" +
+	"\forg_key_hash\x18\x04 \x01(\tR\n" +
+	"orgKeyHash\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fcipher_suite\x18\x06 \x01(\tR\vcipherSuite\"\x98\x01\n" +
+	"\x1aCreateOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"A\n" +
+	"\x16GetOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x95\x01\n" +
+	"\x17GetOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"`\n" +
+	"\x18ListOrganizationsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xba\x01\n" +
+	"\x19ListOrganizationsResponse\x12?\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x19.persistence.OrganizationR\rorganizations\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"]\n" +
+	"\x1aSuspendOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x99\x01\n" +
+	"\x1bSuspendOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"H\n" +
+	"\x1dReactivateOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x9c\x01\n" +
+	"\x1eReactivateOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\\\n" +
+	"\x19UnlockOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"Y\n" +
+	"\x1aUnlockOrganizationResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"o\n" +
+	"!SetOrganizationCipherSuiteRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fcipher_suite\x18\x02 \x01(\tR\vcipherSuite\"\xa0\x01\n" +
+	"\"SetOrganizationCipherSuiteResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"j\n" +
+	" SetDeterministicDataTypesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"data_types\x18\x02 \x03(\tR\tdataTypes\"\x9f\x01\n" +
+	"!SetDeterministicDataTypesResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xdb\x01\n" +
+	"\x18ShredOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x126\n" +
+	"\x17confirm_organization_id\x18\x02 \x01(\tR\x15confirmOrganizationId\x12#\n" +
+	"\rdelete_tokens\x18\x03 \x01(\bR\fdeleteTokens\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\"\xcc\x03\n" +
+	"\x19ShredOrganizationResponse\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x19.persistence.OrganizationR\forganization\x12\x16\n" +
+	"\x06record\x18\x02 \x01(\tR\x06record\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x124\n" +
+	"\x16tek_versions_destroyed\x18\x05 \x01(\x05R\x14tekVersionsDestroyed\x12%\n" +
+	"\x0etokens_deleted\x18\x06 \x01(\x03R\rtokensDeleted\x120\n" +
+	"\x14cache_entries_purged\x18\a \x01(\x03R\x12cacheEntriesPurged\x124\n" +
+	"\x16queued_messages_purged\x18\b \x01(\x03R\x14queuedMessagesPurged\x12\x19\n" +
+	"\baudit_id\x18\t \x01(\tR\aauditId\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\"`\n" +
+	"\x10RotateTEKRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rencrypted_tek\x18\x02 \x01(\fR\fencryptedTek\"\xce\x01\n" +
+	"\x11RotateTEKResponse\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"rotated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x8a\x04\n" +
+	"\x17OrganizationKeyRotation\x12\x1f\n" +
+	"\vrotation_id\x18\x01 \x01(\tR\n" +
+	"rotationId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\ffrom_version\x18\x04 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x05 \x01(\x05R\ttoVersion\x12!\n" +
+	"\ftotal_tokens\x18\x06 \x01(\x03R\vtotalTokens\x12)\n" +
+	"\x10processed_tokens\x18\a \x01(\x03R\x0fprocessedTokens\x12#\n" +
+	"\rfailed_tokens\x18\b \x01(\x03R\ffailedTokens\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12#\n" +
+	"\rerror_message\x18\f \x01(\tR\ferrorMessage\"\xe5\x01\n" +
+	"\x1cRotateOrganizationKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\x120\n" +
+	"\x14new_organization_key\x18\x03 \x01(\tR\x12newOrganizationKey\x12'\n" +
+	"\x10new_org_key_hash\x18\x04 \x01(\tR\rnewOrgKeyHash\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"\x9e\x01\n" +
+	"\x1dRotateOrganizationKeyResponse\x12@\n" +
+	"\brotation\x18\x01 \x01(\v2$.persistence.OrganizationKeyRotationR\brotation\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"L\n" +
+	"!GetOrganizationKeyRotationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xa3\x01\n" +
+	"\"GetOrganizationKeyRotationResponse\x12@\n" +
+	"\brotation\x18\x01 \x01(\v2$.persistence.OrganizationKeyRotationR\brotation\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xac\x02\n" +
+	"\fKEKRewrapJob\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"total_teks\x18\x02 \x01(\x03R\ttotalTeks\x12%\n" +
+	"\x0erewrapped_teks\x18\x03 \x01(\x03R\rrewrappedTeks\x12\x1f\n" +
+	"\vfailed_teks\x18\x04 \x01(\x03R\n" +
+	"failedTeks\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12#\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\"\x13\n" +
+	"\x11RewrapTEKsRequest\"~\n" +
+	"\x12RewrapTEKsResponse\x12+\n" +
+	"\x03job\x18\x01 \x01(\v2\x19.persistence.KEKRewrapJobR\x03job\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x15\n" +
+	"\x13GetKEKStatusRequest\"R\n" +
+	"\fKEKReference\x12\x15\n" +
+	"\x06kek_id\x18\x01 \x01(\tR\x05kekId\x12\x12\n" +
+	"\x04teks\x18\x02 \x01(\x03R\x04teks\x12\x17\n" +
+	"\ain_ring\x18\x03 \x01(\bR\x06inRing\"\x88\x02\n" +
+	"\x14GetKEKStatusResponse\x12$\n" +
+	"\x0ecurrent_kek_id\x18\x01 \x01(\tR\fcurrentKekId\x129\n" +
+	"\n" +
+	"references\x18\x02 \x03(\v2\x19.persistence.KEKReferenceR\n" +
+	"references\x12%\n" +
+	"\x0eremaining_teks\x18\x03 \x01(\x03R\rremainingTeks\x12+\n" +
+	"\x03job\x18\x04 \x01(\v2\x19.persistence.KEKRewrapJobR\x03job\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\xcd\x02\n" +
+	"\x06APIKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xc1\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\bkey_hash\x18\x04 \x01(\tR\akeyHash\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x93\x01\n" +
+	"\x14CreateAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.persistence.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\"Z\n" +
+	"\x12ListAPIKeysRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12'\n" +
+	"\x0finclude_revoked\x18\x02 \x01(\bR\x0eincludeRevoked\"\x82\x01\n" +
+	"\x13ListAPIKeysResponse\x12.\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x13.persistence.APIKeyR\aapiKeys\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\",\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"\x81\x01\n" +
+	"\x14RevokeAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.persistence.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"G\n" +
+	"\x13VerifyAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x19\n" +
+	"\bkey_hash\x18\x02 \x01(\tR\akeyHash\"\x81\x01\n" +
+	"\x14VerifyAPIKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.persistence.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage2\x94\x12\n" +
 	"\x12PersistenceService\x12V\n" +
 	"\rStorePIIToken\x12!.persistence.StorePIITokenRequest\x1a\".persistence.StorePIITokenResponse\x12_\n" +
-	"\x10RetrievePIIToken\x12$.persistence.RetrievePIITokenRequest\x1a%.persistence.RetrievePIITokenResponse\x12G\n" +
+	"\x10RetrievePIIToken\x12$.persistence.RetrievePIITokenRequest\x1a%.persistence.RetrievePIITokenResponse\x12\\\n" +
+	"\x0fLookupPIITokens\x12#.persistence.LookupPIITokensRequest\x1a$.persistence.LookupPIITokensResponse\x12G\n" +
 	"\bStoreTEK\x12\x1c.persistence.StoreTEKRequest\x1a\x1d.persistence.StoreTEKResponse\x12P\n" +
 	"\vRetrieveTEK\x12\x1f.persistence.RetrieveTEKRequest\x1a .persistence.RetrieveTEKResponse\x12P\n" +
-	"\vHealthCheck\x12\x1f.persistence.HealthCheckRequest\x1a .persistence.HealthCheckResponseB7Z5github.com/PlainFunction/mistokenly/proto/persistenceb\x06proto3"
+	"\vHealthCheck\x12\x1f.persistence.HealthCheckRequest\x1a .persistence.HealthCheckResponse\x12e\n" +
+	"\x12CreateOrganization\x12&.persistence.CreateOrganizationRequest\x1a'.persistence.CreateOrganizationResponse\x12\\\n" +
+	"\x0fGetOrganization\x12#.persistence.GetOrganizationRequest\x1a$.persistence.GetOrganizationResponse\x12b\n" +
+	"\x11ListOrganizations\x12%.persistence.ListOrganizationsRequest\x1a&.persistence.ListOrganizationsResponse\x12h\n" +
+	"\x13SuspendOrganization\x12'.persistence.SuspendOrganizationRequest\x1a(.persistence.SuspendOrganizationResponse\x12q\n" +
+	"\x16ReactivateOrganization\x12*.persistence.ReactivateOrganizationRequest\x1a+.persistence.ReactivateOrganizationResponse\x12e\n" +
+	"\x12UnlockOrganization\x12&.persistence.UnlockOrganizationRequest\x1a'.persistence.UnlockOrganizationResponse\x12}\n" +
+	"\x1aSetOrganizationCipherSuite\x12..persistence.SetOrganizationCipherSuiteRequest\x1a/.persistence.SetOrganizationCipherSuiteResponse\x12z\n" +
+	"\x19SetDeterministicDataTypes\x12-.persistence.SetDeterministicDataTypesRequest\x1a..persistence.SetDeterministicDataTypesResponse\x12b\n" +
+	"\x11ShredOrganization\x12%.persistence.ShredOrganizationRequest\x1a&.persistence.ShredOrganizationResponse\x12J\n" +
+	"\tRotateTEK\x12\x1d.persistence.RotateTEKRequest\x1a\x1e.persistence.RotateTEKResponse\x12n\n" +
+	"\x15RotateOrganizationKey\x12).persistence.RotateOrganizationKeyRequest\x1a*.persistence.RotateOrganizationKeyResponse\x12}\n" +
+	"\x1aGetOrganizationKeyRotation\x12..persistence.GetOrganizationKeyRotationRequest\x1a/.persistence.GetOrganizationKeyRotationResponse\x12M\n" +
+	"\n" +
+	"RewrapTEKs\x12\x1e.persistence.RewrapTEKsRequest\x1a\x1f.persistence.RewrapTEKsResponse\x12S\n" +
+	"\fGetKEKStatus\x12 .persistence.GetKEKStatusRequest\x1a!.persistence.GetKEKStatusResponse\x12S\n" +
+	"\fCreateAPIKey\x12 .persistence.CreateAPIKeyRequest\x1a!.persistence.CreateAPIKeyResponse\x12P\n" +
+	"\vListAPIKeys\x12\x1f.persistence.ListAPIKeysRequest\x1a .persistence.ListAPIKeysResponse\x12S\n" +
+	"\fRevokeAPIKey\x12 .persistence.RevokeAPIKeyRequest\x1a!.persistence.RevokeAPIKeyResponse\x12S\n" +
+	"\fVerifyAPIKey\x12 .persistence.VerifyAPIKeyRequest\x1a!.persistence.VerifyAPIKeyResponseB7Z5github.com/PlainFunction/mistokenly/proto/persistenceb\x06proto3"
 
 var (
 	file_persistence_persistence_service_proto_rawDescOnce sync.Once
@@ -891,51 +4230,170 @@ func file_persistence_persistence_service_proto_rawDescGZIP() []byte {
 	return file_persistence_persistence_service_proto_rawDescData
 }
 
-var file_persistence_persistence_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_persistence_persistence_service_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_persistence_persistence_service_proto_goTypes = []any{
-	(*StorePIITokenRequest)(nil),     // 0: persistence.StorePIITokenRequest
-	(*StorePIITokenResponse)(nil),    // 1: persistence.StorePIITokenResponse
-	(*RetrievePIITokenRequest)(nil),  // 2: persistence.RetrievePIITokenRequest
-	(*RetrievePIITokenResponse)(nil), // 3: persistence.RetrievePIITokenResponse
-	(*HealthCheckRequest)(nil),       // 4: persistence.HealthCheckRequest
-	(*HealthCheckResponse)(nil),      // 5: persistence.HealthCheckResponse
-	(*StoreTEKRequest)(nil),          // 6: persistence.StoreTEKRequest
-	(*StoreTEKResponse)(nil),         // 7: persistence.StoreTEKResponse
-	(*RetrieveTEKRequest)(nil),       // 8: persistence.RetrieveTEKRequest
-	(*RetrieveTEKResponse)(nil),      // 9: persistence.RetrieveTEKResponse
-	nil,                              // 10: persistence.StorePIITokenRequest.MetadataEntry
-	nil,                              // 11: persistence.RetrievePIITokenResponse.MetadataEntry
-	nil,                              // 12: persistence.HealthCheckResponse.DetailsEntry
-	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+	(*StorePIITokenRequest)(nil),               // 0: persistence.StorePIITokenRequest
+	(*StorePIITokenResponse)(nil),              // 1: persistence.StorePIITokenResponse
+	(*RetrievePIITokenRequest)(nil),            // 2: persistence.RetrievePIITokenRequest
+	(*RetrievePIITokenResponse)(nil),           // 3: persistence.RetrievePIITokenResponse
+	(*LookupPIITokensRequest)(nil),             // 4: persistence.LookupPIITokensRequest
+	(*TokenReference)(nil),                     // 5: persistence.TokenReference
+	(*LookupPIITokensResponse)(nil),            // 6: persistence.LookupPIITokensResponse
+	(*HealthCheckRequest)(nil),                 // 7: persistence.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                // 8: persistence.HealthCheckResponse
+	(*StoreTEKRequest)(nil),                    // 9: persistence.StoreTEKRequest
+	(*StoreTEKResponse)(nil),                   // 10: persistence.StoreTEKResponse
+	(*RetrieveTEKRequest)(nil),                 // 11: persistence.RetrieveTEKRequest
+	(*RetrieveTEKResponse)(nil),                // 12: persistence.RetrieveTEKResponse
+	(*Organization)(nil),                       // 13: persistence.Organization
+	(*CreateOrganizationRequest)(nil),          // 14: persistence.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),         // 15: persistence.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),             // 16: persistence.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),            // 17: persistence.GetOrganizationResponse
+	(*ListOrganizationsRequest)(nil),           // 18: persistence.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),          // 19: persistence.ListOrganizationsResponse
+	(*SuspendOrganizationRequest)(nil),         // 20: persistence.SuspendOrganizationRequest
+	(*SuspendOrganizationResponse)(nil),        // 21: persistence.SuspendOrganizationResponse
+	(*ReactivateOrganizationRequest)(nil),      // 22: persistence.ReactivateOrganizationRequest
+	(*ReactivateOrganizationResponse)(nil),     // 23: persistence.ReactivateOrganizationResponse
+	(*UnlockOrganizationRequest)(nil),          // 24: persistence.UnlockOrganizationRequest
+	(*UnlockOrganizationResponse)(nil),         // 25: persistence.UnlockOrganizationResponse
+	(*SetOrganizationCipherSuiteRequest)(nil),  // 26: persistence.SetOrganizationCipherSuiteRequest
+	(*SetOrganizationCipherSuiteResponse)(nil), // 27: persistence.SetOrganizationCipherSuiteResponse
+	(*SetDeterministicDataTypesRequest)(nil),   // 28: persistence.SetDeterministicDataTypesRequest
+	(*SetDeterministicDataTypesResponse)(nil),  // 29: persistence.SetDeterministicDataTypesResponse
+	(*ShredOrganizationRequest)(nil),           // 30: persistence.ShredOrganizationRequest
+	(*ShredOrganizationResponse)(nil),          // 31: persistence.ShredOrganizationResponse
+	(*RotateTEKRequest)(nil),                   // 32: persistence.RotateTEKRequest
+	(*RotateTEKResponse)(nil),                  // 33: persistence.RotateTEKResponse
+	(*OrganizationKeyRotation)(nil),            // 34: persistence.OrganizationKeyRotation
+	(*RotateOrganizationKeyRequest)(nil),       // 35: persistence.RotateOrganizationKeyRequest
+	(*RotateOrganizationKeyResponse)(nil),      // 36: persistence.RotateOrganizationKeyResponse
+	(*GetOrganizationKeyRotationRequest)(nil),  // 37: persistence.GetOrganizationKeyRotationRequest
+	(*GetOrganizationKeyRotationResponse)(nil), // 38: persistence.GetOrganizationKeyRotationResponse
+	(*KEKRewrapJob)(nil),                       // 39: persistence.KEKRewrapJob
+	(*RewrapTEKsRequest)(nil),                  // 40: persistence.RewrapTEKsRequest
+	(*RewrapTEKsResponse)(nil),                 // 41: persistence.RewrapTEKsResponse
+	(*GetKEKStatusRequest)(nil),                // 42: persistence.GetKEKStatusRequest
+	(*KEKReference)(nil),                       // 43: persistence.KEKReference
+	(*GetKEKStatusResponse)(nil),               // 44: persistence.GetKEKStatusResponse
+	(*APIKey)(nil),                             // 45: persistence.APIKey
+	(*CreateAPIKeyRequest)(nil),                // 46: persistence.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),               // 47: persistence.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                 // 48: persistence.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                // 49: persistence.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),                // 50: persistence.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),               // 51: persistence.RevokeAPIKeyResponse
+	(*VerifyAPIKeyRequest)(nil),                // 52: persistence.VerifyAPIKeyRequest
+	(*VerifyAPIKeyResponse)(nil),               // 53: persistence.VerifyAPIKeyResponse
+	nil,                                        // 54: persistence.StorePIITokenRequest.MetadataEntry
+	nil,                                        // 55: persistence.RetrievePIITokenResponse.MetadataEntry
+	nil,                                        // 56: persistence.HealthCheckResponse.DetailsEntry
+	(*timestamppb.Timestamp)(nil),              // 57: google.protobuf.Timestamp
 }
 var file_persistence_persistence_service_proto_depIdxs = []int32{
-	13, // 0: persistence.StorePIITokenRequest.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: persistence.StorePIITokenRequest.expires_at:type_name -> google.protobuf.Timestamp
-	10, // 2: persistence.StorePIITokenRequest.metadata:type_name -> persistence.StorePIITokenRequest.MetadataEntry
-	13, // 3: persistence.RetrievePIITokenResponse.created_at:type_name -> google.protobuf.Timestamp
-	13, // 4: persistence.RetrievePIITokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	11, // 5: persistence.RetrievePIITokenResponse.metadata:type_name -> persistence.RetrievePIITokenResponse.MetadataEntry
-	13, // 6: persistence.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	12, // 7: persistence.HealthCheckResponse.details:type_name -> persistence.HealthCheckResponse.DetailsEntry
-	13, // 8: persistence.StoreTEKRequest.created_at:type_name -> google.protobuf.Timestamp
-	13, // 9: persistence.StoreTEKRequest.rotated_at:type_name -> google.protobuf.Timestamp
-	13, // 10: persistence.RetrieveTEKResponse.created_at:type_name -> google.protobuf.Timestamp
-	13, // 11: persistence.RetrieveTEKResponse.rotated_at:type_name -> google.protobuf.Timestamp
-	0,  // 12: persistence.PersistenceService.StorePIIToken:input_type -> persistence.StorePIITokenRequest
-	2,  // 13: persistence.PersistenceService.RetrievePIIToken:input_type -> persistence.RetrievePIITokenRequest
-	6,  // 14: persistence.PersistenceService.StoreTEK:input_type -> persistence.StoreTEKRequest
-	8,  // 15: persistence.PersistenceService.RetrieveTEK:input_type -> persistence.RetrieveTEKRequest
-	4,  // 16: persistence.PersistenceService.HealthCheck:input_type -> persistence.HealthCheckRequest
-	1,  // 17: persistence.PersistenceService.StorePIIToken:output_type -> persistence.StorePIITokenResponse
-	3,  // 18: persistence.PersistenceService.RetrievePIIToken:output_type -> persistence.RetrievePIITokenResponse
-	7,  // 19: persistence.PersistenceService.StoreTEK:output_type -> persistence.StoreTEKResponse
-	9,  // 20: persistence.PersistenceService.RetrieveTEK:output_type -> persistence.RetrieveTEKResponse
-	5,  // 21: persistence.PersistenceService.HealthCheck:output_type -> persistence.HealthCheckResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	57, // 0: persistence.StorePIITokenRequest.created_at:type_name -> google.protobuf.Timestamp
+	57, // 1: persistence.StorePIITokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	54, // 2: persistence.StorePIITokenRequest.metadata:type_name -> persistence.StorePIITokenRequest.MetadataEntry
+	57, // 3: persistence.RetrievePIITokenResponse.created_at:type_name -> google.protobuf.Timestamp
+	57, // 4: persistence.RetrievePIITokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	55, // 5: persistence.RetrievePIITokenResponse.metadata:type_name -> persistence.RetrievePIITokenResponse.MetadataEntry
+	57, // 6: persistence.TokenReference.created_at:type_name -> google.protobuf.Timestamp
+	57, // 7: persistence.TokenReference.expires_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: persistence.LookupPIITokensResponse.tokens:type_name -> persistence.TokenReference
+	57, // 9: persistence.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	56, // 10: persistence.HealthCheckResponse.details:type_name -> persistence.HealthCheckResponse.DetailsEntry
+	57, // 11: persistence.StoreTEKRequest.created_at:type_name -> google.protobuf.Timestamp
+	57, // 12: persistence.StoreTEKRequest.rotated_at:type_name -> google.protobuf.Timestamp
+	57, // 13: persistence.StoreTEKResponse.created_at:type_name -> google.protobuf.Timestamp
+	57, // 14: persistence.RetrieveTEKResponse.created_at:type_name -> google.protobuf.Timestamp
+	57, // 15: persistence.RetrieveTEKResponse.rotated_at:type_name -> google.protobuf.Timestamp
+	57, // 16: persistence.Organization.created_at:type_name -> google.protobuf.Timestamp
+	57, // 17: persistence.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 18: persistence.Organization.suspended_at:type_name -> google.protobuf.Timestamp
+	57, // 19: persistence.Organization.shredded_at:type_name -> google.protobuf.Timestamp
+	57, // 20: persistence.CreateOrganizationRequest.created_at:type_name -> google.protobuf.Timestamp
+	13, // 21: persistence.CreateOrganizationResponse.organization:type_name -> persistence.Organization
+	13, // 22: persistence.GetOrganizationResponse.organization:type_name -> persistence.Organization
+	13, // 23: persistence.ListOrganizationsResponse.organizations:type_name -> persistence.Organization
+	13, // 24: persistence.SuspendOrganizationResponse.organization:type_name -> persistence.Organization
+	13, // 25: persistence.ReactivateOrganizationResponse.organization:type_name -> persistence.Organization
+	13, // 26: persistence.SetOrganizationCipherSuiteResponse.organization:type_name -> persistence.Organization
+	13, // 27: persistence.SetDeterministicDataTypesResponse.organization:type_name -> persistence.Organization
+	13, // 28: persistence.ShredOrganizationResponse.organization:type_name -> persistence.Organization
+	57, // 29: persistence.RotateTEKResponse.rotated_at:type_name -> google.protobuf.Timestamp
+	57, // 30: persistence.OrganizationKeyRotation.started_at:type_name -> google.protobuf.Timestamp
+	57, // 31: persistence.OrganizationKeyRotation.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 32: persistence.OrganizationKeyRotation.completed_at:type_name -> google.protobuf.Timestamp
+	34, // 33: persistence.RotateOrganizationKeyResponse.rotation:type_name -> persistence.OrganizationKeyRotation
+	34, // 34: persistence.GetOrganizationKeyRotationResponse.rotation:type_name -> persistence.OrganizationKeyRotation
+	57, // 35: persistence.KEKRewrapJob.started_at:type_name -> google.protobuf.Timestamp
+	57, // 36: persistence.KEKRewrapJob.completed_at:type_name -> google.protobuf.Timestamp
+	39, // 37: persistence.RewrapTEKsResponse.job:type_name -> persistence.KEKRewrapJob
+	43, // 38: persistence.GetKEKStatusResponse.references:type_name -> persistence.KEKReference
+	39, // 39: persistence.GetKEKStatusResponse.job:type_name -> persistence.KEKRewrapJob
+	57, // 40: persistence.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	57, // 41: persistence.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	57, // 42: persistence.APIKey.revoked_at:type_name -> google.protobuf.Timestamp
+	57, // 43: persistence.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	57, // 44: persistence.CreateAPIKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	45, // 45: persistence.CreateAPIKeyResponse.api_key:type_name -> persistence.APIKey
+	45, // 46: persistence.ListAPIKeysResponse.api_keys:type_name -> persistence.APIKey
+	45, // 47: persistence.RevokeAPIKeyResponse.api_key:type_name -> persistence.APIKey
+	45, // 48: persistence.VerifyAPIKeyResponse.api_key:type_name -> persistence.APIKey
+	0,  // 49: persistence.PersistenceService.StorePIIToken:input_type -> persistence.StorePIITokenRequest
+	2,  // 50: persistence.PersistenceService.RetrievePIIToken:input_type -> persistence.RetrievePIITokenRequest
+	4,  // 51: persistence.PersistenceService.LookupPIITokens:input_type -> persistence.LookupPIITokensRequest
+	9,  // 52: persistence.PersistenceService.StoreTEK:input_type -> persistence.StoreTEKRequest
+	11, // 53: persistence.PersistenceService.RetrieveTEK:input_type -> persistence.RetrieveTEKRequest
+	7,  // 54: persistence.PersistenceService.HealthCheck:input_type -> persistence.HealthCheckRequest
+	14, // 55: persistence.PersistenceService.CreateOrganization:input_type -> persistence.CreateOrganizationRequest
+	16, // 56: persistence.PersistenceService.GetOrganization:input_type -> persistence.GetOrganizationRequest
+	18, // 57: persistence.PersistenceService.ListOrganizations:input_type -> persistence.ListOrganizationsRequest
+	20, // 58: persistence.PersistenceService.SuspendOrganization:input_type -> persistence.SuspendOrganizationRequest
+	22, // 59: persistence.PersistenceService.ReactivateOrganization:input_type -> persistence.ReactivateOrganizationRequest
+	24, // 60: persistence.PersistenceService.UnlockOrganization:input_type -> persistence.UnlockOrganizationRequest
+	26, // 61: persistence.PersistenceService.SetOrganizationCipherSuite:input_type -> persistence.SetOrganizationCipherSuiteRequest
+	28, // 62: persistence.PersistenceService.SetDeterministicDataTypes:input_type -> persistence.SetDeterministicDataTypesRequest
+	30, // 63: persistence.PersistenceService.ShredOrganization:input_type -> persistence.ShredOrganizationRequest
+	32, // 64: persistence.PersistenceService.RotateTEK:input_type -> persistence.RotateTEKRequest
+	35, // 65: persistence.PersistenceService.RotateOrganizationKey:input_type -> persistence.RotateOrganizationKeyRequest
+	37, // 66: persistence.PersistenceService.GetOrganizationKeyRotation:input_type -> persistence.GetOrganizationKeyRotationRequest
+	40, // 67: persistence.PersistenceService.RewrapTEKs:input_type -> persistence.RewrapTEKsRequest
+	42, // 68: persistence.PersistenceService.GetKEKStatus:input_type -> persistence.GetKEKStatusRequest
+	46, // 69: persistence.PersistenceService.CreateAPIKey:input_type -> persistence.CreateAPIKeyRequest
+	48, // 70: persistence.PersistenceService.ListAPIKeys:input_type -> persistence.ListAPIKeysRequest
+	50, // 71: persistence.PersistenceService.RevokeAPIKey:input_type -> persistence.RevokeAPIKeyRequest
+	52, // 72: persistence.PersistenceService.VerifyAPIKey:input_type -> persistence.VerifyAPIKeyRequest
+	1,  // 73: persistence.PersistenceService.StorePIIToken:output_type -> persistence.StorePIITokenResponse
+	3,  // 74: persistence.PersistenceService.RetrievePIIToken:output_type -> persistence.RetrievePIITokenResponse
+	6,  // 75: persistence.PersistenceService.LookupPIITokens:output_type -> persistence.LookupPIITokensResponse
+	10, // 76: persistence.PersistenceService.StoreTEK:output_type -> persistence.StoreTEKResponse
+	12, // 77: persistence.PersistenceService.RetrieveTEK:output_type -> persistence.RetrieveTEKResponse
+	8,  // 78: persistence.PersistenceService.HealthCheck:output_type -> persistence.HealthCheckResponse
+	15, // 79: persistence.PersistenceService.CreateOrganization:output_type -> persistence.CreateOrganizationResponse
+	17, // 80: persistence.PersistenceService.GetOrganization:output_type -> persistence.GetOrganizationResponse
+	19, // 81: persistence.PersistenceService.ListOrganizations:output_type -> persistence.ListOrganizationsResponse
+	21, // 82: persistence.PersistenceService.SuspendOrganization:output_type -> persistence.SuspendOrganizationResponse
+	23, // 83: persistence.PersistenceService.ReactivateOrganization:output_type -> persistence.ReactivateOrganizationResponse
+	25, // 84: persistence.PersistenceService.UnlockOrganization:output_type -> persistence.UnlockOrganizationResponse
+	27, // 85: persistence.PersistenceService.SetOrganizationCipherSuite:output_type -> persistence.SetOrganizationCipherSuiteResponse
+	29, // 86: persistence.PersistenceService.SetDeterministicDataTypes:output_type -> persistence.SetDeterministicDataTypesResponse
+	31, // 87: persistence.PersistenceService.ShredOrganization:output_type -> persistence.ShredOrganizationResponse
+	33, // 88: persistence.PersistenceService.RotateTEK:output_type -> persistence.RotateTEKResponse
+	36, // 89: persistence.PersistenceService.RotateOrganizationKey:output_type -> persistence.RotateOrganizationKeyResponse
+	38, // 90: persistence.PersistenceService.GetOrganizationKeyRotation:output_type -> persistence.GetOrganizationKeyRotationResponse
+	41, // 91: persistence.PersistenceService.RewrapTEKs:output_type -> persistence.RewrapTEKsResponse
+	44, // 92: persistence.PersistenceService.GetKEKStatus:output_type -> persistence.GetKEKStatusResponse
+	47, // 93: persistence.PersistenceService.CreateAPIKey:output_type -> persistence.CreateAPIKeyResponse
+	49, // 94: persistence.PersistenceService.ListAPIKeys:output_type -> persistence.ListAPIKeysResponse
+	51, // 95: persistence.PersistenceService.RevokeAPIKey:output_type -> persistence.RevokeAPIKeyResponse
+	53, // 96: persistence.PersistenceService.VerifyAPIKey:output_type -> persistence.VerifyAPIKeyResponse
+	73, // [73:97] is the sub-list for method output_type
+	49, // [49:73] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_persistence_persistence_service_proto_init() }
@@ -949,7 +4407,7 @@ func file_persistence_persistence_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_persistence_persistence_service_proto_rawDesc), len(file_persistence_persistence_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
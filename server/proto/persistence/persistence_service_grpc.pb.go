@@ -19,11 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PersistenceService_StorePIIToken_FullMethodName    = "/persistence.PersistenceService/StorePIIToken"
-	PersistenceService_RetrievePIIToken_FullMethodName = "/persistence.PersistenceService/RetrievePIIToken"
-	PersistenceService_StoreTEK_FullMethodName         = "/persistence.PersistenceService/StoreTEK"
-	PersistenceService_RetrieveTEK_FullMethodName      = "/persistence.PersistenceService/RetrieveTEK"
-	PersistenceService_HealthCheck_FullMethodName      = "/persistence.PersistenceService/HealthCheck"
+	PersistenceService_StorePIIToken_FullMethodName              = "/persistence.PersistenceService/StorePIIToken"
+	PersistenceService_RetrievePIIToken_FullMethodName           = "/persistence.PersistenceService/RetrievePIIToken"
+	PersistenceService_LookupPIITokens_FullMethodName            = "/persistence.PersistenceService/LookupPIITokens"
+	PersistenceService_StoreTEK_FullMethodName                   = "/persistence.PersistenceService/StoreTEK"
+	PersistenceService_RetrieveTEK_FullMethodName                = "/persistence.PersistenceService/RetrieveTEK"
+	PersistenceService_HealthCheck_FullMethodName                = "/persistence.PersistenceService/HealthCheck"
+	PersistenceService_CreateOrganization_FullMethodName         = "/persistence.PersistenceService/CreateOrganization"
+	PersistenceService_GetOrganization_FullMethodName            = "/persistence.PersistenceService/GetOrganization"
+	PersistenceService_ListOrganizations_FullMethodName          = "/persistence.PersistenceService/ListOrganizations"
+	PersistenceService_SuspendOrganization_FullMethodName        = "/persistence.PersistenceService/SuspendOrganization"
+	PersistenceService_ReactivateOrganization_FullMethodName     = "/persistence.PersistenceService/ReactivateOrganization"
+	PersistenceService_UnlockOrganization_FullMethodName         = "/persistence.PersistenceService/UnlockOrganization"
+	PersistenceService_SetOrganizationCipherSuite_FullMethodName = "/persistence.PersistenceService/SetOrganizationCipherSuite"
+	PersistenceService_SetDeterministicDataTypes_FullMethodName  = "/persistence.PersistenceService/SetDeterministicDataTypes"
+	PersistenceService_ShredOrganization_FullMethodName          = "/persistence.PersistenceService/ShredOrganization"
+	PersistenceService_RotateTEK_FullMethodName                  = "/persistence.PersistenceService/RotateTEK"
+	PersistenceService_RotateOrganizationKey_FullMethodName      = "/persistence.PersistenceService/RotateOrganizationKey"
+	PersistenceService_GetOrganizationKeyRotation_FullMethodName = "/persistence.PersistenceService/GetOrganizationKeyRotation"
+	PersistenceService_RewrapTEKs_FullMethodName                 = "/persistence.PersistenceService/RewrapTEKs"
+	PersistenceService_GetKEKStatus_FullMethodName               = "/persistence.PersistenceService/GetKEKStatus"
+	PersistenceService_CreateAPIKey_FullMethodName               = "/persistence.PersistenceService/CreateAPIKey"
+	PersistenceService_ListAPIKeys_FullMethodName                = "/persistence.PersistenceService/ListAPIKeys"
+	PersistenceService_RevokeAPIKey_FullMethodName               = "/persistence.PersistenceService/RevokeAPIKey"
+	PersistenceService_VerifyAPIKey_FullMethodName               = "/persistence.PersistenceService/VerifyAPIKey"
 )
 
 // PersistenceServiceClient is the client API for PersistenceService service.
@@ -36,12 +55,57 @@ type PersistenceServiceClient interface {
 	StorePIIToken(ctx context.Context, in *StorePIITokenRequest, opts ...grpc.CallOption) (*StorePIITokenResponse, error)
 	// RetrievePIIToken retrieves a tokenized PII record from persistent storage
 	RetrievePIIToken(ctx context.Context, in *RetrievePIITokenRequest, opts ...grpc.CallOption) (*RetrievePIITokenResponse, error)
-	// StoreTEK stores a Tenant Encryption Key for an organization
+	// LookupPIITokens returns the live tokens of an organization with any of the given blind indexes
+	LookupPIITokens(ctx context.Context, in *LookupPIITokensRequest, opts ...grpc.CallOption) (*LookupPIITokensResponse, error)
+	// StoreTEK stores a Tenant Encryption Key for an organization.
+	// It is insert-only: if the organization already has a TEK, the existing one is returned.
 	StoreTEK(ctx context.Context, in *StoreTEKRequest, opts ...grpc.CallOption) (*StoreTEKResponse, error)
 	// RetrieveTEK retrieves a Tenant Encryption Key for an organization
 	RetrieveTEK(ctx context.Context, in *RetrieveTEKRequest, opts ...grpc.CallOption) (*RetrieveTEKResponse, error)
 	// HealthCheck returns the health status of the persistence service
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// CreateOrganization registers a new organization together with its initial TEK
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// GetOrganization returns a single organization
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
+	// ListOrganizations returns organizations, optionally filtered by status
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	// SuspendOrganization blocks all tokenize and detokenize operations for an organization
+	SuspendOrganization(ctx context.Context, in *SuspendOrganizationRequest, opts ...grpc.CallOption) (*SuspendOrganizationResponse, error)
+	// ReactivateOrganization lifts a suspension
+	ReactivateOrganization(ctx context.Context, in *ReactivateOrganizationRequest, opts ...grpc.CallOption) (*ReactivateOrganizationResponse, error)
+	// UnlockOrganization clears brute-force lockout counters for an organization and optionally a source
+	UnlockOrganization(ctx context.Context, in *UnlockOrganizationRequest, opts ...grpc.CallOption) (*UnlockOrganizationResponse, error)
+	// SetOrganizationCipherSuite changes the cipher suite new tokens of an organization are sealed with
+	SetOrganizationCipherSuite(ctx context.Context, in *SetOrganizationCipherSuiteRequest, opts ...grpc.CallOption) (*SetOrganizationCipherSuiteResponse, error)
+	// SetDeterministicDataTypes changes the data types an organization tokenizes deterministically
+	SetDeterministicDataTypes(ctx context.Context, in *SetDeterministicDataTypesRequest, opts ...grpc.CallOption) (*SetDeterministicDataTypesResponse, error)
+	// ShredOrganization destroys an organization's TEKs, purges its cached and queued
+	// tokens and writes a signed shred record to the audit log
+	ShredOrganization(ctx context.Context, in *ShredOrganizationRequest, opts ...grpc.CallOption) (*ShredOrganizationResponse, error)
+	// RotateTEK stores a new active TEK version for an organization. Previous versions
+	// are kept so tokens encrypted with them can still be decrypted.
+	RotateTEK(ctx context.Context, in *RotateTEKRequest, opts ...grpc.CallOption) (*RotateTEKResponse, error)
+	// RotateOrganizationKey replaces an organization's key and starts a background job that
+	// re-encrypts its tokens under the new key. Calling it again with the same keys resumes
+	// an interrupted job.
+	RotateOrganizationKey(ctx context.Context, in *RotateOrganizationKeyRequest, opts ...grpc.CallOption) (*RotateOrganizationKeyResponse, error)
+	// GetOrganizationKeyRotation reports the progress of an organization's latest key rotation
+	GetOrganizationKeyRotation(ctx context.Context, in *GetOrganizationKeyRotationRequest, opts ...grpc.CallOption) (*GetOrganizationKeyRotationResponse, error)
+	// RewrapTEKs starts a background job that rewraps every stored TEK under the current
+	// KEK. Token ciphertexts are not touched.
+	RewrapTEKs(ctx context.Context, in *RewrapTEKsRequest, opts ...grpc.CallOption) (*RewrapTEKsResponse, error)
+	// GetKEKStatus reports how many stored TEKs each KEK still wraps
+	GetKEKStatus(ctx context.Context, in *GetKEKStatusRequest, opts ...grpc.CallOption) (*GetKEKStatusResponse, error)
+	// CreateAPIKey stores a new API key of the API gateway. Only the key's hash is stored.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys returns API keys, optionally those of one client only
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey permanently disables an API key
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// VerifyAPIKey checks a key hash against a live (unrevoked, unexpired) API key and
+	// returns the key, so the gateway learns which client it belongs to
+	VerifyAPIKey(ctx context.Context, in *VerifyAPIKeyRequest, opts ...grpc.CallOption) (*VerifyAPIKeyResponse, error)
 }
 
 type persistenceServiceClient struct {
@@ -72,6 +136,16 @@ func (c *persistenceServiceClient) RetrievePIIToken(ctx context.Context, in *Ret
 	return out, nil
 }
 
+func (c *persistenceServiceClient) LookupPIITokens(ctx context.Context, in *LookupPIITokensRequest, opts ...grpc.CallOption) (*LookupPIITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupPIITokensResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_LookupPIITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *persistenceServiceClient) StoreTEK(ctx context.Context, in *StoreTEKRequest, opts ...grpc.CallOption) (*StoreTEKResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StoreTEKResponse)
@@ -102,6 +176,186 @@ func (c *persistenceServiceClient) HealthCheck(ctx context.Context, in *HealthCh
 	return out, nil
 }
 
+func (c *persistenceServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_GetOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_ListOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) SuspendOrganization(ctx context.Context, in *SuspendOrganizationRequest, opts ...grpc.CallOption) (*SuspendOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendOrganizationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_SuspendOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ReactivateOrganization(ctx context.Context, in *ReactivateOrganizationRequest, opts ...grpc.CallOption) (*ReactivateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateOrganizationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_ReactivateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) UnlockOrganization(ctx context.Context, in *UnlockOrganizationRequest, opts ...grpc.CallOption) (*UnlockOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockOrganizationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_UnlockOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) SetOrganizationCipherSuite(ctx context.Context, in *SetOrganizationCipherSuiteRequest, opts ...grpc.CallOption) (*SetOrganizationCipherSuiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrganizationCipherSuiteResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_SetOrganizationCipherSuite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) SetDeterministicDataTypes(ctx context.Context, in *SetDeterministicDataTypesRequest, opts ...grpc.CallOption) (*SetDeterministicDataTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDeterministicDataTypesResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_SetDeterministicDataTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ShredOrganization(ctx context.Context, in *ShredOrganizationRequest, opts ...grpc.CallOption) (*ShredOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShredOrganizationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_ShredOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) RotateTEK(ctx context.Context, in *RotateTEKRequest, opts ...grpc.CallOption) (*RotateTEKResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateTEKResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_RotateTEK_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) RotateOrganizationKey(ctx context.Context, in *RotateOrganizationKeyRequest, opts ...grpc.CallOption) (*RotateOrganizationKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateOrganizationKeyResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_RotateOrganizationKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) GetOrganizationKeyRotation(ctx context.Context, in *GetOrganizationKeyRotationRequest, opts ...grpc.CallOption) (*GetOrganizationKeyRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationKeyRotationResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_GetOrganizationKeyRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) RewrapTEKs(ctx context.Context, in *RewrapTEKsRequest, opts ...grpc.CallOption) (*RewrapTEKsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewrapTEKsResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_RewrapTEKs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) GetKEKStatus(ctx context.Context, in *GetKEKStatusRequest, opts ...grpc.CallOption) (*GetKEKStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKEKStatusResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_GetKEKStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) VerifyAPIKey(ctx context.Context, in *VerifyAPIKeyRequest, opts ...grpc.CallOption) (*VerifyAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAPIKeyResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_VerifyAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PersistenceServiceServer is the server API for PersistenceService service.
 // All implementations must embed UnimplementedPersistenceServiceServer
 // for forward compatibility.
@@ -112,12 +366,57 @@ type PersistenceServiceServer interface {
 	StorePIIToken(context.Context, *StorePIITokenRequest) (*StorePIITokenResponse, error)
 	// RetrievePIIToken retrieves a tokenized PII record from persistent storage
 	RetrievePIIToken(context.Context, *RetrievePIITokenRequest) (*RetrievePIITokenResponse, error)
-	// StoreTEK stores a Tenant Encryption Key for an organization
+	// LookupPIITokens returns the live tokens of an organization with any of the given blind indexes
+	LookupPIITokens(context.Context, *LookupPIITokensRequest) (*LookupPIITokensResponse, error)
+	// StoreTEK stores a Tenant Encryption Key for an organization.
+	// It is insert-only: if the organization already has a TEK, the existing one is returned.
 	StoreTEK(context.Context, *StoreTEKRequest) (*StoreTEKResponse, error)
 	// RetrieveTEK retrieves a Tenant Encryption Key for an organization
 	RetrieveTEK(context.Context, *RetrieveTEKRequest) (*RetrieveTEKResponse, error)
 	// HealthCheck returns the health status of the persistence service
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// CreateOrganization registers a new organization together with its initial TEK
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// GetOrganization returns a single organization
+	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	// ListOrganizations returns organizations, optionally filtered by status
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	// SuspendOrganization blocks all tokenize and detokenize operations for an organization
+	SuspendOrganization(context.Context, *SuspendOrganizationRequest) (*SuspendOrganizationResponse, error)
+	// ReactivateOrganization lifts a suspension
+	ReactivateOrganization(context.Context, *ReactivateOrganizationRequest) (*ReactivateOrganizationResponse, error)
+	// UnlockOrganization clears brute-force lockout counters for an organization and optionally a source
+	UnlockOrganization(context.Context, *UnlockOrganizationRequest) (*UnlockOrganizationResponse, error)
+	// SetOrganizationCipherSuite changes the cipher suite new tokens of an organization are sealed with
+	SetOrganizationCipherSuite(context.Context, *SetOrganizationCipherSuiteRequest) (*SetOrganizationCipherSuiteResponse, error)
+	// SetDeterministicDataTypes changes the data types an organization tokenizes deterministically
+	SetDeterministicDataTypes(context.Context, *SetDeterministicDataTypesRequest) (*SetDeterministicDataTypesResponse, error)
+	// ShredOrganization destroys an organization's TEKs, purges its cached and queued
+	// tokens and writes a signed shred record to the audit log
+	ShredOrganization(context.Context, *ShredOrganizationRequest) (*ShredOrganizationResponse, error)
+	// RotateTEK stores a new active TEK version for an organization. Previous versions
+	// are kept so tokens encrypted with them can still be decrypted.
+	RotateTEK(context.Context, *RotateTEKRequest) (*RotateTEKResponse, error)
+	// RotateOrganizationKey replaces an organization's key and starts a background job that
+	// re-encrypts its tokens under the new key. Calling it again with the same keys resumes
+	// an interrupted job.
+	RotateOrganizationKey(context.Context, *RotateOrganizationKeyRequest) (*RotateOrganizationKeyResponse, error)
+	// GetOrganizationKeyRotation reports the progress of an organization's latest key rotation
+	GetOrganizationKeyRotation(context.Context, *GetOrganizationKeyRotationRequest) (*GetOrganizationKeyRotationResponse, error)
+	// RewrapTEKs starts a background job that rewraps every stored TEK under the current
+	// KEK. Token ciphertexts are not touched.
+	RewrapTEKs(context.Context, *RewrapTEKsRequest) (*RewrapTEKsResponse, error)
+	// GetKEKStatus reports how many stored TEKs each KEK still wraps
+	GetKEKStatus(context.Context, *GetKEKStatusRequest) (*GetKEKStatusResponse, error)
+	// CreateAPIKey stores a new API key of the API gateway. Only the key's hash is stored.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys returns API keys, optionally those of one client only
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey permanently disables an API key
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// VerifyAPIKey checks a key hash against a live (unrevoked, unexpired) API key and
+	// returns the key, so the gateway learns which client it belongs to
+	VerifyAPIKey(context.Context, *VerifyAPIKeyRequest) (*VerifyAPIKeyResponse, error)
 	mustEmbedUnimplementedPersistenceServiceServer()
 }
 
@@ -134,6 +433,9 @@ func (UnimplementedPersistenceServiceServer) StorePIIToken(context.Context, *Sto
 func (UnimplementedPersistenceServiceServer) RetrievePIIToken(context.Context, *RetrievePIITokenRequest) (*RetrievePIITokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrievePIIToken not implemented")
 }
+func (UnimplementedPersistenceServiceServer) LookupPIITokens(context.Context, *LookupPIITokensRequest) (*LookupPIITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPIITokens not implemented")
+}
 func (UnimplementedPersistenceServiceServer) StoreTEK(context.Context, *StoreTEKRequest) (*StoreTEKResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreTEK not implemented")
 }
@@ -143,6 +445,60 @@ func (UnimplementedPersistenceServiceServer) RetrieveTEK(context.Context, *Retri
 func (UnimplementedPersistenceServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (UnimplementedPersistenceServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedPersistenceServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedPersistenceServiceServer) SuspendOrganization(context.Context, *SuspendOrganizationRequest) (*SuspendOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendOrganization not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ReactivateOrganization(context.Context, *ReactivateOrganizationRequest) (*ReactivateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateOrganization not implemented")
+}
+func (UnimplementedPersistenceServiceServer) UnlockOrganization(context.Context, *UnlockOrganizationRequest) (*UnlockOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockOrganization not implemented")
+}
+func (UnimplementedPersistenceServiceServer) SetOrganizationCipherSuite(context.Context, *SetOrganizationCipherSuiteRequest) (*SetOrganizationCipherSuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationCipherSuite not implemented")
+}
+func (UnimplementedPersistenceServiceServer) SetDeterministicDataTypes(context.Context, *SetDeterministicDataTypesRequest) (*SetDeterministicDataTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeterministicDataTypes not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ShredOrganization(context.Context, *ShredOrganizationRequest) (*ShredOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShredOrganization not implemented")
+}
+func (UnimplementedPersistenceServiceServer) RotateTEK(context.Context, *RotateTEKRequest) (*RotateTEKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTEK not implemented")
+}
+func (UnimplementedPersistenceServiceServer) RotateOrganizationKey(context.Context, *RotateOrganizationKeyRequest) (*RotateOrganizationKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateOrganizationKey not implemented")
+}
+func (UnimplementedPersistenceServiceServer) GetOrganizationKeyRotation(context.Context, *GetOrganizationKeyRotationRequest) (*GetOrganizationKeyRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationKeyRotation not implemented")
+}
+func (UnimplementedPersistenceServiceServer) RewrapTEKs(context.Context, *RewrapTEKsRequest) (*RewrapTEKsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewrapTEKs not implemented")
+}
+func (UnimplementedPersistenceServiceServer) GetKEKStatus(context.Context, *GetKEKStatusRequest) (*GetKEKStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKEKStatus not implemented")
+}
+func (UnimplementedPersistenceServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedPersistenceServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedPersistenceServiceServer) VerifyAPIKey(context.Context, *VerifyAPIKeyRequest) (*VerifyAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAPIKey not implemented")
+}
 func (UnimplementedPersistenceServiceServer) mustEmbedUnimplementedPersistenceServiceServer() {}
 func (UnimplementedPersistenceServiceServer) testEmbeddedByValue()                            {}
 
@@ -200,6 +556,24 @@ func _PersistenceService_RetrievePIIToken_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PersistenceService_LookupPIITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPIITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).LookupPIITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_LookupPIITokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).LookupPIITokens(ctx, req.(*LookupPIITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PersistenceService_StoreTEK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StoreTEKRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +628,330 @@ func _PersistenceService_HealthCheck_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PersistenceService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_SuspendOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).SuspendOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_SuspendOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).SuspendOrganization(ctx, req.(*SuspendOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_ReactivateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).ReactivateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_ReactivateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).ReactivateOrganization(ctx, req.(*ReactivateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_UnlockOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).UnlockOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_UnlockOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).UnlockOrganization(ctx, req.(*UnlockOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_SetOrganizationCipherSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationCipherSuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).SetOrganizationCipherSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_SetOrganizationCipherSuite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).SetOrganizationCipherSuite(ctx, req.(*SetOrganizationCipherSuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_SetDeterministicDataTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeterministicDataTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).SetDeterministicDataTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_SetDeterministicDataTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).SetDeterministicDataTypes(ctx, req.(*SetDeterministicDataTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_ShredOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShredOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).ShredOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_ShredOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).ShredOrganization(ctx, req.(*ShredOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_RotateTEK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTEKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).RotateTEK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_RotateTEK_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).RotateTEK(ctx, req.(*RotateTEKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_RotateOrganizationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateOrganizationKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).RotateOrganizationKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_RotateOrganizationKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).RotateOrganizationKey(ctx, req.(*RotateOrganizationKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetOrganizationKeyRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationKeyRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetOrganizationKeyRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetOrganizationKeyRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetOrganizationKeyRotation(ctx, req.(*GetOrganizationKeyRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_RewrapTEKs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewrapTEKsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).RewrapTEKs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_RewrapTEKs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).RewrapTEKs(ctx, req.(*RewrapTEKsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetKEKStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKEKStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetKEKStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetKEKStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetKEKStatus(ctx, req.(*GetKEKStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_VerifyAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).VerifyAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_VerifyAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).VerifyAPIKey(ctx, req.(*VerifyAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PersistenceService_ServiceDesc is the grpc.ServiceDesc for PersistenceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +967,10 @@ var PersistenceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetrievePIIToken",
 			Handler:    _PersistenceService_RetrievePIIToken_Handler,
 		},
+		{
+			MethodName: "LookupPIITokens",
+			Handler:    _PersistenceService_LookupPIITokens_Handler,
+		},
 		{
 			MethodName: "StoreTEK",
 			Handler:    _PersistenceService_StoreTEK_Handler,
@@ -281,6 +983,78 @@ var PersistenceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HealthCheck",
 			Handler:    _PersistenceService_HealthCheck_Handler,
 		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _PersistenceService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _PersistenceService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _PersistenceService_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "SuspendOrganization",
+			Handler:    _PersistenceService_SuspendOrganization_Handler,
+		},
+		{
+			MethodName: "ReactivateOrganization",
+			Handler:    _PersistenceService_ReactivateOrganization_Handler,
+		},
+		{
+			MethodName: "UnlockOrganization",
+			Handler:    _PersistenceService_UnlockOrganization_Handler,
+		},
+		{
+			MethodName: "SetOrganizationCipherSuite",
+			Handler:    _PersistenceService_SetOrganizationCipherSuite_Handler,
+		},
+		{
+			MethodName: "SetDeterministicDataTypes",
+			Handler:    _PersistenceService_SetDeterministicDataTypes_Handler,
+		},
+		{
+			MethodName: "ShredOrganization",
+			Handler:    _PersistenceService_ShredOrganization_Handler,
+		},
+		{
+			MethodName: "RotateTEK",
+			Handler:    _PersistenceService_RotateTEK_Handler,
+		},
+		{
+			MethodName: "RotateOrganizationKey",
+			Handler:    _PersistenceService_RotateOrganizationKey_Handler,
+		},
+		{
+			MethodName: "GetOrganizationKeyRotation",
+			Handler:    _PersistenceService_GetOrganizationKeyRotation_Handler,
+		},
+		{
+			MethodName: "RewrapTEKs",
+			Handler:    _PersistenceService_RewrapTEKs_Handler,
+		},
+		{
+			MethodName: "GetKEKStatus",
+			Handler:    _PersistenceService_GetKEKStatus_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _PersistenceService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _PersistenceService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _PersistenceService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "VerifyAPIKey",
+			Handler:    _PersistenceService_VerifyAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "persistence/persistence_service.proto",
@@ -24,16 +24,19 @@ const (
 
 // TokenizeRequest contains PII data to be tokenized
 type TokenizeRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Data            string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	DataType        string                 `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	RetentionPolicy string                 `protobuf:"bytes,3,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
-	ClientId        string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Metadata        map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	OrganizationId  string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationKey string                 `protobuf:"bytes,7,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Data             string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	DataType         string                 `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	RetentionPolicy  string                 `protobuf:"bytes,3,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	ClientId         string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Metadata         map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OrganizationId   string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey  string                 `protobuf:"bytes,7,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
+	TokenFormat      string                 `protobuf:"bytes,8,opt,name=token_format,json=tokenFormat,proto3" json:"token_format,omitempty"`                    // "reference" (default) or "fpe" for format-preserving credit_card, ssn and phone tokens
+	PreserveBin      bool                   `protobuf:"varint,9,opt,name=preserve_bin,json=preserveBin,proto3" json:"preserve_bin,omitempty"`                   // fpe credit_card only: keep the first six digits
+	PreserveLastFour bool                   `protobuf:"varint,10,opt,name=preserve_last_four,json=preserveLastFour,proto3" json:"preserve_last_four,omitempty"` // fpe only: keep the last four digits
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TokenizeRequest) Reset() {
@@ -115,6 +118,27 @@ func (x *TokenizeRequest) GetOrganizationKey() string {
 	return ""
 }
 
+func (x *TokenizeRequest) GetTokenFormat() string {
+	if x != nil {
+		return x.TokenFormat
+	}
+	return ""
+}
+
+func (x *TokenizeRequest) GetPreserveBin() bool {
+	if x != nil {
+		return x.PreserveBin
+	}
+	return false
+}
+
+func (x *TokenizeRequest) GetPreserveLastFour() bool {
+	if x != nil {
+		return x.PreserveLastFour
+	}
+	return false
+}
+
 // TokenizeResponse contains the generated reference token
 type TokenizeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -123,6 +147,7 @@ type TokenizeResponse struct {
 	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Existing      bool                   `protobuf:"varint,6,opt,name=existing,proto3" json:"existing,omitempty"` // Deterministic tokenization returned the token of an earlier request
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -192,6 +217,13 @@ func (x *TokenizeResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *TokenizeResponse) GetExisting() bool {
+	if x != nil {
+		return x.Existing
+	}
+	return false
+}
+
 // DetokenizeRequest contains the reference token to be detokenized
 type DetokenizeRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -362,28 +394,34 @@ func (x *DetokenizeResponse) GetErrorMessage() string {
 	return ""
 }
 
-// HealthCheckRequest requests health status
-type HealthCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// LookupTokenRequest asks for the tokens of a known value
+type LookupTokenRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Data              string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	DataType          string                 `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Purpose           string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	RequestingService string                 `protobuf:"bytes,4,opt,name=requesting_service,json=requestingService,proto3" json:"requesting_service,omitempty"`
+	RequestingUser    string                 `protobuf:"bytes,5,opt,name=requesting_user,json=requestingUser,proto3" json:"requesting_user,omitempty"`
+	OrganizationId    string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey   string                 `protobuf:"bytes,7,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
+func (x *LookupTokenRequest) Reset() {
+	*x = LookupTokenRequest{}
 	mi := &file_pii_pii_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *LookupTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*LookupTokenRequest) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *LookupTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pii_pii_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,44 +433,85 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LookupTokenRequest.ProtoReflect.Descriptor instead.
+func (*LookupTokenRequest) Descriptor() ([]byte, []int) {
 	return file_pii_pii_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *HealthCheckRequest) GetServiceName() string {
+func (x *LookupTokenRequest) GetData() string {
 	if x != nil {
-		return x.ServiceName
+		return x.Data
 	}
 	return ""
 }
 
-// HealthCheckResponse contains health status information
-type HealthCheckResponse struct {
+func (x *LookupTokenRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *LookupTokenRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *LookupTokenRequest) GetRequestingService() string {
+	if x != nil {
+		return x.RequestingService
+	}
+	return ""
+}
+
+func (x *LookupTokenRequest) GetRequestingUser() string {
+	if x != nil {
+		return x.RequestingUser
+	}
+	return ""
+}
+
+func (x *LookupTokenRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *LookupTokenRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+// TokenMatch is a live token whose value matched a lookup
+type TokenMatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Details       map[string]string      `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReferenceHash string                 `protobuf:"bytes,1,opt,name=reference_hash,json=referenceHash,proto3" json:"reference_hash,omitempty"` // tok_<hash>, accepted by Detokenize
+	TokenType     string                 `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+func (x *TokenMatch) Reset() {
+	*x = TokenMatch{}
 	mi := &file_pii_pii_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *TokenMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*TokenMatch) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *TokenMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_pii_pii_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -444,101 +523,3250 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenMatch.ProtoReflect.Descriptor instead.
+func (*TokenMatch) Descriptor() ([]byte, []int) {
 	return file_pii_pii_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HealthCheckResponse) GetStatus() string {
+func (x *TokenMatch) GetReferenceHash() string {
 	if x != nil {
-		return x.Status
+		return x.ReferenceHash
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetServiceName() string {
+func (x *TokenMatch) GetTokenType() string {
 	if x != nil {
-		return x.ServiceName
+		return x.TokenType
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetVersion() string {
+func (x *TokenMatch) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Version
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TokenMatch) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// LookupTokenResponse lists the matching tokens, newest first
+type LookupTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*TokenMatch          `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupTokenResponse) Reset() {
+	*x = LookupTokenResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupTokenResponse) ProtoMessage() {}
+
+func (x *LookupTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupTokenResponse.ProtoReflect.Descriptor instead.
+func (*LookupTokenResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LookupTokenResponse) GetTokens() []*TokenMatch {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *LookupTokenResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *LookupTokenResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.Timestamp
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// TokenizeBatchRequest tokenizes several values with one organization key
+type TokenizeBatchRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Items           []*TokenizeBatchItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	RetentionPolicy string                 `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"` // For items without their own
+	ClientId        string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Metadata        map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OrganizationId  string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey string                 `protobuf:"bytes,6,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenizeBatchRequest) Reset() {
+	*x = TokenizeBatchRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeBatchRequest) ProtoMessage() {}
+
+func (x *TokenizeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeBatchRequest.ProtoReflect.Descriptor instead.
+func (*TokenizeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TokenizeBatchRequest) GetItems() []*TokenizeBatchItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-func (x *HealthCheckResponse) GetDetails() map[string]string {
+func (x *TokenizeBatchRequest) GetRetentionPolicy() string {
 	if x != nil {
-		return x.Details
+		return x.RetentionPolicy
+	}
+	return ""
+}
+
+func (x *TokenizeBatchRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TokenizeBatchRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-var File_pii_pii_service_proto protoreflect.FileDescriptor
+func (x *TokenizeBatchRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
 
-const file_pii_pii_service_proto_rawDesc = "" +
-	"\n" +
-	"\x15pii/pii_service.proto\x12\x03pii\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x02\n" +
-	"\x0fTokenizeRequest\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1b\n" +
-	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12)\n" +
-	"\x10retention_policy\x18\x03 \x01(\tR\x0fretentionPolicy\x12\x1b\n" +
-	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12>\n" +
-	"\bmetadata\x18\x05 \x03(\v2\".pii.TokenizeRequest.MetadataEntryR\bmetadata\x12'\n" +
-	"\x0forganization_id\x18\x06 \x01(\tR\x0eorganizationId\x12)\n" +
-	"\x10organization_key\x18\a \x01(\tR\x0forganizationKey\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd0\x01\n" +
-	"\x10TokenizeResponse\x12%\n" +
-	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12\x1d\n" +
-	"\n" +
-	"token_type\x18\x02 \x01(\tR\ttokenType\x129\n" +
-	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x80\x02\n" +
-	"\x11DetokenizeRequest\x12%\n" +
-	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12\x18\n" +
-	"\apurpose\x18\x02 \x01(\tR\apurpose\x12-\n" +
-	"\x12requesting_service\x18\x03 \x01(\tR\x11requestingService\x12'\n" +
-	"\x0frequesting_user\x18\x04 \x01(\tR\x0erequestingUser\x12'\n" +
-	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12)\n" +
-	"\x10organization_key\x18\x06 \x01(\tR\x0forganizationKey\"\xf2\x01\n" +
-	"\x12DetokenizeResponse\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1b\n" +
-	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12I\n" +
-	"\x12original_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11originalTimestamp\x12#\n" +
-	"\raccess_logged\x18\x04 \x01(\bR\faccessLogged\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
-	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"7\n" +
-	"\x12HealthCheckRequest\x12!\n" +
-	"\fservice_name\x18\x01 \x01(\tR\vserviceName\"\xa1\x02\n" +
-	"\x13HealthCheckResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
-	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12?\n" +
-	"\adetails\x18\x05 \x03(\v2%.pii.HealthCheckResponse.DetailsEntryR\adetails\x1a:\n" +
-	"\fDetailsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xc6\x01\n" +
-	"\n" +
-	"PIIService\x127\n" +
-	"\bTokenize\x12\x14.pii.TokenizeRequest\x1a\x15.pii.TokenizeResponse\x12=\n" +
+func (x *TokenizeBatchRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+// TokenizeBatchItem is one value of a TokenizeBatchRequest, with the fields of TokenizeRequest
+type TokenizeBatchItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Data             string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	DataType         string                 `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	RetentionPolicy  string                 `protobuf:"bytes,3,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"` // Overrides the batch's retention policy
+	TokenFormat      string                 `protobuf:"bytes,4,opt,name=token_format,json=tokenFormat,proto3" json:"token_format,omitempty"`
+	PreserveBin      bool                   `protobuf:"varint,5,opt,name=preserve_bin,json=preserveBin,proto3" json:"preserve_bin,omitempty"`
+	PreserveLastFour bool                   `protobuf:"varint,6,opt,name=preserve_last_four,json=preserveLastFour,proto3" json:"preserve_last_four,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TokenizeBatchItem) Reset() {
+	*x = TokenizeBatchItem{}
+	mi := &file_pii_pii_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeBatchItem) ProtoMessage() {}
+
+func (x *TokenizeBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeBatchItem.ProtoReflect.Descriptor instead.
+func (*TokenizeBatchItem) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TokenizeBatchItem) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *TokenizeBatchItem) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *TokenizeBatchItem) GetRetentionPolicy() string {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return ""
+}
+
+func (x *TokenizeBatchItem) GetTokenFormat() string {
+	if x != nil {
+		return x.TokenFormat
+	}
+	return ""
+}
+
+func (x *TokenizeBatchItem) GetPreserveBin() bool {
+	if x != nil {
+		return x.PreserveBin
+	}
+	return false
+}
+
+func (x *TokenizeBatchItem) GetPreserveLastFour() bool {
+	if x != nil {
+		return x.PreserveLastFour
+	}
+	return false
+}
+
+// TokenizeBatchResponse holds one result per item, in request order. Status is
+// "success" when the batch was processed, even if some of its items failed.
+type TokenizeBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*TokenizeResponse    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeBatchResponse) Reset() {
+	*x = TokenizeBatchResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeBatchResponse) ProtoMessage() {}
+
+func (x *TokenizeBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeBatchResponse.ProtoReflect.Descriptor instead.
+func (*TokenizeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TokenizeBatchResponse) GetResults() []*TokenizeResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TokenizeBatchResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TokenizeBatchResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// DetokenizeBatchRequest detokenizes several tokens with one organization key
+type DetokenizeBatchRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceHashes   []string               `protobuf:"bytes,1,rep,name=reference_hashes,json=referenceHashes,proto3" json:"reference_hashes,omitempty"`
+	Purpose           string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	RequestingService string                 `protobuf:"bytes,3,opt,name=requesting_service,json=requestingService,proto3" json:"requesting_service,omitempty"`
+	RequestingUser    string                 `protobuf:"bytes,4,opt,name=requesting_user,json=requestingUser,proto3" json:"requesting_user,omitempty"`
+	OrganizationId    string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey   string                 `protobuf:"bytes,6,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DetokenizeBatchRequest) Reset() {
+	*x = DetokenizeBatchRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetokenizeBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetokenizeBatchRequest) ProtoMessage() {}
+
+func (x *DetokenizeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetokenizeBatchRequest.ProtoReflect.Descriptor instead.
+func (*DetokenizeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DetokenizeBatchRequest) GetReferenceHashes() []string {
+	if x != nil {
+		return x.ReferenceHashes
+	}
+	return nil
+}
+
+func (x *DetokenizeBatchRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *DetokenizeBatchRequest) GetRequestingService() string {
+	if x != nil {
+		return x.RequestingService
+	}
+	return ""
+}
+
+func (x *DetokenizeBatchRequest) GetRequestingUser() string {
+	if x != nil {
+		return x.RequestingUser
+	}
+	return ""
+}
+
+func (x *DetokenizeBatchRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DetokenizeBatchRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+// DetokenizeBatchResponse holds one result per token, in request order. Status is
+// "success" when the batch was processed, even if some of its tokens failed.
+type DetokenizeBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*DetokenizeResponse  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetokenizeBatchResponse) Reset() {
+	*x = DetokenizeBatchResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetokenizeBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetokenizeBatchResponse) ProtoMessage() {}
+
+func (x *DetokenizeBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetokenizeBatchResponse.ProtoReflect.Descriptor instead.
+func (*DetokenizeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DetokenizeBatchResponse) GetResults() []*DetokenizeResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DetokenizeBatchResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetokenizeBatchResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// TokenizeStreamRequest is one message of a TokenizeStream
+type TokenizeStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"` // Chosen by the client and echoed on the response
+	Request       *TokenizeRequest       `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeStreamRequest) Reset() {
+	*x = TokenizeStreamRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeStreamRequest) ProtoMessage() {}
+
+func (x *TokenizeStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeStreamRequest.ProtoReflect.Descriptor instead.
+func (*TokenizeStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TokenizeStreamRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *TokenizeStreamRequest) GetRequest() *TokenizeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// TokenizeStreamResponse answers one TokenizeStreamRequest. A message that fails does
+// not end the stream: its response has status "error", and error_code names the gRPC
+// status code when the unary call would have failed with one (e.g. "Unauthenticated").
+type TokenizeStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Response      *TokenizeResponse      `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeStreamResponse) Reset() {
+	*x = TokenizeStreamResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeStreamResponse) ProtoMessage() {}
+
+func (x *TokenizeStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeStreamResponse.ProtoReflect.Descriptor instead.
+func (*TokenizeStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TokenizeStreamResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *TokenizeStreamResponse) GetResponse() *TokenizeResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TokenizeStreamResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+// DetokenizeStreamRequest is one message of a DetokenizeStream
+type DetokenizeStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"` // Chosen by the client and echoed on the response
+	Request       *DetokenizeRequest     `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetokenizeStreamRequest) Reset() {
+	*x = DetokenizeStreamRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetokenizeStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetokenizeStreamRequest) ProtoMessage() {}
+
+func (x *DetokenizeStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetokenizeStreamRequest.ProtoReflect.Descriptor instead.
+func (*DetokenizeStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DetokenizeStreamRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *DetokenizeStreamRequest) GetRequest() *DetokenizeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// DetokenizeStreamResponse answers one DetokenizeStreamRequest, like TokenizeStreamResponse
+type DetokenizeStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Response      *DetokenizeResponse    `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ReferenceHash string                 `protobuf:"bytes,4,opt,name=reference_hash,json=referenceHash,proto3" json:"reference_hash,omitempty"` // The token of the request, so the response can be audited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetokenizeStreamResponse) Reset() {
+	*x = DetokenizeStreamResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetokenizeStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetokenizeStreamResponse) ProtoMessage() {}
+
+func (x *DetokenizeStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetokenizeStreamResponse.ProtoReflect.Descriptor instead.
+func (*DetokenizeStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DetokenizeStreamResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *DetokenizeStreamResponse) GetResponse() *DetokenizeResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DetokenizeStreamResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *DetokenizeStreamResponse) GetReferenceHash() string {
+	if x != nil {
+		return x.ReferenceHash
+	}
+	return ""
+}
+
+// HealthCheckRequest requests health status
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HealthCheckRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+// HealthCheckResponse contains health status information
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HealthCheckResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *HealthCheckResponse) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Organization describes a tenant registered with the platform
+type Organization struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId         string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DisplayName            string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Status                 string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "active", "suspended" or "shredded"
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SuspendedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	SuspendedReason        string                 `protobuf:"bytes,7,opt,name=suspended_reason,json=suspendedReason,proto3" json:"suspended_reason,omitempty"`
+	CipherSuite            string                 `protobuf:"bytes,8,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`                                    // "aes-256-gcm", "aes-256-gcm-siv" or "xchacha20-poly1305"
+	DeterministicDataTypes []string               `protobuf:"bytes,9,rep,name=deterministic_data_types,json=deterministicDataTypes,proto3" json:"deterministic_data_types,omitempty"` // Data types whose reference tokens are derived from the value
+	ShreddedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=shredded_at,json=shreddedAt,proto3" json:"shredded_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_pii_pii_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Organization) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Organization) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Organization) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetSuspendedReason() string {
+	if x != nil {
+		return x.SuspendedReason
+	}
+	return ""
+}
+
+func (x *Organization) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+func (x *Organization) GetDeterministicDataTypes() []string {
+	if x != nil {
+		return x.DeterministicDataTypes
+	}
+	return nil
+}
+
+func (x *Organization) GetShreddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShreddedAt
+	}
+	return nil
+}
+
+// CreateOrganizationRequest onboards a new tenant
+type CreateOrganizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DisplayName     string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	OrganizationKey string                 `protobuf:"bytes,3,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"` // Optional; generated and returned once when empty
+	CipherSuite     string                 `protobuf:"bytes,4,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`             // Optional; defaults to "aes-256-gcm"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+// CreateOrganizationResponse contains the new organization
+type CreateOrganizationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Organization    *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	OrganizationKey string                 `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"` // Only set when the key was generated by the service
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage    string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *CreateOrganizationResponse) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+func (x *CreateOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationResponse) Reset() {
+	*x = GetOrganizationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *GetOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Optional filter: "active", "suspended" or "shredded"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListOrganizationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrganizationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrganizationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListOrganizationsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrganizationsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SuspendOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuspendOrganizationRequest) Reset() {
+	*x = SuspendOrganizationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendOrganizationRequest) ProtoMessage() {}
+
+func (x *SuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SuspendOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendOrganizationResponse) Reset() {
+	*x = SuspendOrganizationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendOrganizationResponse) ProtoMessage() {}
+
+func (x *SuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SuspendOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SuspendOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ReactivateOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReactivateOrganizationRequest) Reset() {
+	*x = ReactivateOrganizationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateOrganizationRequest) ProtoMessage() {}
+
+func (x *ReactivateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReactivateOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ReactivateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateOrganizationResponse) Reset() {
+	*x = ReactivateOrganizationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateOrganizationResponse) ProtoMessage() {}
+
+func (x *ReactivateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReactivateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *ReactivateOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReactivateOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UnlockOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Source         string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // Optional: also clear the counters of this source address
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnlockOrganizationRequest) Reset() {
+	*x = UnlockOrganizationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockOrganizationRequest) ProtoMessage() {}
+
+func (x *UnlockOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UnlockOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlockOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UnlockOrganizationRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type UnlockOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockOrganizationResponse) Reset() {
+	*x = UnlockOrganizationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockOrganizationResponse) ProtoMessage() {}
+
+func (x *UnlockOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*UnlockOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnlockOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnlockOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SetOrganizationCipherSuiteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CipherSuite    string                 `protobuf:"bytes,2,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetOrganizationCipherSuiteRequest) Reset() {
+	*x = SetOrganizationCipherSuiteRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrganizationCipherSuiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrganizationCipherSuiteRequest) ProtoMessage() {}
+
+func (x *SetOrganizationCipherSuiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrganizationCipherSuiteRequest.ProtoReflect.Descriptor instead.
+func (*SetOrganizationCipherSuiteRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetOrganizationCipherSuiteRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetOrganizationCipherSuiteRequest) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+type SetOrganizationCipherSuiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOrganizationCipherSuiteResponse) Reset() {
+	*x = SetOrganizationCipherSuiteResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrganizationCipherSuiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrganizationCipherSuiteResponse) ProtoMessage() {}
+
+func (x *SetOrganizationCipherSuiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrganizationCipherSuiteResponse.ProtoReflect.Descriptor instead.
+func (*SetOrganizationCipherSuiteResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetOrganizationCipherSuiteResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SetOrganizationCipherSuiteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetOrganizationCipherSuiteResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SetDeterministicDataTypesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DataTypes      []string               `protobuf:"bytes,2,rep,name=data_types,json=dataTypes,proto3" json:"data_types,omitempty"` // Replaces the current list; empty turns deterministic tokens off
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetDeterministicDataTypesRequest) Reset() {
+	*x = SetDeterministicDataTypesRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeterministicDataTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeterministicDataTypesRequest) ProtoMessage() {}
+
+func (x *SetDeterministicDataTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeterministicDataTypesRequest.ProtoReflect.Descriptor instead.
+func (*SetDeterministicDataTypesRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetDeterministicDataTypesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetDeterministicDataTypesRequest) GetDataTypes() []string {
+	if x != nil {
+		return x.DataTypes
+	}
+	return nil
+}
+
+type SetDeterministicDataTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeterministicDataTypesResponse) Reset() {
+	*x = SetDeterministicDataTypesResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeterministicDataTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeterministicDataTypesResponse) ProtoMessage() {}
+
+func (x *SetDeterministicDataTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeterministicDataTypesResponse.ProtoReflect.Descriptor instead.
+func (*SetDeterministicDataTypesResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetDeterministicDataTypesResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SetDeterministicDataTypesResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetDeterministicDataTypesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ShredOrganizationRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ConfirmOrganizationId string                 `protobuf:"bytes,2,opt,name=confirm_organization_id,json=confirmOrganizationId,proto3" json:"confirm_organization_id,omitempty"` // Must repeat organization_id
+	DeleteTokens          bool                   `protobuf:"varint,3,opt,name=delete_tokens,json=deleteTokens,proto3" json:"delete_tokens,omitempty"`                             // Also delete the organization's token rows
+	Reason                string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy           string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ShredOrganizationRequest) Reset() {
+	*x = ShredOrganizationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShredOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShredOrganizationRequest) ProtoMessage() {}
+
+func (x *ShredOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShredOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ShredOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShredOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ShredOrganizationRequest) GetConfirmOrganizationId() string {
+	if x != nil {
+		return x.ConfirmOrganizationId
+	}
+	return ""
+}
+
+func (x *ShredOrganizationRequest) GetDeleteTokens() bool {
+	if x != nil {
+		return x.DeleteTokens
+	}
+	return false
+}
+
+func (x *ShredOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ShredOrganizationRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type ShredOrganizationResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Organization         *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Record               string                 `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`                        // The signed shred record, a JSON document
+	Signature            string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`                  // Base64 Ed25519 signature of record
+	PublicKey            string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // Base64 Ed25519 key that verifies signature
+	TekVersionsDestroyed int32                  `protobuf:"varint,5,opt,name=tek_versions_destroyed,json=tekVersionsDestroyed,proto3" json:"tek_versions_destroyed,omitempty"`
+	TokensDeleted        int64                  `protobuf:"varint,6,opt,name=tokens_deleted,json=tokensDeleted,proto3" json:"tokens_deleted,omitempty"`
+	CacheEntriesPurged   int64                  `protobuf:"varint,7,opt,name=cache_entries_purged,json=cacheEntriesPurged,proto3" json:"cache_entries_purged,omitempty"`
+	QueuedMessagesPurged int64                  `protobuf:"varint,8,opt,name=queued_messages_purged,json=queuedMessagesPurged,proto3" json:"queued_messages_purged,omitempty"`
+	AuditId              string                 `protobuf:"bytes,9,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"` // Empty if the record could not be written to the audit log
+	Status               string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                 // "success" or "error"
+	ErrorMessage         string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CachedTeksExpireAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=cached_teks_expire_at,json=cachedTeksExpireAt,proto3" json:"cached_teks_expire_at,omitempty"` // Latest time a PII service replica that missed the invalidation may still serve a cached TEK
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShredOrganizationResponse) Reset() {
+	*x = ShredOrganizationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShredOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShredOrganizationResponse) ProtoMessage() {}
+
+func (x *ShredOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShredOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ShredOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ShredOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *ShredOrganizationResponse) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetTekVersionsDestroyed() int32 {
+	if x != nil {
+		return x.TekVersionsDestroyed
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetTokensDeleted() int64 {
+	if x != nil {
+		return x.TokensDeleted
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetCacheEntriesPurged() int64 {
+	if x != nil {
+		return x.CacheEntriesPurged
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetQueuedMessagesPurged() int64 {
+	if x != nil {
+		return x.QueuedMessagesPurged
+	}
+	return 0
+}
+
+func (x *ShredOrganizationResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ShredOrganizationResponse) GetCachedTeksExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CachedTeksExpireAt
+	}
+	return nil
+}
+
+type RotateTEKRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateTEKRequest) Reset() {
+	*x = RotateTEKRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTEKRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTEKRequest) ProtoMessage() {}
+
+func (x *RotateTEKRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTEKRequest.ProtoReflect.Descriptor instead.
+func (*RotateTEKRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RotateTEKRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type RotateTEKResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Version        int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // The new active TEK version
+	RotatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage   string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateTEKResponse) Reset() {
+	*x = RotateTEKResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTEKResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTEKResponse) ProtoMessage() {}
+
+func (x *RotateTEKResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTEKResponse.ProtoReflect.Descriptor instead.
+func (*RotateTEKResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RotateTEKResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RotateTEKResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RotateTEKResponse) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return nil
+}
+
+func (x *RotateTEKResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RotateTEKResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// OrganizationKeyRotation describes a background re-encryption job
+type OrganizationKeyRotation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RotationId      string                 `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "running", "interrupted" (resume with the same keys) or "completed"
+	FromVersion     int32                  `protobuf:"varint,4,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion       int32                  `protobuf:"varint,5,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	TotalTokens     int64                  `protobuf:"varint,6,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	ProcessedTokens int64                  `protobuf:"varint,7,opt,name=processed_tokens,json=processedTokens,proto3" json:"processed_tokens,omitempty"`
+	FailedTokens    int64                  `protobuf:"varint,8,opt,name=failed_tokens,json=failedTokens,proto3" json:"failed_tokens,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ErrorMessage    string                 `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrganizationKeyRotation) Reset() {
+	*x = OrganizationKeyRotation{}
+	mi := &file_pii_pii_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationKeyRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationKeyRotation) ProtoMessage() {}
+
+func (x *OrganizationKeyRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationKeyRotation.ProtoReflect.Descriptor instead.
+func (*OrganizationKeyRotation) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OrganizationKeyRotation) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *OrganizationKeyRotation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationKeyRotation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrganizationKeyRotation) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetProcessedTokens() int64 {
+	if x != nil {
+		return x.ProcessedTokens
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetFailedTokens() int64 {
+	if x != nil {
+		return x.FailedTokens
+	}
+	return 0
+}
+
+func (x *OrganizationKeyRotation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *OrganizationKeyRotation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationKeyRotation) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *OrganizationKeyRotation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RotateOrganizationKeyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId     string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationKey    string                 `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`            // Current key
+	NewOrganizationKey string                 `protobuf:"bytes,3,opt,name=new_organization_key,json=newOrganizationKey,proto3" json:"new_organization_key,omitempty"` // Optional: generated if empty
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateOrganizationKeyRequest) Reset() {
+	*x = RotateOrganizationKeyRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOrganizationKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOrganizationKeyRequest) ProtoMessage() {}
+
+func (x *RotateOrganizationKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOrganizationKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateOrganizationKeyRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RotateOrganizationKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyRequest) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyRequest) GetNewOrganizationKey() string {
+	if x != nil {
+		return x.NewOrganizationKey
+	}
+	return ""
+}
+
+type RotateOrganizationKeyResponse struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Rotation        *OrganizationKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	OrganizationKey string                   `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"` // The generated new key; only set when it was generated
+	Status          string                   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage    string                   `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RotateOrganizationKeyResponse) Reset() {
+	*x = RotateOrganizationKeyResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOrganizationKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOrganizationKeyResponse) ProtoMessage() {}
+
+func (x *RotateOrganizationKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOrganizationKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateOrganizationKeyResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RotateOrganizationKeyResponse) GetRotation() *OrganizationKeyRotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *RotateOrganizationKeyResponse) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RotateOrganizationKeyResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetOrganizationKeyRotationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationKeyRotationRequest) Reset() {
+	*x = GetOrganizationKeyRotationRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationKeyRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationKeyRotationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationKeyRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationKeyRotationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationKeyRotationRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetOrganizationKeyRotationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationKeyRotationResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rotation      *OrganizationKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Status        string                   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                   `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationKeyRotationResponse) Reset() {
+	*x = GetOrganizationKeyRotationResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationKeyRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationKeyRotationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationKeyRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationKeyRotationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationKeyRotationResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetOrganizationKeyRotationResponse) GetRotation() *OrganizationKeyRotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *GetOrganizationKeyRotationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrganizationKeyRotationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// KEK rotation messages
+type KEKRewrapJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "running", "completed" or "failed"
+	TotalTeks     int64                  `protobuf:"varint,2,opt,name=total_teks,json=totalTeks,proto3" json:"total_teks,omitempty"`
+	RewrappedTeks int64                  `protobuf:"varint,3,opt,name=rewrapped_teks,json=rewrappedTeks,proto3" json:"rewrapped_teks,omitempty"`
+	FailedTeks    int64                  `protobuf:"varint,4,opt,name=failed_teks,json=failedTeks,proto3" json:"failed_teks,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KEKRewrapJob) Reset() {
+	*x = KEKRewrapJob{}
+	mi := &file_pii_pii_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KEKRewrapJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KEKRewrapJob) ProtoMessage() {}
+
+func (x *KEKRewrapJob) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KEKRewrapJob.ProtoReflect.Descriptor instead.
+func (*KEKRewrapJob) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *KEKRewrapJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KEKRewrapJob) GetTotalTeks() int64 {
+	if x != nil {
+		return x.TotalTeks
+	}
+	return 0
+}
+
+func (x *KEKRewrapJob) GetRewrappedTeks() int64 {
+	if x != nil {
+		return x.RewrappedTeks
+	}
+	return 0
+}
+
+func (x *KEKRewrapJob) GetFailedTeks() int64 {
+	if x != nil {
+		return x.FailedTeks
+	}
+	return 0
+}
+
+func (x *KEKRewrapJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *KEKRewrapJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *KEKRewrapJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RewrapTEKsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewrapTEKsRequest) Reset() {
+	*x = RewrapTEKsRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewrapTEKsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewrapTEKsRequest) ProtoMessage() {}
+
+func (x *RewrapTEKsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewrapTEKsRequest.ProtoReflect.Descriptor instead.
+func (*RewrapTEKsRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{45}
+}
+
+type RewrapTEKsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *KEKRewrapJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewrapTEKsResponse) Reset() {
+	*x = RewrapTEKsResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewrapTEKsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewrapTEKsResponse) ProtoMessage() {}
+
+func (x *RewrapTEKsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewrapTEKsResponse.ProtoReflect.Descriptor instead.
+func (*RewrapTEKsResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RewrapTEKsResponse) GetJob() *KEKRewrapJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *RewrapTEKsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RewrapTEKsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetKEKStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKEKStatusRequest) Reset() {
+	*x = GetKEKStatusRequest{}
+	mi := &file_pii_pii_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKEKStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKEKStatusRequest) ProtoMessage() {}
+
+func (x *GetKEKStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKEKStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetKEKStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{47}
+}
+
+type KEKReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KekId         string                 `protobuf:"bytes,1,opt,name=kek_id,json=kekId,proto3" json:"kek_id,omitempty"`     // Empty for TEKs wrapped before KEK IDs were introduced
+	Teks          int64                  `protobuf:"varint,2,opt,name=teks,proto3" json:"teks,omitempty"`                   // Stored TEKs wrapped with this KEK
+	InRing        bool                   `protobuf:"varint,3,opt,name=in_ring,json=inRing,proto3" json:"in_ring,omitempty"` // Whether the KEK is configured on the persistence service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KEKReference) Reset() {
+	*x = KEKReference{}
+	mi := &file_pii_pii_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KEKReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KEKReference) ProtoMessage() {}
+
+func (x *KEKReference) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KEKReference.ProtoReflect.Descriptor instead.
+func (*KEKReference) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *KEKReference) GetKekId() string {
+	if x != nil {
+		return x.KekId
+	}
+	return ""
+}
+
+func (x *KEKReference) GetTeks() int64 {
+	if x != nil {
+		return x.Teks
+	}
+	return 0
+}
+
+func (x *KEKReference) GetInRing() bool {
+	if x != nil {
+		return x.InRing
+	}
+	return false
+}
+
+type GetKEKStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentKekId  string                 `protobuf:"bytes,1,opt,name=current_kek_id,json=currentKekId,proto3" json:"current_kek_id,omitempty"`
+	References    []*KEKReference        `protobuf:"bytes,2,rep,name=references,proto3" json:"references,omitempty"`
+	RemainingTeks int64                  `protobuf:"varint,3,opt,name=remaining_teks,json=remainingTeks,proto3" json:"remaining_teks,omitempty"` // TEKs not yet wrapped with the current KEK
+	Job           *KEKRewrapJob          `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`                                           // Latest rewrap job run by the replica that answered, if any
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                     // "success" or "error"
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKEKStatusResponse) Reset() {
+	*x = GetKEKStatusResponse{}
+	mi := &file_pii_pii_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKEKStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKEKStatusResponse) ProtoMessage() {}
+
+func (x *GetKEKStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pii_pii_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKEKStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetKEKStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pii_pii_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetKEKStatusResponse) GetCurrentKekId() string {
+	if x != nil {
+		return x.CurrentKekId
+	}
+	return ""
+}
+
+func (x *GetKEKStatusResponse) GetReferences() []*KEKReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetKEKStatusResponse) GetRemainingTeks() int64 {
+	if x != nil {
+		return x.RemainingTeks
+	}
+	return 0
+}
+
+func (x *GetKEKStatusResponse) GetJob() *KEKRewrapJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GetKEKStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetKEKStatusResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+var File_pii_pii_service_proto protoreflect.FileDescriptor
+
+const file_pii_pii_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15pii/pii_service.proto\x12\x03pii\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x03\n" +
+	"\x0fTokenizeRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12)\n" +
+	"\x10retention_policy\x18\x03 \x01(\tR\x0fretentionPolicy\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12>\n" +
+	"\bmetadata\x18\x05 \x03(\v2\".pii.TokenizeRequest.MetadataEntryR\bmetadata\x12'\n" +
+	"\x0forganization_id\x18\x06 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\a \x01(\tR\x0forganizationKey\x12!\n" +
+	"\ftoken_format\x18\b \x01(\tR\vtokenFormat\x12!\n" +
+	"\fpreserve_bin\x18\t \x01(\bR\vpreserveBin\x12,\n" +
+	"\x12preserve_last_four\x18\n" +
+	" \x01(\bR\x10preserveLastFour\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xec\x01\n" +
+	"\x10TokenizeResponse\x12%\n" +
+	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x1a\n" +
+	"\bexisting\x18\x06 \x01(\bR\bexisting\"\x80\x02\n" +
+	"\x11DetokenizeRequest\x12%\n" +
+	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12-\n" +
+	"\x12requesting_service\x18\x03 \x01(\tR\x11requestingService\x12'\n" +
+	"\x0frequesting_user\x18\x04 \x01(\tR\x0erequestingUser\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\x06 \x01(\tR\x0forganizationKey\"\xf2\x01\n" +
+	"\x12DetokenizeResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12I\n" +
+	"\x12original_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11originalTimestamp\x12#\n" +
+	"\raccess_logged\x18\x04 \x01(\bR\faccessLogged\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\x8b\x02\n" +
+	"\x12LookupTokenRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12-\n" +
+	"\x12requesting_service\x18\x04 \x01(\tR\x11requestingService\x12'\n" +
+	"\x0frequesting_user\x18\x05 \x01(\tR\x0erequestingUser\x12'\n" +
+	"\x0forganization_id\x18\x06 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\a \x01(\tR\x0forganizationKey\"\xc8\x01\n" +
+	"\n" +
+	"TokenMatch\x12%\n" +
+	"\x0ereference_hash\x18\x01 \x01(\tR\rreferenceHash\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"{\n" +
+	"\x13LookupTokenResponse\x12'\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x0f.pii.TokenMatchR\x06tokens\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xe2\x02\n" +
+	"\x14TokenizeBatchRequest\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.pii.TokenizeBatchItemR\x05items\x12)\n" +
+	"\x10retention_policy\x18\x02 \x01(\tR\x0fretentionPolicy\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12C\n" +
+	"\bmetadata\x18\x04 \x03(\v2'.pii.TokenizeBatchRequest.MetadataEntryR\bmetadata\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\x06 \x01(\tR\x0forganizationKey\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x11TokenizeBatchItem\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12)\n" +
+	"\x10retention_policy\x18\x03 \x01(\tR\x0fretentionPolicy\x12!\n" +
+	"\ftoken_format\x18\x04 \x01(\tR\vtokenFormat\x12!\n" +
+	"\fpreserve_bin\x18\x05 \x01(\bR\vpreserveBin\x12,\n" +
+	"\x12preserve_last_four\x18\x06 \x01(\bR\x10preserveLastFour\"\x85\x01\n" +
+	"\x15TokenizeBatchResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.pii.TokenizeResponseR\aresults\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x89\x02\n" +
+	"\x16DetokenizeBatchRequest\x12)\n" +
+	"\x10reference_hashes\x18\x01 \x03(\tR\x0freferenceHashes\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12-\n" +
+	"\x12requesting_service\x18\x03 \x01(\tR\x11requestingService\x12'\n" +
+	"\x0frequesting_user\x18\x04 \x01(\tR\x0erequestingUser\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\x06 \x01(\tR\x0forganizationKey\"\x89\x01\n" +
+	"\x17DetokenizeBatchResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.pii.DetokenizeResponseR\aresults\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"n\n" +
+	"\x15TokenizeStreamRequest\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x12.\n" +
+	"\arequest\x18\x02 \x01(\v2\x14.pii.TokenizeRequestR\arequest\"\x91\x01\n" +
+	"\x16TokenizeStreamResponse\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x121\n" +
+	"\bresponse\x18\x02 \x01(\v2\x15.pii.TokenizeResponseR\bresponse\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\"r\n" +
+	"\x17DetokenizeStreamRequest\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x120\n" +
+	"\arequest\x18\x02 \x01(\v2\x16.pii.DetokenizeRequestR\arequest\"\xbc\x01\n" +
+	"\x18DetokenizeStreamResponse\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x123\n" +
+	"\bresponse\x18\x02 \x01(\v2\x17.pii.DetokenizeResponseR\bresponse\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12%\n" +
+	"\x0ereference_hash\x18\x04 \x01(\tR\rreferenceHash\"7\n" +
+	"\x12HealthCheckRequest\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\"\xa1\x02\n" +
+	"\x13HealthCheckResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12?\n" +
+	"\adetails\x18\x05 \x03(\v2%.pii.HealthCheckResponse.DetailsEntryR\adetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xec\x03\n" +
+	"\fOrganization\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fsuspended_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x12)\n" +
+	"\x10suspended_reason\x18\a \x01(\tR\x0fsuspendedReason\x12!\n" +
+	"\fcipher_suite\x18\b \x01(\tR\vcipherSuite\x128\n" +
+	"\x18deterministic_data_types\x18\t \x03(\tR\x16deterministicDataTypes\x12;\n" +
+	"\vshredded_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"shreddedAt\"\xb5\x01\n" +
+	"\x19CreateOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12)\n" +
+	"\x10organization_key\x18\x03 \x01(\tR\x0forganizationKey\x12!\n" +
+	"\fcipher_suite\x18\x04 \x01(\tR\vcipherSuite\"\xbb\x01\n" +
+	"\x1aCreateOrganizationResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12)\n" +
+	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"A\n" +
+	"\x16GetOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x8d\x01\n" +
+	"\x17GetOrganizationResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"`\n" +
+	"\x18ListOrganizationsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xb2\x01\n" +
+	"\x19ListOrganizationsResponse\x127\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x11.pii.OrganizationR\rorganizations\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"]\n" +
+	"\x1aSuspendOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x91\x01\n" +
+	"\x1bSuspendOrganizationResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"H\n" +
+	"\x1dReactivateOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x94\x01\n" +
+	"\x1eReactivateOrganizationResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\\\n" +
+	"\x19UnlockOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"Y\n" +
+	"\x1aUnlockOrganizationResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"o\n" +
+	"!SetOrganizationCipherSuiteRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fcipher_suite\x18\x02 \x01(\tR\vcipherSuite\"\x98\x01\n" +
+	"\"SetOrganizationCipherSuiteResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"j\n" +
+	" SetDeterministicDataTypesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"data_types\x18\x02 \x03(\tR\tdataTypes\"\x97\x01\n" +
+	"!SetDeterministicDataTypesResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xdb\x01\n" +
+	"\x18ShredOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x126\n" +
+	"\x17confirm_organization_id\x18\x02 \x01(\tR\x15confirmOrganizationId\x12#\n" +
+	"\rdelete_tokens\x18\x03 \x01(\bR\fdeleteTokens\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\"\x93\x04\n" +
+	"\x19ShredOrganizationResponse\x125\n" +
+	"\forganization\x18\x01 \x01(\v2\x11.pii.OrganizationR\forganization\x12\x16\n" +
+	"\x06record\x18\x02 \x01(\tR\x06record\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x124\n" +
+	"\x16tek_versions_destroyed\x18\x05 \x01(\x05R\x14tekVersionsDestroyed\x12%\n" +
+	"\x0etokens_deleted\x18\x06 \x01(\x03R\rtokensDeleted\x120\n" +
+	"\x14cache_entries_purged\x18\a \x01(\x03R\x12cacheEntriesPurged\x124\n" +
+	"\x16queued_messages_purged\x18\b \x01(\x03R\x14queuedMessagesPurged\x12\x19\n" +
+	"\baudit_id\x18\t \x01(\tR\aauditId\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x12M\n" +
+	"\x15cached_teks_expire_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x12cachedTeksExpireAt\";\n" +
+	"\x10RotateTEKRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xce\x01\n" +
+	"\x11RotateTEKResponse\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"rotated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x8a\x04\n" +
+	"\x17OrganizationKeyRotation\x12\x1f\n" +
+	"\vrotation_id\x18\x01 \x01(\tR\n" +
+	"rotationId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\ffrom_version\x18\x04 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x05 \x01(\x05R\ttoVersion\x12!\n" +
+	"\ftotal_tokens\x18\x06 \x01(\x03R\vtotalTokens\x12)\n" +
+	"\x10processed_tokens\x18\a \x01(\x03R\x0fprocessedTokens\x12#\n" +
+	"\rfailed_tokens\x18\b \x01(\x03R\ffailedTokens\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12#\n" +
+	"\rerror_message\x18\f \x01(\tR\ferrorMessage\"\xa4\x01\n" +
+	"\x1cRotateOrganizationKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\x120\n" +
+	"\x14new_organization_key\x18\x03 \x01(\tR\x12newOrganizationKey\"\xc1\x01\n" +
+	"\x1dRotateOrganizationKeyResponse\x128\n" +
+	"\brotation\x18\x01 \x01(\v2\x1c.pii.OrganizationKeyRotationR\brotation\x12)\n" +
+	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"L\n" +
+	"!GetOrganizationKeyRotationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x9b\x01\n" +
+	"\"GetOrganizationKeyRotationResponse\x128\n" +
+	"\brotation\x18\x01 \x01(\v2\x1c.pii.OrganizationKeyRotationR\brotation\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xac\x02\n" +
+	"\fKEKRewrapJob\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"total_teks\x18\x02 \x01(\x03R\ttotalTeks\x12%\n" +
+	"\x0erewrapped_teks\x18\x03 \x01(\x03R\rrewrappedTeks\x12\x1f\n" +
+	"\vfailed_teks\x18\x04 \x01(\x03R\n" +
+	"failedTeks\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12#\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\"\x13\n" +
+	"\x11RewrapTEKsRequest\"v\n" +
+	"\x12RewrapTEKsResponse\x12#\n" +
+	"\x03job\x18\x01 \x01(\v2\x11.pii.KEKRewrapJobR\x03job\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x15\n" +
+	"\x13GetKEKStatusRequest\"R\n" +
+	"\fKEKReference\x12\x15\n" +
+	"\x06kek_id\x18\x01 \x01(\tR\x05kekId\x12\x12\n" +
+	"\x04teks\x18\x02 \x01(\x03R\x04teks\x12\x17\n" +
+	"\ain_ring\x18\x03 \x01(\bR\x06inRing\"\xf8\x01\n" +
+	"\x14GetKEKStatusResponse\x12$\n" +
+	"\x0ecurrent_kek_id\x18\x01 \x01(\tR\fcurrentKekId\x121\n" +
+	"\n" +
+	"references\x18\x02 \x03(\v2\x11.pii.KEKReferenceR\n" +
+	"references\x12%\n" +
+	"\x0eremaining_teks\x18\x03 \x01(\x03R\rremainingTeks\x12#\n" +
+	"\x03job\x18\x04 \x01(\v2\x11.pii.KEKRewrapJobR\x03job\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage2\x8d\x0e\n" +
+	"\n" +
+	"PIIService\x127\n" +
+	"\bTokenize\x12\x14.pii.TokenizeRequest\x1a\x15.pii.TokenizeResponse\x12=\n" +
+	"\n" +
+	"Detokenize\x12\x16.pii.DetokenizeRequest\x1a\x17.pii.DetokenizeResponse\x12F\n" +
+	"\rTokenizeBatch\x12\x19.pii.TokenizeBatchRequest\x1a\x1a.pii.TokenizeBatchResponse\x12L\n" +
+	"\x0fDetokenizeBatch\x12\x1b.pii.DetokenizeBatchRequest\x1a\x1c.pii.DetokenizeBatchResponse\x12M\n" +
+	"\x0eTokenizeStream\x12\x1a.pii.TokenizeStreamRequest\x1a\x1b.pii.TokenizeStreamResponse(\x010\x01\x12S\n" +
+	"\x10DetokenizeStream\x12\x1c.pii.DetokenizeStreamRequest\x1a\x1d.pii.DetokenizeStreamResponse(\x010\x01\x12@\n" +
+	"\vLookupToken\x12\x17.pii.LookupTokenRequest\x1a\x18.pii.LookupTokenResponse\x12@\n" +
+	"\vHealthCheck\x12\x17.pii.HealthCheckRequest\x1a\x18.pii.HealthCheckResponse\x12U\n" +
+	"\x12CreateOrganization\x12\x1e.pii.CreateOrganizationRequest\x1a\x1f.pii.CreateOrganizationResponse\x12L\n" +
+	"\x0fGetOrganization\x12\x1b.pii.GetOrganizationRequest\x1a\x1c.pii.GetOrganizationResponse\x12R\n" +
+	"\x11ListOrganizations\x12\x1d.pii.ListOrganizationsRequest\x1a\x1e.pii.ListOrganizationsResponse\x12X\n" +
+	"\x13SuspendOrganization\x12\x1f.pii.SuspendOrganizationRequest\x1a .pii.SuspendOrganizationResponse\x12a\n" +
+	"\x16ReactivateOrganization\x12\".pii.ReactivateOrganizationRequest\x1a#.pii.ReactivateOrganizationResponse\x12U\n" +
+	"\x12UnlockOrganization\x12\x1e.pii.UnlockOrganizationRequest\x1a\x1f.pii.UnlockOrganizationResponse\x12m\n" +
+	"\x1aSetOrganizationCipherSuite\x12&.pii.SetOrganizationCipherSuiteRequest\x1a'.pii.SetOrganizationCipherSuiteResponse\x12j\n" +
+	"\x19SetDeterministicDataTypes\x12%.pii.SetDeterministicDataTypesRequest\x1a&.pii.SetDeterministicDataTypesResponse\x12R\n" +
+	"\x11ShredOrganization\x12\x1d.pii.ShredOrganizationRequest\x1a\x1e.pii.ShredOrganizationResponse\x12:\n" +
+	"\tRotateTEK\x12\x15.pii.RotateTEKRequest\x1a\x16.pii.RotateTEKResponse\x12^\n" +
+	"\x15RotateOrganizationKey\x12!.pii.RotateOrganizationKeyRequest\x1a\".pii.RotateOrganizationKeyResponse\x12m\n" +
+	"\x1aGetOrganizationKeyRotation\x12&.pii.GetOrganizationKeyRotationRequest\x1a'.pii.GetOrganizationKeyRotationResponse\x12=\n" +
 	"\n" +
-	"Detokenize\x12\x16.pii.DetokenizeRequest\x1a\x17.pii.DetokenizeResponse\x12@\n" +
-	"\vHealthCheck\x12\x17.pii.HealthCheckRequest\x1a\x18.pii.HealthCheckResponseB/Z-github.com/PlainFunction/mistokenly/proto/piib\x06proto3"
+	"RewrapTEKs\x12\x16.pii.RewrapTEKsRequest\x1a\x17.pii.RewrapTEKsResponse\x12C\n" +
+	"\fGetKEKStatus\x12\x18.pii.GetKEKStatusRequest\x1a\x19.pii.GetKEKStatusResponseB/Z-github.com/PlainFunction/mistokenly/proto/piib\x06proto3"
 
 var (
 	file_pii_pii_service_proto_rawDescOnce sync.Once
@@ -552,35 +3780,153 @@ func file_pii_pii_service_proto_rawDescGZIP() []byte {
 	return file_pii_pii_service_proto_rawDescData
 }
 
-var file_pii_pii_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_pii_pii_service_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_pii_pii_service_proto_goTypes = []any{
-	(*TokenizeRequest)(nil),       // 0: pii.TokenizeRequest
-	(*TokenizeResponse)(nil),      // 1: pii.TokenizeResponse
-	(*DetokenizeRequest)(nil),     // 2: pii.DetokenizeRequest
-	(*DetokenizeResponse)(nil),    // 3: pii.DetokenizeResponse
-	(*HealthCheckRequest)(nil),    // 4: pii.HealthCheckRequest
-	(*HealthCheckResponse)(nil),   // 5: pii.HealthCheckResponse
-	nil,                           // 6: pii.TokenizeRequest.MetadataEntry
-	nil,                           // 7: pii.HealthCheckResponse.DetailsEntry
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*TokenizeRequest)(nil),                    // 0: pii.TokenizeRequest
+	(*TokenizeResponse)(nil),                   // 1: pii.TokenizeResponse
+	(*DetokenizeRequest)(nil),                  // 2: pii.DetokenizeRequest
+	(*DetokenizeResponse)(nil),                 // 3: pii.DetokenizeResponse
+	(*LookupTokenRequest)(nil),                 // 4: pii.LookupTokenRequest
+	(*TokenMatch)(nil),                         // 5: pii.TokenMatch
+	(*LookupTokenResponse)(nil),                // 6: pii.LookupTokenResponse
+	(*TokenizeBatchRequest)(nil),               // 7: pii.TokenizeBatchRequest
+	(*TokenizeBatchItem)(nil),                  // 8: pii.TokenizeBatchItem
+	(*TokenizeBatchResponse)(nil),              // 9: pii.TokenizeBatchResponse
+	(*DetokenizeBatchRequest)(nil),             // 10: pii.DetokenizeBatchRequest
+	(*DetokenizeBatchResponse)(nil),            // 11: pii.DetokenizeBatchResponse
+	(*TokenizeStreamRequest)(nil),              // 12: pii.TokenizeStreamRequest
+	(*TokenizeStreamResponse)(nil),             // 13: pii.TokenizeStreamResponse
+	(*DetokenizeStreamRequest)(nil),            // 14: pii.DetokenizeStreamRequest
+	(*DetokenizeStreamResponse)(nil),           // 15: pii.DetokenizeStreamResponse
+	(*HealthCheckRequest)(nil),                 // 16: pii.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                // 17: pii.HealthCheckResponse
+	(*Organization)(nil),                       // 18: pii.Organization
+	(*CreateOrganizationRequest)(nil),          // 19: pii.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),         // 20: pii.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),             // 21: pii.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),            // 22: pii.GetOrganizationResponse
+	(*ListOrganizationsRequest)(nil),           // 23: pii.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),          // 24: pii.ListOrganizationsResponse
+	(*SuspendOrganizationRequest)(nil),         // 25: pii.SuspendOrganizationRequest
+	(*SuspendOrganizationResponse)(nil),        // 26: pii.SuspendOrganizationResponse
+	(*ReactivateOrganizationRequest)(nil),      // 27: pii.ReactivateOrganizationRequest
+	(*ReactivateOrganizationResponse)(nil),     // 28: pii.ReactivateOrganizationResponse
+	(*UnlockOrganizationRequest)(nil),          // 29: pii.UnlockOrganizationRequest
+	(*UnlockOrganizationResponse)(nil),         // 30: pii.UnlockOrganizationResponse
+	(*SetOrganizationCipherSuiteRequest)(nil),  // 31: pii.SetOrganizationCipherSuiteRequest
+	(*SetOrganizationCipherSuiteResponse)(nil), // 32: pii.SetOrganizationCipherSuiteResponse
+	(*SetDeterministicDataTypesRequest)(nil),   // 33: pii.SetDeterministicDataTypesRequest
+	(*SetDeterministicDataTypesResponse)(nil),  // 34: pii.SetDeterministicDataTypesResponse
+	(*ShredOrganizationRequest)(nil),           // 35: pii.ShredOrganizationRequest
+	(*ShredOrganizationResponse)(nil),          // 36: pii.ShredOrganizationResponse
+	(*RotateTEKRequest)(nil),                   // 37: pii.RotateTEKRequest
+	(*RotateTEKResponse)(nil),                  // 38: pii.RotateTEKResponse
+	(*OrganizationKeyRotation)(nil),            // 39: pii.OrganizationKeyRotation
+	(*RotateOrganizationKeyRequest)(nil),       // 40: pii.RotateOrganizationKeyRequest
+	(*RotateOrganizationKeyResponse)(nil),      // 41: pii.RotateOrganizationKeyResponse
+	(*GetOrganizationKeyRotationRequest)(nil),  // 42: pii.GetOrganizationKeyRotationRequest
+	(*GetOrganizationKeyRotationResponse)(nil), // 43: pii.GetOrganizationKeyRotationResponse
+	(*KEKRewrapJob)(nil),                       // 44: pii.KEKRewrapJob
+	(*RewrapTEKsRequest)(nil),                  // 45: pii.RewrapTEKsRequest
+	(*RewrapTEKsResponse)(nil),                 // 46: pii.RewrapTEKsResponse
+	(*GetKEKStatusRequest)(nil),                // 47: pii.GetKEKStatusRequest
+	(*KEKReference)(nil),                       // 48: pii.KEKReference
+	(*GetKEKStatusResponse)(nil),               // 49: pii.GetKEKStatusResponse
+	nil,                                        // 50: pii.TokenizeRequest.MetadataEntry
+	nil,                                        // 51: pii.TokenizeBatchRequest.MetadataEntry
+	nil,                                        // 52: pii.HealthCheckResponse.DetailsEntry
+	(*timestamppb.Timestamp)(nil),              // 53: google.protobuf.Timestamp
 }
 var file_pii_pii_service_proto_depIdxs = []int32{
-	6, // 0: pii.TokenizeRequest.metadata:type_name -> pii.TokenizeRequest.MetadataEntry
-	8, // 1: pii.TokenizeResponse.expires_at:type_name -> google.protobuf.Timestamp
-	8, // 2: pii.DetokenizeResponse.original_timestamp:type_name -> google.protobuf.Timestamp
-	8, // 3: pii.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	7, // 4: pii.HealthCheckResponse.details:type_name -> pii.HealthCheckResponse.DetailsEntry
-	0, // 5: pii.PIIService.Tokenize:input_type -> pii.TokenizeRequest
-	2, // 6: pii.PIIService.Detokenize:input_type -> pii.DetokenizeRequest
-	4, // 7: pii.PIIService.HealthCheck:input_type -> pii.HealthCheckRequest
-	1, // 8: pii.PIIService.Tokenize:output_type -> pii.TokenizeResponse
-	3, // 9: pii.PIIService.Detokenize:output_type -> pii.DetokenizeResponse
-	5, // 10: pii.PIIService.HealthCheck:output_type -> pii.HealthCheckResponse
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	50, // 0: pii.TokenizeRequest.metadata:type_name -> pii.TokenizeRequest.MetadataEntry
+	53, // 1: pii.TokenizeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 2: pii.DetokenizeResponse.original_timestamp:type_name -> google.protobuf.Timestamp
+	53, // 3: pii.TokenMatch.created_at:type_name -> google.protobuf.Timestamp
+	53, // 4: pii.TokenMatch.expires_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: pii.LookupTokenResponse.tokens:type_name -> pii.TokenMatch
+	8,  // 6: pii.TokenizeBatchRequest.items:type_name -> pii.TokenizeBatchItem
+	51, // 7: pii.TokenizeBatchRequest.metadata:type_name -> pii.TokenizeBatchRequest.MetadataEntry
+	1,  // 8: pii.TokenizeBatchResponse.results:type_name -> pii.TokenizeResponse
+	3,  // 9: pii.DetokenizeBatchResponse.results:type_name -> pii.DetokenizeResponse
+	0,  // 10: pii.TokenizeStreamRequest.request:type_name -> pii.TokenizeRequest
+	1,  // 11: pii.TokenizeStreamResponse.response:type_name -> pii.TokenizeResponse
+	2,  // 12: pii.DetokenizeStreamRequest.request:type_name -> pii.DetokenizeRequest
+	3,  // 13: pii.DetokenizeStreamResponse.response:type_name -> pii.DetokenizeResponse
+	53, // 14: pii.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	52, // 15: pii.HealthCheckResponse.details:type_name -> pii.HealthCheckResponse.DetailsEntry
+	53, // 16: pii.Organization.created_at:type_name -> google.protobuf.Timestamp
+	53, // 17: pii.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 18: pii.Organization.suspended_at:type_name -> google.protobuf.Timestamp
+	53, // 19: pii.Organization.shredded_at:type_name -> google.protobuf.Timestamp
+	18, // 20: pii.CreateOrganizationResponse.organization:type_name -> pii.Organization
+	18, // 21: pii.GetOrganizationResponse.organization:type_name -> pii.Organization
+	18, // 22: pii.ListOrganizationsResponse.organizations:type_name -> pii.Organization
+	18, // 23: pii.SuspendOrganizationResponse.organization:type_name -> pii.Organization
+	18, // 24: pii.ReactivateOrganizationResponse.organization:type_name -> pii.Organization
+	18, // 25: pii.SetOrganizationCipherSuiteResponse.organization:type_name -> pii.Organization
+	18, // 26: pii.SetDeterministicDataTypesResponse.organization:type_name -> pii.Organization
+	18, // 27: pii.ShredOrganizationResponse.organization:type_name -> pii.Organization
+	53, // 28: pii.ShredOrganizationResponse.cached_teks_expire_at:type_name -> google.protobuf.Timestamp
+	53, // 29: pii.RotateTEKResponse.rotated_at:type_name -> google.protobuf.Timestamp
+	53, // 30: pii.OrganizationKeyRotation.started_at:type_name -> google.protobuf.Timestamp
+	53, // 31: pii.OrganizationKeyRotation.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 32: pii.OrganizationKeyRotation.completed_at:type_name -> google.protobuf.Timestamp
+	39, // 33: pii.RotateOrganizationKeyResponse.rotation:type_name -> pii.OrganizationKeyRotation
+	39, // 34: pii.GetOrganizationKeyRotationResponse.rotation:type_name -> pii.OrganizationKeyRotation
+	53, // 35: pii.KEKRewrapJob.started_at:type_name -> google.protobuf.Timestamp
+	53, // 36: pii.KEKRewrapJob.completed_at:type_name -> google.protobuf.Timestamp
+	44, // 37: pii.RewrapTEKsResponse.job:type_name -> pii.KEKRewrapJob
+	48, // 38: pii.GetKEKStatusResponse.references:type_name -> pii.KEKReference
+	44, // 39: pii.GetKEKStatusResponse.job:type_name -> pii.KEKRewrapJob
+	0,  // 40: pii.PIIService.Tokenize:input_type -> pii.TokenizeRequest
+	2,  // 41: pii.PIIService.Detokenize:input_type -> pii.DetokenizeRequest
+	7,  // 42: pii.PIIService.TokenizeBatch:input_type -> pii.TokenizeBatchRequest
+	10, // 43: pii.PIIService.DetokenizeBatch:input_type -> pii.DetokenizeBatchRequest
+	12, // 44: pii.PIIService.TokenizeStream:input_type -> pii.TokenizeStreamRequest
+	14, // 45: pii.PIIService.DetokenizeStream:input_type -> pii.DetokenizeStreamRequest
+	4,  // 46: pii.PIIService.LookupToken:input_type -> pii.LookupTokenRequest
+	16, // 47: pii.PIIService.HealthCheck:input_type -> pii.HealthCheckRequest
+	19, // 48: pii.PIIService.CreateOrganization:input_type -> pii.CreateOrganizationRequest
+	21, // 49: pii.PIIService.GetOrganization:input_type -> pii.GetOrganizationRequest
+	23, // 50: pii.PIIService.ListOrganizations:input_type -> pii.ListOrganizationsRequest
+	25, // 51: pii.PIIService.SuspendOrganization:input_type -> pii.SuspendOrganizationRequest
+	27, // 52: pii.PIIService.ReactivateOrganization:input_type -> pii.ReactivateOrganizationRequest
+	29, // 53: pii.PIIService.UnlockOrganization:input_type -> pii.UnlockOrganizationRequest
+	31, // 54: pii.PIIService.SetOrganizationCipherSuite:input_type -> pii.SetOrganizationCipherSuiteRequest
+	33, // 55: pii.PIIService.SetDeterministicDataTypes:input_type -> pii.SetDeterministicDataTypesRequest
+	35, // 56: pii.PIIService.ShredOrganization:input_type -> pii.ShredOrganizationRequest
+	37, // 57: pii.PIIService.RotateTEK:input_type -> pii.RotateTEKRequest
+	40, // 58: pii.PIIService.RotateOrganizationKey:input_type -> pii.RotateOrganizationKeyRequest
+	42, // 59: pii.PIIService.GetOrganizationKeyRotation:input_type -> pii.GetOrganizationKeyRotationRequest
+	45, // 60: pii.PIIService.RewrapTEKs:input_type -> pii.RewrapTEKsRequest
+	47, // 61: pii.PIIService.GetKEKStatus:input_type -> pii.GetKEKStatusRequest
+	1,  // 62: pii.PIIService.Tokenize:output_type -> pii.TokenizeResponse
+	3,  // 63: pii.PIIService.Detokenize:output_type -> pii.DetokenizeResponse
+	9,  // 64: pii.PIIService.TokenizeBatch:output_type -> pii.TokenizeBatchResponse
+	11, // 65: pii.PIIService.DetokenizeBatch:output_type -> pii.DetokenizeBatchResponse
+	13, // 66: pii.PIIService.TokenizeStream:output_type -> pii.TokenizeStreamResponse
+	15, // 67: pii.PIIService.DetokenizeStream:output_type -> pii.DetokenizeStreamResponse
+	6,  // 68: pii.PIIService.LookupToken:output_type -> pii.LookupTokenResponse
+	17, // 69: pii.PIIService.HealthCheck:output_type -> pii.HealthCheckResponse
+	20, // 70: pii.PIIService.CreateOrganization:output_type -> pii.CreateOrganizationResponse
+	22, // 71: pii.PIIService.GetOrganization:output_type -> pii.GetOrganizationResponse
+	24, // 72: pii.PIIService.ListOrganizations:output_type -> pii.ListOrganizationsResponse
+	26, // 73: pii.PIIService.SuspendOrganization:output_type -> pii.SuspendOrganizationResponse
+	28, // 74: pii.PIIService.ReactivateOrganization:output_type -> pii.ReactivateOrganizationResponse
+	30, // 75: pii.PIIService.UnlockOrganization:output_type -> pii.UnlockOrganizationResponse
+	32, // 76: pii.PIIService.SetOrganizationCipherSuite:output_type -> pii.SetOrganizationCipherSuiteResponse
+	34, // 77: pii.PIIService.SetDeterministicDataTypes:output_type -> pii.SetDeterministicDataTypesResponse
+	36, // 78: pii.PIIService.ShredOrganization:output_type -> pii.ShredOrganizationResponse
+	38, // 79: pii.PIIService.RotateTEK:output_type -> pii.RotateTEKResponse
+	41, // 80: pii.PIIService.RotateOrganizationKey:output_type -> pii.RotateOrganizationKeyResponse
+	43, // 81: pii.PIIService.GetOrganizationKeyRotation:output_type -> pii.GetOrganizationKeyRotationResponse
+	46, // 82: pii.PIIService.RewrapTEKs:output_type -> pii.RewrapTEKsResponse
+	49, // 83: pii.PIIService.GetKEKStatus:output_type -> pii.GetKEKStatusResponse
+	62, // [62:84] is the sub-list for method output_type
+	40, // [40:62] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_pii_pii_service_proto_init() }
@@ -594,7 +3940,7 @@ func file_pii_pii_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pii_pii_service_proto_rawDesc), len(file_pii_pii_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
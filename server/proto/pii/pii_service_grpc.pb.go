@@ -19,9 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PIIService_Tokenize_FullMethodName    = "/pii.PIIService/Tokenize"
-	PIIService_Detokenize_FullMethodName  = "/pii.PIIService/Detokenize"
-	PIIService_HealthCheck_FullMethodName = "/pii.PIIService/HealthCheck"
+	PIIService_Tokenize_FullMethodName                   = "/pii.PIIService/Tokenize"
+	PIIService_Detokenize_FullMethodName                 = "/pii.PIIService/Detokenize"
+	PIIService_TokenizeBatch_FullMethodName              = "/pii.PIIService/TokenizeBatch"
+	PIIService_DetokenizeBatch_FullMethodName            = "/pii.PIIService/DetokenizeBatch"
+	PIIService_TokenizeStream_FullMethodName             = "/pii.PIIService/TokenizeStream"
+	PIIService_DetokenizeStream_FullMethodName           = "/pii.PIIService/DetokenizeStream"
+	PIIService_LookupToken_FullMethodName                = "/pii.PIIService/LookupToken"
+	PIIService_HealthCheck_FullMethodName                = "/pii.PIIService/HealthCheck"
+	PIIService_CreateOrganization_FullMethodName         = "/pii.PIIService/CreateOrganization"
+	PIIService_GetOrganization_FullMethodName            = "/pii.PIIService/GetOrganization"
+	PIIService_ListOrganizations_FullMethodName          = "/pii.PIIService/ListOrganizations"
+	PIIService_SuspendOrganization_FullMethodName        = "/pii.PIIService/SuspendOrganization"
+	PIIService_ReactivateOrganization_FullMethodName     = "/pii.PIIService/ReactivateOrganization"
+	PIIService_UnlockOrganization_FullMethodName         = "/pii.PIIService/UnlockOrganization"
+	PIIService_SetOrganizationCipherSuite_FullMethodName = "/pii.PIIService/SetOrganizationCipherSuite"
+	PIIService_SetDeterministicDataTypes_FullMethodName  = "/pii.PIIService/SetDeterministicDataTypes"
+	PIIService_ShredOrganization_FullMethodName          = "/pii.PIIService/ShredOrganization"
+	PIIService_RotateTEK_FullMethodName                  = "/pii.PIIService/RotateTEK"
+	PIIService_RotateOrganizationKey_FullMethodName      = "/pii.PIIService/RotateOrganizationKey"
+	PIIService_GetOrganizationKeyRotation_FullMethodName = "/pii.PIIService/GetOrganizationKeyRotation"
+	PIIService_RewrapTEKs_FullMethodName                 = "/pii.PIIService/RewrapTEKs"
+	PIIService_GetKEKStatus_FullMethodName               = "/pii.PIIService/GetKEKStatus"
 )
 
 // PIIServiceClient is the client API for PIIService service.
@@ -34,8 +53,56 @@ type PIIServiceClient interface {
 	Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error)
 	// Detokenize retrieves and decrypts PII data from a reference token
 	Detokenize(ctx context.Context, in *DetokenizeRequest, opts ...grpc.CallOption) (*DetokenizeResponse, error)
+	// TokenizeBatch tokenizes several values of one organization, unwrapping its TEK once
+	// and queueing the tokens for persistence together
+	TokenizeBatch(ctx context.Context, in *TokenizeBatchRequest, opts ...grpc.CallOption) (*TokenizeBatchResponse, error)
+	// DetokenizeBatch detokenizes several tokens of one organization, unwrapping each
+	// TEK version once
+	DetokenizeBatch(ctx context.Context, in *DetokenizeBatchRequest, opts ...grpc.CallOption) (*DetokenizeBatchResponse, error)
+	// TokenizeStream tokenizes a stream of values, answering each message as it completes,
+	// possibly out of order; responses carry the correlation ID of their message
+	TokenizeStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TokenizeStreamRequest, TokenizeStreamResponse], error)
+	// DetokenizeStream detokenizes a stream of tokens like TokenizeStream
+	DetokenizeStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DetokenizeStreamRequest, DetokenizeStreamResponse], error)
+	// LookupToken finds the live tokens of a known value through their blind index
+	LookupToken(ctx context.Context, in *LookupTokenRequest, opts ...grpc.CallOption) (*LookupTokenResponse, error)
 	// HealthCheck returns the health status of the PII service
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// CreateOrganization onboards a tenant and provisions its TEK (admin only)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// GetOrganization returns a single organization (admin only)
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
+	// ListOrganizations returns onboarded organizations (admin only)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	// SuspendOrganization blocks tokenize and detokenize for an organization (admin only)
+	SuspendOrganization(ctx context.Context, in *SuspendOrganizationRequest, opts ...grpc.CallOption) (*SuspendOrganizationResponse, error)
+	// ReactivateOrganization lifts a suspension (admin only)
+	ReactivateOrganization(ctx context.Context, in *ReactivateOrganizationRequest, opts ...grpc.CallOption) (*ReactivateOrganizationResponse, error)
+	// UnlockOrganization clears brute-force lockout counters for an organization (admin only)
+	UnlockOrganization(ctx context.Context, in *UnlockOrganizationRequest, opts ...grpc.CallOption) (*UnlockOrganizationResponse, error)
+	// SetOrganizationCipherSuite changes the cipher suite new tokens are sealed with;
+	// existing tokens keep the suite named in their header (admin only)
+	SetOrganizationCipherSuite(ctx context.Context, in *SetOrganizationCipherSuiteRequest, opts ...grpc.CallOption) (*SetOrganizationCipherSuiteResponse, error)
+	// SetDeterministicDataTypes chooses the data types whose reference tokens are derived
+	// from the value, so equal values share one token (admin only)
+	SetDeterministicDataTypes(ctx context.Context, in *SetDeterministicDataTypesRequest, opts ...grpc.CallOption) (*SetDeterministicDataTypesResponse, error)
+	// ShredOrganization destroys every TEK version of an organization so that none of its
+	// ciphertexts can be decrypted again, purges its cached and queued tokens and returns
+	// a signed record of the shred (admin only)
+	ShredOrganization(ctx context.Context, in *ShredOrganizationRequest, opts ...grpc.CallOption) (*ShredOrganizationResponse, error)
+	// RotateTEK provisions a new TEK version for new tokens; existing tokens keep
+	// decrypting with the version that encrypted them (admin only)
+	RotateTEK(ctx context.Context, in *RotateTEKRequest, opts ...grpc.CallOption) (*RotateTEKResponse, error)
+	// RotateOrganizationKey replaces an organization's key and re-encrypts its tokens in
+	// the background; both keys work until the job completes (admin only)
+	RotateOrganizationKey(ctx context.Context, in *RotateOrganizationKeyRequest, opts ...grpc.CallOption) (*RotateOrganizationKeyResponse, error)
+	// GetOrganizationKeyRotation reports key rotation progress (admin only)
+	GetOrganizationKeyRotation(ctx context.Context, in *GetOrganizationKeyRotationRequest, opts ...grpc.CallOption) (*GetOrganizationKeyRotationResponse, error)
+	// RewrapTEKs starts a background job that rewraps every stored TEK under the current
+	// KEK. Token ciphertexts are not touched. (admin only)
+	RewrapTEKs(ctx context.Context, in *RewrapTEKsRequest, opts ...grpc.CallOption) (*RewrapTEKsResponse, error)
+	// GetKEKStatus reports how many stored TEKs each KEK still wraps (admin only)
+	GetKEKStatus(ctx context.Context, in *GetKEKStatusRequest, opts ...grpc.CallOption) (*GetKEKStatusResponse, error)
 }
 
 type pIIServiceClient struct {
@@ -66,6 +133,62 @@ func (c *pIIServiceClient) Detokenize(ctx context.Context, in *DetokenizeRequest
 	return out, nil
 }
 
+func (c *pIIServiceClient) TokenizeBatch(ctx context.Context, in *TokenizeBatchRequest, opts ...grpc.CallOption) (*TokenizeBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenizeBatchResponse)
+	err := c.cc.Invoke(ctx, PIIService_TokenizeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) DetokenizeBatch(ctx context.Context, in *DetokenizeBatchRequest, opts ...grpc.CallOption) (*DetokenizeBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetokenizeBatchResponse)
+	err := c.cc.Invoke(ctx, PIIService_DetokenizeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) TokenizeStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TokenizeStreamRequest, TokenizeStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PIIService_ServiceDesc.Streams[0], PIIService_TokenizeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TokenizeStreamRequest, TokenizeStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PIIService_TokenizeStreamClient = grpc.BidiStreamingClient[TokenizeStreamRequest, TokenizeStreamResponse]
+
+func (c *pIIServiceClient) DetokenizeStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DetokenizeStreamRequest, DetokenizeStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PIIService_ServiceDesc.Streams[1], PIIService_DetokenizeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DetokenizeStreamRequest, DetokenizeStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PIIService_DetokenizeStreamClient = grpc.BidiStreamingClient[DetokenizeStreamRequest, DetokenizeStreamResponse]
+
+func (c *pIIServiceClient) LookupToken(ctx context.Context, in *LookupTokenRequest, opts ...grpc.CallOption) (*LookupTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupTokenResponse)
+	err := c.cc.Invoke(ctx, PIIService_LookupToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pIIServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -76,6 +199,146 @@ func (c *pIIServiceClient) HealthCheck(ctx context.Context, in *HealthCheckReque
 	return out, nil
 }
 
+func (c *pIIServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, PIIService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationResponse)
+	err := c.cc.Invoke(ctx, PIIService_GetOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, PIIService_ListOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) SuspendOrganization(ctx context.Context, in *SuspendOrganizationRequest, opts ...grpc.CallOption) (*SuspendOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendOrganizationResponse)
+	err := c.cc.Invoke(ctx, PIIService_SuspendOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) ReactivateOrganization(ctx context.Context, in *ReactivateOrganizationRequest, opts ...grpc.CallOption) (*ReactivateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateOrganizationResponse)
+	err := c.cc.Invoke(ctx, PIIService_ReactivateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) UnlockOrganization(ctx context.Context, in *UnlockOrganizationRequest, opts ...grpc.CallOption) (*UnlockOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockOrganizationResponse)
+	err := c.cc.Invoke(ctx, PIIService_UnlockOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) SetOrganizationCipherSuite(ctx context.Context, in *SetOrganizationCipherSuiteRequest, opts ...grpc.CallOption) (*SetOrganizationCipherSuiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrganizationCipherSuiteResponse)
+	err := c.cc.Invoke(ctx, PIIService_SetOrganizationCipherSuite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) SetDeterministicDataTypes(ctx context.Context, in *SetDeterministicDataTypesRequest, opts ...grpc.CallOption) (*SetDeterministicDataTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDeterministicDataTypesResponse)
+	err := c.cc.Invoke(ctx, PIIService_SetDeterministicDataTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) ShredOrganization(ctx context.Context, in *ShredOrganizationRequest, opts ...grpc.CallOption) (*ShredOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShredOrganizationResponse)
+	err := c.cc.Invoke(ctx, PIIService_ShredOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) RotateTEK(ctx context.Context, in *RotateTEKRequest, opts ...grpc.CallOption) (*RotateTEKResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateTEKResponse)
+	err := c.cc.Invoke(ctx, PIIService_RotateTEK_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) RotateOrganizationKey(ctx context.Context, in *RotateOrganizationKeyRequest, opts ...grpc.CallOption) (*RotateOrganizationKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateOrganizationKeyResponse)
+	err := c.cc.Invoke(ctx, PIIService_RotateOrganizationKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) GetOrganizationKeyRotation(ctx context.Context, in *GetOrganizationKeyRotationRequest, opts ...grpc.CallOption) (*GetOrganizationKeyRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationKeyRotationResponse)
+	err := c.cc.Invoke(ctx, PIIService_GetOrganizationKeyRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) RewrapTEKs(ctx context.Context, in *RewrapTEKsRequest, opts ...grpc.CallOption) (*RewrapTEKsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewrapTEKsResponse)
+	err := c.cc.Invoke(ctx, PIIService_RewrapTEKs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIIServiceClient) GetKEKStatus(ctx context.Context, in *GetKEKStatusRequest, opts ...grpc.CallOption) (*GetKEKStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKEKStatusResponse)
+	err := c.cc.Invoke(ctx, PIIService_GetKEKStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PIIServiceServer is the server API for PIIService service.
 // All implementations must embed UnimplementedPIIServiceServer
 // for forward compatibility.
@@ -86,8 +349,56 @@ type PIIServiceServer interface {
 	Tokenize(context.Context, *TokenizeRequest) (*TokenizeResponse, error)
 	// Detokenize retrieves and decrypts PII data from a reference token
 	Detokenize(context.Context, *DetokenizeRequest) (*DetokenizeResponse, error)
+	// TokenizeBatch tokenizes several values of one organization, unwrapping its TEK once
+	// and queueing the tokens for persistence together
+	TokenizeBatch(context.Context, *TokenizeBatchRequest) (*TokenizeBatchResponse, error)
+	// DetokenizeBatch detokenizes several tokens of one organization, unwrapping each
+	// TEK version once
+	DetokenizeBatch(context.Context, *DetokenizeBatchRequest) (*DetokenizeBatchResponse, error)
+	// TokenizeStream tokenizes a stream of values, answering each message as it completes,
+	// possibly out of order; responses carry the correlation ID of their message
+	TokenizeStream(grpc.BidiStreamingServer[TokenizeStreamRequest, TokenizeStreamResponse]) error
+	// DetokenizeStream detokenizes a stream of tokens like TokenizeStream
+	DetokenizeStream(grpc.BidiStreamingServer[DetokenizeStreamRequest, DetokenizeStreamResponse]) error
+	// LookupToken finds the live tokens of a known value through their blind index
+	LookupToken(context.Context, *LookupTokenRequest) (*LookupTokenResponse, error)
 	// HealthCheck returns the health status of the PII service
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// CreateOrganization onboards a tenant and provisions its TEK (admin only)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// GetOrganization returns a single organization (admin only)
+	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	// ListOrganizations returns onboarded organizations (admin only)
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	// SuspendOrganization blocks tokenize and detokenize for an organization (admin only)
+	SuspendOrganization(context.Context, *SuspendOrganizationRequest) (*SuspendOrganizationResponse, error)
+	// ReactivateOrganization lifts a suspension (admin only)
+	ReactivateOrganization(context.Context, *ReactivateOrganizationRequest) (*ReactivateOrganizationResponse, error)
+	// UnlockOrganization clears brute-force lockout counters for an organization (admin only)
+	UnlockOrganization(context.Context, *UnlockOrganizationRequest) (*UnlockOrganizationResponse, error)
+	// SetOrganizationCipherSuite changes the cipher suite new tokens are sealed with;
+	// existing tokens keep the suite named in their header (admin only)
+	SetOrganizationCipherSuite(context.Context, *SetOrganizationCipherSuiteRequest) (*SetOrganizationCipherSuiteResponse, error)
+	// SetDeterministicDataTypes chooses the data types whose reference tokens are derived
+	// from the value, so equal values share one token (admin only)
+	SetDeterministicDataTypes(context.Context, *SetDeterministicDataTypesRequest) (*SetDeterministicDataTypesResponse, error)
+	// ShredOrganization destroys every TEK version of an organization so that none of its
+	// ciphertexts can be decrypted again, purges its cached and queued tokens and returns
+	// a signed record of the shred (admin only)
+	ShredOrganization(context.Context, *ShredOrganizationRequest) (*ShredOrganizationResponse, error)
+	// RotateTEK provisions a new TEK version for new tokens; existing tokens keep
+	// decrypting with the version that encrypted them (admin only)
+	RotateTEK(context.Context, *RotateTEKRequest) (*RotateTEKResponse, error)
+	// RotateOrganizationKey replaces an organization's key and re-encrypts its tokens in
+	// the background; both keys work until the job completes (admin only)
+	RotateOrganizationKey(context.Context, *RotateOrganizationKeyRequest) (*RotateOrganizationKeyResponse, error)
+	// GetOrganizationKeyRotation reports key rotation progress (admin only)
+	GetOrganizationKeyRotation(context.Context, *GetOrganizationKeyRotationRequest) (*GetOrganizationKeyRotationResponse, error)
+	// RewrapTEKs starts a background job that rewraps every stored TEK under the current
+	// KEK. Token ciphertexts are not touched. (admin only)
+	RewrapTEKs(context.Context, *RewrapTEKsRequest) (*RewrapTEKsResponse, error)
+	// GetKEKStatus reports how many stored TEKs each KEK still wraps (admin only)
+	GetKEKStatus(context.Context, *GetKEKStatusRequest) (*GetKEKStatusResponse, error)
 	mustEmbedUnimplementedPIIServiceServer()
 }
 
@@ -104,9 +415,66 @@ func (UnimplementedPIIServiceServer) Tokenize(context.Context, *TokenizeRequest)
 func (UnimplementedPIIServiceServer) Detokenize(context.Context, *DetokenizeRequest) (*DetokenizeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Detokenize not implemented")
 }
+func (UnimplementedPIIServiceServer) TokenizeBatch(context.Context, *TokenizeBatchRequest) (*TokenizeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeBatch not implemented")
+}
+func (UnimplementedPIIServiceServer) DetokenizeBatch(context.Context, *DetokenizeBatchRequest) (*DetokenizeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetokenizeBatch not implemented")
+}
+func (UnimplementedPIIServiceServer) TokenizeStream(grpc.BidiStreamingServer[TokenizeStreamRequest, TokenizeStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method TokenizeStream not implemented")
+}
+func (UnimplementedPIIServiceServer) DetokenizeStream(grpc.BidiStreamingServer[DetokenizeStreamRequest, DetokenizeStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DetokenizeStream not implemented")
+}
+func (UnimplementedPIIServiceServer) LookupToken(context.Context, *LookupTokenRequest) (*LookupTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupToken not implemented")
+}
 func (UnimplementedPIIServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (UnimplementedPIIServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedPIIServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedPIIServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedPIIServiceServer) SuspendOrganization(context.Context, *SuspendOrganizationRequest) (*SuspendOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendOrganization not implemented")
+}
+func (UnimplementedPIIServiceServer) ReactivateOrganization(context.Context, *ReactivateOrganizationRequest) (*ReactivateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateOrganization not implemented")
+}
+func (UnimplementedPIIServiceServer) UnlockOrganization(context.Context, *UnlockOrganizationRequest) (*UnlockOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockOrganization not implemented")
+}
+func (UnimplementedPIIServiceServer) SetOrganizationCipherSuite(context.Context, *SetOrganizationCipherSuiteRequest) (*SetOrganizationCipherSuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationCipherSuite not implemented")
+}
+func (UnimplementedPIIServiceServer) SetDeterministicDataTypes(context.Context, *SetDeterministicDataTypesRequest) (*SetDeterministicDataTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeterministicDataTypes not implemented")
+}
+func (UnimplementedPIIServiceServer) ShredOrganization(context.Context, *ShredOrganizationRequest) (*ShredOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShredOrganization not implemented")
+}
+func (UnimplementedPIIServiceServer) RotateTEK(context.Context, *RotateTEKRequest) (*RotateTEKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTEK not implemented")
+}
+func (UnimplementedPIIServiceServer) RotateOrganizationKey(context.Context, *RotateOrganizationKeyRequest) (*RotateOrganizationKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateOrganizationKey not implemented")
+}
+func (UnimplementedPIIServiceServer) GetOrganizationKeyRotation(context.Context, *GetOrganizationKeyRotationRequest) (*GetOrganizationKeyRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationKeyRotation not implemented")
+}
+func (UnimplementedPIIServiceServer) RewrapTEKs(context.Context, *RewrapTEKsRequest) (*RewrapTEKsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewrapTEKs not implemented")
+}
+func (UnimplementedPIIServiceServer) GetKEKStatus(context.Context, *GetKEKStatusRequest) (*GetKEKStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKEKStatus not implemented")
+}
 func (UnimplementedPIIServiceServer) mustEmbedUnimplementedPIIServiceServer() {}
 func (UnimplementedPIIServiceServer) testEmbeddedByValue()                    {}
 
@@ -164,6 +532,74 @@ func _PIIService_Detokenize_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PIIService_TokenizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).TokenizeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_TokenizeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).TokenizeBatch(ctx, req.(*TokenizeBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_DetokenizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetokenizeBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).DetokenizeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_DetokenizeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).DetokenizeBatch(ctx, req.(*DetokenizeBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_TokenizeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PIIServiceServer).TokenizeStream(&grpc.GenericServerStream[TokenizeStreamRequest, TokenizeStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PIIService_TokenizeStreamServer = grpc.BidiStreamingServer[TokenizeStreamRequest, TokenizeStreamResponse]
+
+func _PIIService_DetokenizeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PIIServiceServer).DetokenizeStream(&grpc.GenericServerStream[DetokenizeStreamRequest, DetokenizeStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PIIService_DetokenizeStreamServer = grpc.BidiStreamingServer[DetokenizeStreamRequest, DetokenizeStreamResponse]
+
+func _PIIService_LookupToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).LookupToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_LookupToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).LookupToken(ctx, req.(*LookupTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PIIService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +618,258 @@ func _PIIService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PIIService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_SuspendOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).SuspendOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_SuspendOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).SuspendOrganization(ctx, req.(*SuspendOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_ReactivateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).ReactivateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_ReactivateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).ReactivateOrganization(ctx, req.(*ReactivateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_UnlockOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).UnlockOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_UnlockOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).UnlockOrganization(ctx, req.(*UnlockOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_SetOrganizationCipherSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationCipherSuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).SetOrganizationCipherSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_SetOrganizationCipherSuite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).SetOrganizationCipherSuite(ctx, req.(*SetOrganizationCipherSuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_SetDeterministicDataTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeterministicDataTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).SetDeterministicDataTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_SetDeterministicDataTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).SetDeterministicDataTypes(ctx, req.(*SetDeterministicDataTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_ShredOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShredOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).ShredOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_ShredOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).ShredOrganization(ctx, req.(*ShredOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_RotateTEK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTEKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).RotateTEK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_RotateTEK_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).RotateTEK(ctx, req.(*RotateTEKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_RotateOrganizationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateOrganizationKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).RotateOrganizationKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_RotateOrganizationKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).RotateOrganizationKey(ctx, req.(*RotateOrganizationKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_GetOrganizationKeyRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationKeyRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).GetOrganizationKeyRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_GetOrganizationKeyRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).GetOrganizationKeyRotation(ctx, req.(*GetOrganizationKeyRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_RewrapTEKs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewrapTEKsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).RewrapTEKs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_RewrapTEKs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).RewrapTEKs(ctx, req.(*RewrapTEKsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PIIService_GetKEKStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKEKStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIIServiceServer).GetKEKStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PIIService_GetKEKStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIIServiceServer).GetKEKStatus(ctx, req.(*GetKEKStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PIIService_ServiceDesc is the grpc.ServiceDesc for PIIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,11 +885,92 @@ var PIIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Detokenize",
 			Handler:    _PIIService_Detokenize_Handler,
 		},
+		{
+			MethodName: "TokenizeBatch",
+			Handler:    _PIIService_TokenizeBatch_Handler,
+		},
+		{
+			MethodName: "DetokenizeBatch",
+			Handler:    _PIIService_DetokenizeBatch_Handler,
+		},
+		{
+			MethodName: "LookupToken",
+			Handler:    _PIIService_LookupToken_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _PIIService_HealthCheck_Handler,
 		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _PIIService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _PIIService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _PIIService_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "SuspendOrganization",
+			Handler:    _PIIService_SuspendOrganization_Handler,
+		},
+		{
+			MethodName: "ReactivateOrganization",
+			Handler:    _PIIService_ReactivateOrganization_Handler,
+		},
+		{
+			MethodName: "UnlockOrganization",
+			Handler:    _PIIService_UnlockOrganization_Handler,
+		},
+		{
+			MethodName: "SetOrganizationCipherSuite",
+			Handler:    _PIIService_SetOrganizationCipherSuite_Handler,
+		},
+		{
+			MethodName: "SetDeterministicDataTypes",
+			Handler:    _PIIService_SetDeterministicDataTypes_Handler,
+		},
+		{
+			MethodName: "ShredOrganization",
+			Handler:    _PIIService_ShredOrganization_Handler,
+		},
+		{
+			MethodName: "RotateTEK",
+			Handler:    _PIIService_RotateTEK_Handler,
+		},
+		{
+			MethodName: "RotateOrganizationKey",
+			Handler:    _PIIService_RotateOrganizationKey_Handler,
+		},
+		{
+			MethodName: "GetOrganizationKeyRotation",
+			Handler:    _PIIService_GetOrganizationKeyRotation_Handler,
+		},
+		{
+			MethodName: "RewrapTEKs",
+			Handler:    _PIIService_RewrapTEKs_Handler,
+		},
+		{
+			MethodName: "GetKEKStatus",
+			Handler:    _PIIService_GetKEKStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TokenizeStream",
+			Handler:       _PIIService_TokenizeStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DetokenizeStream",
+			Handler:       _PIIService_DetokenizeStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pii/pii_service.proto",
 }